@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net"
 	"regexp"
+	"strings"
 
 	"github.com/armon/go-radix"
 )
@@ -112,55 +113,136 @@ var AsciiEmojis = []string{
 }
 
 var Cfg = struct {
-	Key             string
-	RPCKey          string
-	Cooldown        int   // minute
-	TokenTTL        int64 // minute
-	IDTokenTTL      int64 // second
-	MaxContent      int64 // byte
-	MinContent      int64 // byte
-	AdminName       string
-	PostsPerPage    int
-	MaxRequestSize  int // MB
-	Domains         []string
-	MediaDomain     string
-	IPBlacklist     []string
-	MaxMentions     int
-	DyRegion        string
-	CwRegion        string
-	DyAccessKey     string
-	DySecretKey     string
-	S3AccessKey     string
-	S3SecretKey     string
-	S3Region        string
-	S3Endpoint      string
-	S3Bucket        string
-	RedisAddr       string
-	ReadOnly        bool
-	HCaptchaSiteKey string
-	HCaptchaSecKey  string
-	SMTPServer      string
-	SMTPEmail       string
-	SMTPPassword    string
+	Key               string
+	OldKeys           []string // verification only keys
+	MaxKeys           int      // max keys promoted at runtime kept in the keyring, keys in config are not counted
+	KeyringKey        string   // master key encrypting keys promoted at runtime, promoting is disabled if empty
+	RPCKey            string
+	Cooldown          int   // second
+	TokenTTL          int64 // minute
+	IDTokenTTL        int64 // second
+	MaxContent        int64 // byte
+	MinContent        int64 // byte
+	AdminName         string
+	PostsPerPage      int
+	MaxRequestSize    int // MB
+	Domains           []string
+	MediaDomain       string
+	IPBlacklist       []string
+	MaxMentions       int
+	DyRegion          string
+	CwRegion          string
+	DyAccessKey       string
+	DySecretKey       string
+	S3AccessKey       string
+	S3SecretKey       string
+	S3Region          string
+	S3Endpoint        string
+	S3Bucket          string
+	RedisAddr         string
+	ReadOnly          bool
+	HCaptchaSiteKey   string
+	HCaptchaSecKey    string
+	SMTPServer        string
+	SMTPEmail         string
+	SMTPPassword      string
+	ResetMailCooldown int    // minute
+	VerifyEmailTTL    int    // hour
+	OAuthAccessTTL    int    // second
+	PasswordHasher    string // bcrypt, scrypt or argon2id
+	BcryptCost        int
+	ScryptN           int
+	Argon2Time        uint32
+	Argon2Memory      uint32 // KiB
+	Argon2Threads     uint8
+	TOTPForMods       bool // mods and admins must enable 2FA to use their privileges
+	RateLimits        []RateLimitPolicy
+	LoginWindow       int // minute, failed logins are counted in this window
+	LoginFreeFailures int // failures allowed before backoff and challenge
+	LoginMaxLockout   int // minute
+	LoginChallengeIPs int // distinct IPs failing one account before challenge
+	LoginAlertAfter   int // failures before alerting the account owner
+	SearchPostingSize int // max articles per text term in the search index, filter terms are not bounded
+
+	SearchRecencyHalfLife int     // day, 0 to disable the recency decay
+	SearchRecencyWeight   float64 // [0, 1], how much of the score is subject to the recency decay
+	MaxSavedSearches      int
+	SavedSearchNotify     int // notifications per saved search per hour
+	MaxMutes              int
+	MaxBookmarkFolders    int
+	MaxLists              int
+	MaxListMembers        int
+	MaxScheduledPosts     int
+	MaxScheduleDays       int // scheduled articles must be published within these days
+	MaxDrafts             int
+	EditWindow            int // minute, articles can only be edited within it after being posted, 0 to disable
+	MaxThreadParts        int
+
+	HomeFeed         bool // materialize home timelines instead of merging all followings on read
+	HomeFeedSize     int  // max articles in one feed
+	HomeFeedFanout   int  // accounts with more followers are pulled at read time
+	HomeFeedTTL      int  // hour, idle feeds expire and will be rebuilt
+	HomeFeedBackfill int  // articles backfilled from a newly followed account
 
 	// inited after Cfg being read
 	Blk               cipher.Block
 	KeyBytes          []byte
 	IPBlacklistParsed []*net.IPNet
 }{
-	MediaDomain:     "/i",
-	TokenTTL:        10,
-	IDTokenTTL:      600,
-	Key:             "0123456789abcdef",
-	AdminName:       "zzzz",
-	MaxContent:      1024,
-	MinContent:      8,
-	PostsPerPage:    30,
-	Cooldown:        5,
-	MaxMentions:     3,
-	MaxRequestSize:  6,
-	HCaptchaSiteKey: "10000000-ffff-ffff-ffff-000000000001",
-	HCaptchaSecKey:  "0x0000000000000000000000000000000000000000",
+	MediaDomain:       "/i",
+	TokenTTL:          10,
+	IDTokenTTL:        600,
+	Key:               "0123456789abcdef",
+	MaxKeys:           4,
+	AdminName:         "zzzz",
+	MaxContent:        1024,
+	MinContent:        8,
+	PostsPerPage:      30,
+	Cooldown:          5,
+	MaxMentions:       3,
+	MaxRequestSize:    6,
+	HCaptchaSiteKey:   "10000000-ffff-ffff-ffff-000000000001",
+	HCaptchaSecKey:    "0x0000000000000000000000000000000000000000",
+	ResetMailCooldown: 10,
+	VerifyEmailTTL:    24,
+	OAuthAccessTTL:    3600,
+	PasswordHasher:    "argon2id",
+	BcryptCost:        10,
+	ScryptN:           32768,
+	Argon2Time:        1,
+	Argon2Memory:      64 * 1024,
+	Argon2Threads:     2,
+	LoginWindow:       60,
+	LoginFreeFailures: 3,
+	LoginMaxLockout:   30,
+	LoginChallengeIPs: 3,
+	LoginAlertAfter:   10,
+	SearchPostingSize: 2000,
+
+	SearchRecencyHalfLife: 30,
+	SearchRecencyWeight:   0.3,
+	MaxSavedSearches:      10,
+	SavedSearchNotify:     10,
+	MaxMutes:              200,
+	MaxBookmarkFolders:    20,
+	MaxLists:              20,
+	MaxListMembers:        500,
+	MaxScheduledPosts:     20,
+	MaxScheduleDays:       30,
+	MaxDrafts:             20,
+	EditWindow:            30,
+	MaxThreadParts:        20,
+
+	HomeFeedSize:     800,
+	HomeFeedFanout:   5000,
+	HomeFeedTTL:      72,
+	HomeFeedBackfill: 20,
+	RateLimits: []RateLimitPolicy{
+		{Name: "cooldown", Routes: []string{"/api2/", "/api/reset_password", "/api/totp_"}, Method: "POST", Algo: "bucket", Limit: 1, Key: "ip", Soft: true},
+		{Name: "upload", Routes: []string{"/api/upload_image"}, Method: "POST", Algo: "bucket", Limit: 10, Window: 30, Key: "user"},
+		{Name: "api", Routes: []string{"/api2/"}, Algo: "window", Limit: 300, Window: 60, Key: "token"},
+		{Name: "oauth", Routes: []string{"/oauth/token"}, Method: "POST", Algo: "window", Limit: 60, Window: 60, Key: "ip"},
+	},
 }
 
 func MustLoadConfig(path string) {
@@ -179,6 +261,13 @@ func MustLoadConfig(path string) {
 
 	Cfg.Blk, _ = aes.NewCipher([]byte(Cfg.Key))
 	Cfg.KeyBytes = []byte(Cfg.Key)
+	SetKeyring(append([]string{Cfg.Key}, Cfg.OldKeys...)...)
+
+	for i := range Cfg.RateLimits {
+		if Cfg.RateLimits[i].Window == 0 {
+			Cfg.RateLimits[i].Window = int64(Cfg.Cooldown)
+		}
+	}
 
 	for _, addr := range Cfg.IPBlacklist {
 		_, subnet, _ := net.ParseCIDR(addr)
@@ -186,6 +275,31 @@ func MustLoadConfig(path string) {
 	}
 }
 
+// RateLimitPolicy limits requests whose path starts with one of the routes,
+// admins can override the limit of a policy for specific users
+type RateLimitPolicy struct {
+	Name   string
+	Routes []string // path prefixes
+	Method string   // empty matches all methods
+	Algo   string   // bucket (token bucket) or window (sliding window)
+	Limit  int
+	Window int64  // second, zero means Cooldown
+	Key    string // ip, user or token
+	Soft   bool   // exceeding the limit only marks the request, handlers decide whether to reject it
+}
+
+func (p *RateLimitPolicy) Match(method, path string) bool {
+	if p.Method != "" && p.Method != method {
+		return false
+	}
+	for _, r := range p.Routes {
+		if strings.HasPrefix(path, r) {
+			return true
+		}
+	}
+	return false
+}
+
 type CSSConfig struct {
 	BodyBG         string // main background color
 	ContainerBG    string
@@ -4,6 +4,7 @@ package jiebago
 import (
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -203,3 +204,78 @@ func (seg *Segmenter) Cut(sentence string, n int) []string {
 func Cut(sentence string, n int) []string {
 	return defaultSeg.Cut(sentence, n)
 }
+
+// calc finds the most probable route of the DAG using dynamic programming
+func (seg *Segmenter) calc(runes []rune) map[int]route {
+	dag := seg.dag(runes)
+	n := len(runes)
+	rs := map[int]route{n: {}}
+	for idx := n - 1; idx >= 0; idx-- {
+		var r route
+		for i, x := range dag[idx] {
+			freq, _ := seg.dict.Frequency(string(runes[idx : x+1]))
+			if freq <= 0 {
+				freq = 1
+			}
+			f := math.Log(freq) - seg.dict.logTotal + rs[x+1].frequency
+			if i == 0 || f > r.frequency {
+				r = route{frequency: f, index: x}
+			}
+		}
+		rs[idx] = r
+	}
+	return rs
+}
+
+// cutDAG cuts Han characters into words using the most probable route,
+// unknown characters are returned one by one
+func (seg *Segmenter) cutDAG(sentence string) []string {
+	runes := []rune(sentence)
+	result := make([]string, 0, len(runes))
+	if seg.dict == nil {
+		for _, r := range runes {
+			result = append(result, string(r))
+		}
+		return result
+	}
+
+	rs := seg.calc(runes)
+	for x := 0; x < len(runes); {
+		y := rs[x].index + 1
+		result = append(result, string(runes[x:y]))
+		x = y
+	}
+	return result
+}
+
+// CutForSearch cuts a sentence using search mode, long words will be further cut
+// into 2-grams and 3-grams which can be found in the dictionary. Only Han characters
+// are segmented, other blocks are returned as is.
+func (seg *Segmenter) CutForSearch(sentence string) []string {
+	result := []string{}
+	for _, block := range util.RegexpSplit(reHanCutAll, sentence, -1) {
+		if len(block) == 0 {
+			continue
+		}
+		if !reHanCutAll.MatchString(block) {
+			result = append(result, block)
+			continue
+		}
+		for _, word := range seg.cutDAG(block) {
+			runes := []rune(word)
+			for _, n := range []int{2, 3} {
+				for i := 0; len(runes) > n && i+n <= len(runes); i++ {
+					if freq, _ := seg.Frequency(string(runes[i : i+n])); freq > 0 {
+						result = append(result, string(runes[i:i+n]))
+					}
+				}
+			}
+			result = append(result, word)
+		}
+	}
+	return result
+}
+
+func CutForSearch(sentence string) []string {
+	return defaultSeg.CutForSearch(sentence)
+}
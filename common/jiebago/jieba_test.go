@@ -1,6 +1,12 @@
 package jiebago
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
 
 var (
 	seg          Segmenter
@@ -292,6 +298,34 @@ func TestCutAll(t *testing.T) {
 	}
 }
 
+func TestCutForSearch(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "jieba")
+	defer os.RemoveAll(dir)
+	dict := filepath.Join(dir, "dict.txt")
+	ioutil.WriteFile(dict, []byte("我 200\n爱 100\n北京 100\n天安门 50\n天安 10\n"+
+		"中国 100\n科学 30\n学院 20\n科学院 20\n中国科学院 10\n"), 0644)
+
+	var seg Segmenter
+	if err := seg.LoadDictionary(dict); err != nil {
+		t.Fatal(err)
+	}
+	for sentence, expect := range map[string]string{
+		"我爱北京天安门":  "我 爱 北京 天安 天安门",
+		"中国科学院":    "中国 科学 学院 科学院 中国科学院",
+		"hello 雷猴": "hello  雷 猴",
+		"":         "",
+	} {
+		if res := strings.Join(seg.CutForSearch(sentence), " "); res != expect {
+			t.Errorf("%s: expect %q, got %q", sentence, expect, res)
+		}
+	}
+
+	var empty Segmenter
+	if res := strings.Join(empty.CutForSearch("北京"), " "); res != "北 京" {
+		t.Fatal(res)
+	}
+}
+
 func BenchmarkCutAll(b *testing.B) {
 	sentence := "工信处女干事每月经过下属科室都要亲口交代24口交换机等技术性器件的安装工作"
 	b.ResetTimer()
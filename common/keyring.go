@@ -0,0 +1,82 @@
+package common
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"sync"
+)
+
+// Key is a secret used to encrypt tokens, its ID will be embedded into new tokens
+// so the parser can find the right key later.
+type Key struct {
+	ID    byte
+	Blk   cipher.Block
+	Bytes []byte
+}
+
+var keyring struct {
+	sync.RWMutex
+	keys []*Key // keys[0] is the active key, others are used for verification only
+}
+
+func NewKey(key string) (*Key, error) {
+	blk, err := aes.NewCipher([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256([]byte(key))
+	return &Key{ID: h[0], Blk: blk, Bytes: []byte(key)}, nil
+}
+
+// SetKeyring replaces the keyring, the first key will be the active one,
+// invalid and duplicated keys are ignored.
+func SetKeyring(keys ...string) []*Key {
+	res := []*Key{}
+	dedup := map[string]bool{}
+	for _, key := range keys {
+		if dedup[key] {
+			continue
+		}
+		k, err := NewKey(key)
+		if err != nil {
+			continue
+		}
+		dedup[key] = true
+		res = append(res, k)
+	}
+
+	keyring.Lock()
+	keyring.keys = res
+	keyring.Unlock()
+	return res
+}
+
+func ActiveKey() *Key {
+	keyring.RLock()
+	keys := keyring.keys
+	keyring.RUnlock()
+	if len(keys) == 0 {
+		keys = SetKeyring(Cfg.Key)
+	}
+	return keys[0]
+}
+
+// Keyring returns all keys with the active one being the first
+func Keyring() []*Key {
+	ActiveKey()
+	keyring.RLock()
+	defer keyring.RUnlock()
+	return append([]*Key{}, keyring.keys...)
+}
+
+// KeysByID returns keys matching the ID, there may be more than one if collided
+func KeysByID(id byte) []*Key {
+	res := []*Key{}
+	for _, k := range Keyring() {
+		if k.ID == id {
+			res = append(res, k)
+		}
+	}
+	return res
+}
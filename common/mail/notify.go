@@ -0,0 +1,34 @@
+package mail
+
+import (
+	"fmt"
+	"time"
+)
+
+func SendEmailVerification(to, uid, link string, ttl time.Duration) error {
+	return SendMail(to, "验证邮箱", fmt.Sprintf(
+		"%s 您好，\r\n\r\n"+
+			"请在 %d 小时内访问以下链接确认这是您的邮箱:\r\n\r\n%s\r\n\r\n"+
+			"如果这不是您本人的操作，请忽略此邮件。", uid, int(ttl.Hours()), link))
+}
+
+func SendPasswordReset(to, uid, link string, ttl time.Duration) error {
+	return SendMail(to, "重置密码", fmt.Sprintf(
+		"%s 您好，\r\n\r\n"+
+			"我们收到了重置您密码的请求，请在 %d 分钟内访问以下链接设置新密码:\r\n\r\n%s\r\n\r\n"+
+			"如果这不是您本人的操作，请忽略此邮件。", uid, int(ttl.Minutes()), link))
+}
+
+func SendEmailChanging(to, uid, email, ip string) error {
+	return SendMail(to, "邮箱更改申请", fmt.Sprintf(
+		"%s 您好，\r\n\r\n"+
+			"您的账号于 %s 申请将邮箱更改为 %s (IP: %s)，新邮箱验证后本邮箱将不再用于找回密码。\r\n\r\n"+
+			"如果这不是您本人的操作，请立即修改密码并注销其他设备。", uid, time.Now().Format("2006-01-02 15:04:05"), email, ip))
+}
+
+func SendPasswordChanged(to, uid, ip string) error {
+	return SendMail(to, "密码已更改", fmt.Sprintf(
+		"%s 您好，\r\n\r\n"+
+			"您的密码已于 %s 被更改 (IP: %s)，所有已登录的设备均已登出。\r\n\r\n"+
+			"如果这不是您本人的操作，请立即重置密码。", uid, time.Now().Format("2006-01-02 15:04:05"), ip))
+}
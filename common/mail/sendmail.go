@@ -3,8 +3,11 @@ package mail
 import (
 	"crypto/tls"
 	"fmt"
-	"github.com/coyove/iis/common"
+	"io"
+	"mime"
 	"net/smtp"
+
+	"github.com/coyove/iis/common"
 )
 
 type loginAuth struct {
@@ -60,7 +63,12 @@ func SendMail(to, subject, body string) error {
 		return err
 	}
 
-	if _, err := fmt.Fprintf(wc, ("To: " + to + "\r\n" + "Subject: " + subject + "\r\n\r\n" + body + "\r\n")); err != nil {
+	if _, err := io.WriteString(wc, "From: "+me+"\r\n"+
+		"To: "+to+"\r\n"+
+		"Subject: "+mime.BEncoding.Encode("utf-8", subject)+"\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=utf-8\r\n\r\n"+
+		body+"\r\n"); err != nil {
 		return err
 	}
 
@@ -0,0 +1,165 @@
+package mail
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"mime"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coyove/iis/common"
+)
+
+type received struct {
+	from, to, data string
+}
+
+// smtpStandIn is a minimal SMTP server which supports STARTTLS and AUTH LOGIN
+func smtpStandIn(t *testing.T, user, password string) (addr string, mails chan received) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	mails = make(chan received, 16)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, cfg, user, password, mails)
+		}
+	}()
+	return ln.Addr().String(), mails
+}
+
+func serveSMTP(conn net.Conn, cfg *tls.Config, user, password string, mails chan received) {
+	defer func() { conn.Close() }()
+
+	r, w := bufio.NewReader(conn), conn
+	reply := func(s string) { w.Write([]byte(s + "\r\n")) }
+	readLine := func() string {
+		l, _ := r.ReadString('\n')
+		return strings.TrimRight(l, "\r\n")
+	}
+
+	var m received
+	var tlsOn, authed bool
+	reply("220 localhost ESMTP stand-in")
+	for {
+		line := readLine()
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch {
+		case cmd == "EHLO" || cmd == "HELO":
+			reply("250-localhost")
+			if !tlsOn {
+				reply("250-STARTTLS")
+			}
+			reply("250 AUTH LOGIN")
+		case cmd == "STARTTLS":
+			reply("220 ready")
+			tc := tls.Server(conn, cfg)
+			if tc.Handshake() != nil {
+				return
+			}
+			conn, r, w, tlsOn = tc, bufio.NewReader(tc), tc, true
+		case cmd == "AUTH":
+			reply("334 VXNlcm5hbWU6") // Username:
+			u, _ := base64.StdEncoding.DecodeString(readLine())
+			reply("334 UGFzc3dvcmQ6") // Password:
+			p, _ := base64.StdEncoding.DecodeString(readLine())
+			if !tlsOn || string(u) != user || string(p) != password {
+				reply("535 authentication failed")
+				continue
+			}
+			authed = true
+			reply("235 ok")
+		case cmd == "MAIL" && authed:
+			m.from = strings.TrimPrefix(line, "MAIL FROM:")
+			reply("250 ok")
+		case cmd == "RCPT" && authed:
+			m.to = strings.TrimPrefix(line, "RCPT TO:")
+			reply("250 ok")
+		case cmd == "DATA" && authed:
+			reply("354 go ahead")
+			var data []string
+			for l := readLine(); l != "."; l = readLine() {
+				data = append(data, l)
+			}
+			m.data = strings.Join(data, "\n")
+			mails <- m
+			reply("250 queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		case cmd == "":
+			return
+		default:
+			reply("502 unsupported")
+		}
+	}
+}
+
+func TestSendMail(t *testing.T) {
+	addr, mails := smtpStandIn(t, "bot@example.com", "pass")
+	common.Cfg.SMTPServer, common.Cfg.SMTPEmail, common.Cfg.SMTPPassword = addr, "bot@example.com", "pass"
+
+	link := "http://example.com/reset_password?token=abc%3D&uid=foo"
+	if err := SendPasswordReset("foo@example.com", "foo", link, 10*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	m := <-mails
+	if m.to != "<foo@example.com>" || m.from != "<bot@example.com>" {
+		t.Fatal(m.from, m.to)
+	}
+	if !strings.Contains(m.data, link) {
+		t.Fatal(m.data)
+	}
+	if !strings.Contains(m.data, "Subject: "+mime.BEncoding.Encode("utf-8", "重置密码")) {
+		t.Fatal(m.data)
+	}
+
+	if err := SendPasswordChanged("foo@example.com", "foo", "127.0.0.0"); err != nil {
+		t.Fatal(err)
+	}
+	if m := <-mails; !strings.Contains(m.data, "127.0.0.0") {
+		t.Fatal(m.data)
+	}
+
+	if err := SendEmailVerification("foo@example.com", "foo", link, 24*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if m := <-mails; !strings.Contains(m.data, link) {
+		t.Fatal(m.data)
+	}
+
+	common.Cfg.SMTPPassword = "wrong"
+	if err := SendPasswordChanged("foo@example.com", "foo", ""); err == nil {
+		t.Fatal("should fail")
+	}
+}
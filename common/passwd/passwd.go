@@ -0,0 +1,203 @@
+package passwd
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/coyove/iis/common"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Hasher produces self-describing hashes: "$<name>$<params>$<salt>$<hash>",
+// except bcrypt which has its own "$2a$..." format.
+type Hasher interface {
+	// Name is the algorithm name used in config
+	Name() string
+	// Hash hashes the password with a random salt and current config params
+	Hash(password []byte) ([]byte, error)
+	// Match tells whether the encoded hash was produced by this hasher
+	Match(encoded []byte) bool
+	// Verify checks the password, 'stale' means the hash was made using params different from current config
+	Verify(password, encoded []byte) (ok bool, stale bool)
+}
+
+var (
+	hashers = map[string]Hasher{}
+	b64     = base64.RawStdEncoding
+)
+
+func init() {
+	Register(bcryptHasher{})
+	Register(scryptHasher{})
+	Register(argon2idHasher{})
+}
+
+func Register(h Hasher) {
+	hashers[h.Name()] = h
+}
+
+func current() Hasher {
+	if h := hashers[common.Cfg.PasswordHasher]; h != nil {
+		return h
+	}
+	return argon2idHasher{}
+}
+
+// Hash hashes the password using the hasher specified in config
+func Hash(password string) []byte {
+	buf, err := current().Hash([]byte(password))
+	if err != nil {
+		panic(err)
+	}
+	return buf
+}
+
+// Verify checks the password against the hash, 'rehash' will be true if the hash
+// is a legacy one or made by a hasher/params different from the current config.
+func Verify(password string, encoded []byte) (ok, rehash bool) {
+	cur := current()
+	for _, h := range hashers {
+		if !h.Match(encoded) {
+			continue
+		}
+		ok, stale := h.Verify([]byte(password), encoded)
+		return ok, ok && (stale || h.Name() != cur.Name())
+	}
+
+	// Legacy: HMAC-SHA256 keyed with Cfg.Key, which may have been rotated into the keyring
+	for _, k := range common.Keyring() {
+		if hmac.Equal(legacyHash(k.Bytes, password), encoded) {
+			return true, true
+		}
+	}
+	return false, false
+}
+
+func legacyHash(key []byte, password string) []byte {
+	pwdHash := hmac.New(sha256.New, key)
+	pwdHash.Write([]byte(password))
+	return pwdHash.Sum(nil)
+}
+
+func salt() []byte {
+	p := make([]byte, 16)
+	rand.Read(p)
+	return p
+}
+
+// split parses "$name$params$salt$hash"
+func split(name string, encoded []byte) (params string, salt, hash []byte, err error) {
+	parts := strings.Split(string(encoded), "$")
+	if len(parts) != 5 || parts[1] != name {
+		return "", nil, nil, fmt.Errorf("invalid %s hash", name)
+	}
+	if salt, err = b64.DecodeString(parts[3]); err != nil {
+		return
+	}
+	hash, err = b64.DecodeString(parts[4])
+	return parts[2], salt, hash, err
+}
+
+func join(name, params string, salt, hash []byte) []byte {
+	return []byte("$" + name + "$" + params + "$" + b64.EncodeToString(salt) + "$" + b64.EncodeToString(hash))
+}
+
+type bcryptHasher struct{}
+
+func (bcryptHasher) Name() string { return "bcrypt" }
+
+func (bcryptHasher) Hash(password []byte) ([]byte, error) {
+	return bcrypt.GenerateFromPassword(password, common.Cfg.BcryptCost)
+}
+
+func (bcryptHasher) Match(encoded []byte) bool {
+	return bytes.HasPrefix(encoded, []byte("$2a$")) || bytes.HasPrefix(encoded, []byte("$2b$"))
+}
+
+func (bcryptHasher) Verify(password, encoded []byte) (bool, bool) {
+	if bcrypt.CompareHashAndPassword(encoded, password) != nil {
+		return false, false
+	}
+	cost, _ := bcrypt.Cost(encoded)
+	return true, cost != common.Cfg.BcryptCost
+}
+
+type scryptHasher struct{}
+
+func (scryptHasher) Name() string { return "scrypt" }
+
+func (scryptHasher) params() string {
+	return fmt.Sprintf("n=%d,r=8,p=1", common.Cfg.ScryptN)
+}
+
+func (h scryptHasher) Hash(password []byte) ([]byte, error) {
+	s := salt()
+	k, err := scrypt.Key(password, s, common.Cfg.ScryptN, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	return join(h.Name(), h.params(), s, k), nil
+}
+
+func (h scryptHasher) Match(encoded []byte) bool {
+	return bytes.HasPrefix(encoded, []byte("$"+h.Name()+"$"))
+}
+
+func (h scryptHasher) Verify(password, encoded []byte) (bool, bool) {
+	params, s, hash, err := split(h.Name(), encoded)
+	if err != nil {
+		return false, false
+	}
+	var n, r, p int
+	if _, err := fmt.Sscanf(params, "n=%d,r=%d,p=%d", &n, &r, &p); err != nil {
+		return false, false
+	}
+	k, err := scrypt.Key(password, s, n, r, p, len(hash))
+	if err != nil || subtle.ConstantTimeCompare(k, hash) != 1 {
+		return false, false
+	}
+	return true, params != h.params()
+}
+
+type argon2idHasher struct{}
+
+func (argon2idHasher) Name() string { return "argon2id" }
+
+func (argon2idHasher) params() string {
+	return fmt.Sprintf("v=%d,m=%d,t=%d,p=%d", argon2.Version,
+		common.Cfg.Argon2Memory, common.Cfg.Argon2Time, common.Cfg.Argon2Threads)
+}
+
+func (h argon2idHasher) Hash(password []byte) ([]byte, error) {
+	s := salt()
+	k := argon2.IDKey(password, s, common.Cfg.Argon2Time, common.Cfg.Argon2Memory, common.Cfg.Argon2Threads, 32)
+	return join(h.Name(), h.params(), s, k), nil
+}
+
+func (h argon2idHasher) Match(encoded []byte) bool {
+	return bytes.HasPrefix(encoded, []byte("$"+h.Name()+"$"))
+}
+
+func (h argon2idHasher) Verify(password, encoded []byte) (bool, bool) {
+	params, s, hash, err := split(h.Name(), encoded)
+	if err != nil {
+		return false, false
+	}
+	var v, m, t, p uint32
+	if _, err := fmt.Sscanf(params, "v=%d,m=%d,t=%d,p=%d", &v, &m, &t, &p); err != nil || v != argon2.Version {
+		return false, false
+	}
+	k := argon2.IDKey(password, s, t, m, uint8(p), uint32(len(hash)))
+	if subtle.ConstantTimeCompare(k, hash) != 1 {
+		return false, false
+	}
+	return true, params != h.params()
+}
@@ -0,0 +1,56 @@
+package passwd
+
+import (
+	"testing"
+
+	"github.com/coyove/iis/common"
+)
+
+func TestHashers(t *testing.T) {
+	common.Cfg.Argon2Memory = 1024
+	common.Cfg.ScryptN = 1024
+	common.Cfg.BcryptCost = 4
+
+	for _, name := range []string{"bcrypt", "scrypt", "argon2id"} {
+		common.Cfg.PasswordHasher = name
+		h := Hash("password")
+		t.Log(string(h))
+
+		if ok, rehash := Verify("password", h); !ok || rehash {
+			t.Fatal(name, ok, rehash)
+		}
+		if ok, _ := Verify("passw0rd", h); ok {
+			t.Fatal(name, "wrong password accepted")
+		}
+		if Hash("password") == nil || string(Hash("password")) == string(h) {
+			t.Fatal(name, "salt not random")
+		}
+	}
+
+	common.Cfg.PasswordHasher = "argon2id"
+	h := Hash("password")
+	common.Cfg.Argon2Time = 2
+	if ok, rehash := Verify("password", h); !ok || !rehash {
+		t.Fatal("params changed, should rehash", ok, rehash)
+	}
+	common.Cfg.PasswordHasher = "bcrypt"
+	if ok, rehash := Verify("password", h); !ok || !rehash {
+		t.Fatal("hasher changed, should rehash", ok, rehash)
+	}
+}
+
+func TestLegacy(t *testing.T) {
+	common.SetKeyring("0123456789abcdef")
+	h := legacyHash([]byte("0123456789abcdef"), "password")
+	if ok, rehash := Verify("password", h); !ok || !rehash {
+		t.Fatal(ok, rehash)
+	}
+
+	common.SetKeyring("fedcba9876543210", "0123456789abcdef")
+	if ok, rehash := Verify("password", h); !ok || !rehash {
+		t.Fatal("rotated key", ok, rehash)
+	}
+	if ok, _ := Verify("passw0rd", h); ok {
+		t.Fatal("wrong password accepted")
+	}
+}
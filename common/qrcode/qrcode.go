@@ -0,0 +1,354 @@
+// Package qrcode is a minimal QR code encoder supporting byte mode, error
+// correction level M and versions 1-9 (up to 180 bytes), which is enough for
+// otpauth:// URIs. The encoding steps follow ISO/IEC 18004.
+package qrcode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+var (
+	eccPerBlock = [10]int{0, 10, 16, 26, 18, 24, 16, 18, 22, 22}
+	numBlocks   = [10]int{0, 1, 1, 1, 2, 2, 4, 4, 4, 5}
+)
+
+const (
+	maxVersion = 9
+	eclM       = 0 // format bits of level M
+	mask       = 0 // (x + y) % 2, any mask is valid to readers, so we don't bother evaluating penalties
+)
+
+type QRCode struct {
+	Size     int
+	modules  [][]bool
+	function [][]bool
+}
+
+// Encode encodes the data into a QR code
+func Encode(data string) (*QRCode, error) {
+	ver := 1
+	for ; ver <= maxVersion; ver++ {
+		if 4+8+len(data)*8 <= numDataCodewords(ver)*8 {
+			break
+		}
+	}
+	if ver > maxVersion {
+		return nil, fmt.Errorf("data too long: %d", len(data))
+	}
+
+	// Mode indicator (byte), char count, data, terminator and padding
+	var bits bitBuffer
+	bits.append(4, 4)
+	bits.append(len(data), 8)
+	for i := 0; i < len(data); i++ {
+		bits.append(int(data[i]), 8)
+	}
+	capacity := numDataCodewords(ver) * 8
+	for i := 0; i < 4 && len(bits) < capacity; i++ {
+		bits.append(0, 1)
+	}
+	for len(bits)%8 != 0 {
+		bits.append(0, 1)
+	}
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+
+	q := &QRCode{Size: ver*4 + 17}
+	q.modules, q.function = make([][]bool, q.Size), make([][]bool, q.Size)
+	for i := range q.modules {
+		q.modules[i], q.function[i] = make([]bool, q.Size), make([]bool, q.Size)
+	}
+
+	q.drawFunctionPatterns(ver)
+	q.drawCodewords(addECCAndInterleave(ver, bits.bytes()))
+	q.applyMask()
+	q.drawFormatBits()
+	return q, nil
+}
+
+// Get returns true if the module at (x, y) is dark
+func (q *QRCode) Get(x, y int) bool {
+	return x >= 0 && x < q.Size && y >= 0 && y < q.Size && q.modules[y][x]
+}
+
+// Image renders the QR code with a 4-module quiet zone, each module is scale*scale pixels
+func (q *QRCode) Image(scale int) image.Image {
+	const border = 4
+	n := (q.Size + border*2) * scale
+	img := image.NewGray(image.Rect(0, 0, n, n))
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			c := color.Gray{255}
+			if q.Get(x/scale-border, y/scale-border) {
+				c = color.Gray{0}
+			}
+			img.SetGray(x, y, c)
+		}
+	}
+	return img
+}
+
+func (q *QRCode) PNGBase64(scale int) string {
+	buf := bytes.Buffer{}
+	png.Encode(&buf, q.Image(scale))
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func (q *QRCode) set(x, y int, dark bool) {
+	q.modules[y][x] = dark
+	q.function[y][x] = true
+}
+
+func (q *QRCode) drawFunctionPatterns(ver int) {
+	// Timing patterns
+	for i := 0; i < q.Size; i++ {
+		q.set(6, i, i%2 == 0)
+		q.set(i, 6, i%2 == 0)
+	}
+
+	// Finder patterns, including separators
+	for _, c := range [][2]int{{3, 3}, {q.Size - 4, 3}, {3, q.Size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := c[0]+dx, c[1]+dy
+				if x >= 0 && x < q.Size && y >= 0 && y < q.Size {
+					d := maxInt(abs(dx), abs(dy))
+					q.set(x, y, d != 2 && d != 4)
+				}
+			}
+		}
+	}
+
+	// Alignment patterns, except those overlapping the finder patterns
+	pos := alignmentPositions(ver)
+	for i, x := range pos {
+		for j, y := range pos {
+			if (i == 0 && j == 0) || (i == 0 && j == len(pos)-1) || (i == len(pos)-1 && j == 0) {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					q.set(x+dx, y+dy, maxInt(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// Reserve format bits area, real values will be drawn after masking
+	q.drawFormatBits()
+
+	// Version information
+	if ver >= 7 {
+		rem := ver
+		for i := 0; i < 12; i++ {
+			rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+		}
+		bits := ver<<12 | rem
+		for i := 0; i < 18; i++ {
+			dark := (bits>>uint(i))&1 != 0
+			a, b := q.Size-11+i%3, i/3
+			q.set(a, b, dark)
+			q.set(b, a, dark)
+		}
+	}
+}
+
+func (q *QRCode) drawFormatBits() {
+	data := eclM<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+	bit := func(i int) bool { return (bits>>uint(i))&1 != 0 }
+
+	// First copy, around the top left finder
+	for i := 0; i <= 5; i++ {
+		q.set(8, i, bit(i))
+	}
+	q.set(8, 7, bit(6))
+	q.set(8, 8, bit(7))
+	q.set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		q.set(14-i, 8, bit(i))
+	}
+
+	// Second copy, split between the other two finders
+	for i := 0; i < 8; i++ {
+		q.set(q.Size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		q.set(8, q.Size-15+i, bit(i))
+	}
+	q.set(8, q.Size-8, true) // the dark module
+}
+
+func (q *QRCode) drawCodewords(data []byte) {
+	i := 0
+	for right := q.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5 // skip the vertical timing pattern
+		}
+		for vert := 0; vert < q.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = q.Size - 1 - vert // upward
+				}
+				if !q.function[y][x] && i < len(data)*8 {
+					q.modules[y][x] = (data[i>>3]>>uint(7-i&7))&1 != 0
+					i++
+				}
+			}
+		}
+	}
+}
+
+func (q *QRCode) applyMask() {
+	for y := 0; y < q.Size; y++ {
+		for x := 0; x < q.Size; x++ {
+			if !q.function[y][x] && (x+y)%2 == 0 {
+				q.modules[y][x] = !q.modules[y][x]
+			}
+		}
+	}
+}
+
+func alignmentPositions(ver int) []int {
+	if ver == 1 {
+		return nil
+	}
+	n := ver/7 + 2
+	step := (ver*4 + n*2 + 1) / (n*2 - 2) * 2
+	res := make([]int, n)
+	res[0] = 6
+	for i, pos := n-1, ver*4+17-7; i >= 1; i, pos = i-1, pos-step {
+		res[i] = pos
+	}
+	return res
+}
+
+func numRawDataModules(ver int) int {
+	res := (16*ver+128)*ver + 64
+	if ver >= 2 {
+		n := ver/7 + 2
+		res -= (25*n-10)*n - 55
+		if ver >= 7 {
+			res -= 36
+		}
+	}
+	return res
+}
+
+func numDataCodewords(ver int) int {
+	return numRawDataModules(ver)/8 - eccPerBlock[ver]*numBlocks[ver]
+}
+
+func addECCAndInterleave(ver int, data []byte) []byte {
+	nb, ecc := numBlocks[ver], eccPerBlock[ver]
+	raw := numRawDataModules(ver) / 8
+	numShort := nb - raw%nb
+	shortLen := raw / nb
+
+	div := rsDivisor(ecc)
+	blocks := make([][]byte, nb)
+	for i, k := 0, 0; i < nb; i++ {
+		n := shortLen - ecc
+		if i >= numShort {
+			n++
+		}
+		dat := append([]byte{}, data[k:k+n]...)
+		k += n
+		rem := rsRemainder(dat, div)
+		if i < numShort {
+			dat = append(dat, 0) // placeholder, skipped when interleaving
+		}
+		blocks[i] = append(dat, rem...)
+	}
+
+	res := make([]byte, 0, raw)
+	for i := range blocks[0] {
+		for j, b := range blocks {
+			if i != shortLen-ecc || j >= numShort {
+				res = append(res, b[i])
+			}
+		}
+	}
+	return res
+}
+
+func rsDivisor(degree int) []byte {
+	res := make([]byte, degree)
+	res[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range res {
+			res[j] = gfMul(res[j], root)
+			if j+1 < len(res) {
+				res[j] ^= res[j+1]
+			}
+		}
+		root = gfMul(root, 0x02)
+	}
+	return res
+}
+
+func rsRemainder(data, div []byte) []byte {
+	res := make([]byte, len(div))
+	for _, b := range data {
+		factor := b ^ res[0]
+		copy(res, res[1:])
+		res[len(res)-1] = 0
+		for i := range res {
+			res[i] ^= gfMul(div[i], factor)
+		}
+	}
+	return res
+}
+
+func gfMul(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>uint(i))&1) * int(x)
+	}
+	return byte(z)
+}
+
+type bitBuffer []bool
+
+func (b *bitBuffer) append(v, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*b = append(*b, (v>>uint(i))&1 != 0)
+	}
+}
+
+func (b bitBuffer) bytes() []byte {
+	res := make([]byte, len(b)/8)
+	for i, v := range b {
+		if v {
+			res[i>>3] |= 1 << uint(7-i&7)
+		}
+	}
+	return res
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
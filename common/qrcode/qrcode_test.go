@@ -0,0 +1,46 @@
+package qrcode
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestReedSolomon(t *testing.T) {
+	// 1-M "HELLO WORLD" from the thonky.com tutorial
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	ecc := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if r := rsRemainder(data, rsDivisor(len(ecc))); !bytes.Equal(r, ecc) {
+		t.Fatal(r)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	q, err := Encode("otpauth://totp/iis:admin?secret=JBSWY3DPEHPK3PXP&issuer=iis")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Format bits of level M, mask 0 (the second copy)
+	var f int
+	for i := 14; i >= 8; i-- {
+		f = f<<1 | b2i(q.Get(8, q.Size-15+i))
+	}
+	for i := 7; i >= 0; i-- {
+		f = f<<1 | b2i(q.Get(q.Size-1-i, 8))
+	}
+	if f != 0x5412 {
+		t.Fatalf("%015b", f)
+	}
+
+	if _, err := Encode(string(make([]byte, 181))); err == nil {
+		t.Fatal("should fail")
+	}
+	t.Log(q.Size, len(q.PNGBase64(4)))
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,188 @@
+// Package stemmer implements the Porter stemming algorithm for English words,
+// see https://tartarus.org/martin/PorterStemmer/def.txt
+package stemmer
+
+type word []byte
+
+func (w word) cons(i int) bool {
+	switch w[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !w.cons(i-1)
+	}
+	return true
+}
+
+// measure returns m in [C](VC){m}[V]
+func (w word) measure() (m int) {
+	i, n := 0, len(w)
+	for i < n && w.cons(i) {
+		i++
+	}
+	for i < n {
+		for i < n && !w.cons(i) {
+			i++
+		}
+		if i >= n {
+			break
+		}
+		for i < n && w.cons(i) {
+			i++
+		}
+		m++
+	}
+	return m
+}
+
+func (w word) hasVowel() bool {
+	for i := range w {
+		if !w.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w word) doubleCons() bool {
+	n := len(w)
+	return n >= 2 && w[n-1] == w[n-2] && w.cons(n-1)
+}
+
+// cvc returns true if the word ends with consonant-vowel-consonant and the last one is not w, x or y
+func (w word) cvc() bool {
+	n := len(w)
+	if n < 3 || !w.cons(n-1) || w.cons(n-2) || !w.cons(n-3) {
+		return false
+	}
+	c := w[n-1]
+	return c != 'w' && c != 'x' && c != 'y'
+}
+
+func (w word) hasSuffix(s string) bool {
+	return len(w) >= len(s) && string(w[len(w)-len(s):]) == s
+}
+
+func (w word) stem(s string) word { return w[:len(w)-len(s)] }
+
+func (w word) replace(s, r string) word { return append(w.stem(s), r...) }
+
+type rule struct{ suffix, replacement string }
+
+// applyRules replaces the first matched suffix if the measure of the stem is greater than 'minM'
+func (w word) applyRules(rules []rule, minM int) word {
+	for _, r := range rules {
+		if w.hasSuffix(r.suffix) {
+			if w.stem(r.suffix).measure() > minM {
+				return w.replace(r.suffix, r.replacement)
+			}
+			return w
+		}
+	}
+	return w
+}
+
+var step2Rules = []rule{
+	{"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"}, {"anci", "ance"}, {"izer", "ize"},
+	{"bli", "ble"}, {"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"},
+	{"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"}, {"alism", "al"}, {"iveness", "ive"},
+	{"fulness", "ful"}, {"ousness", "ous"}, {"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"},
+	{"logi", "log"},
+}
+
+var step3Rules = []rule{
+	{"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"}, {"ical", "ic"}, {"ful", ""}, {"ness", ""},
+}
+
+var step4Suffixes = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment", "ent",
+	"ion", "ou", "ism", "ate", "iti", "ous", "ive", "ize",
+}
+
+func (w word) step1() word {
+	switch {
+	case w.hasSuffix("sses"), w.hasSuffix("ies"):
+		w = w[:len(w)-2]
+	case w.hasSuffix("ss"):
+	case w.hasSuffix("s"):
+		w = w[:len(w)-1]
+	}
+
+	if w.hasSuffix("eed") {
+		if w.stem("eed").measure() > 0 {
+			w = w[:len(w)-1]
+		}
+	} else {
+		removed := false
+		for _, s := range []string{"ed", "ing"} {
+			if w.hasSuffix(s) && w.stem(s).hasVowel() {
+				w, removed = w.stem(s), true
+				break
+			}
+		}
+		if removed {
+			switch {
+			case w.hasSuffix("at"), w.hasSuffix("bl"), w.hasSuffix("iz"):
+				w = append(w, 'e')
+			case w.doubleCons():
+				if c := w[len(w)-1]; c != 'l' && c != 's' && c != 'z' {
+					w = w[:len(w)-1]
+				}
+			case w.measure() == 1 && w.cvc():
+				w = append(w, 'e')
+			}
+		}
+	}
+
+	if w.hasSuffix("y") && w.stem("y").hasVowel() {
+		w[len(w)-1] = 'i'
+	}
+	return w
+}
+
+func (w word) step4() word {
+	for _, s := range step4Suffixes {
+		if !w.hasSuffix(s) {
+			continue
+		}
+		stem := w.stem(s)
+		if s == "ion" && (len(stem) == 0 || (stem[len(stem)-1] != 's' && stem[len(stem)-1] != 't')) {
+			return w
+		}
+		if stem.measure() > 1 {
+			return stem
+		}
+		return w
+	}
+	return w
+}
+
+func (w word) step5() word {
+	if w.hasSuffix("e") {
+		stem := w.stem("e")
+		if m := stem.measure(); m > 1 || (m == 1 && !stem.cvc()) {
+			w = stem
+		}
+	}
+	if w.hasSuffix("ll") && w.measure() > 1 {
+		w = w[:len(w)-1]
+	}
+	return w
+}
+
+// Stem returns the stem of a lowercase English word, words not made of a-z are returned as is
+func Stem(s string) string {
+	if len(s) <= 2 {
+		return s
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return s
+		}
+	}
+
+	w := word(s).step1()
+	w = w.applyRules(step2Rules, 0)
+	w = w.applyRules(step3Rules, 0)
+	return string(w.step4().step5())
+}
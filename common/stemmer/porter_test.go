@@ -0,0 +1,41 @@
+package stemmer
+
+import "testing"
+
+func TestStem(t *testing.T) {
+	for w, s := range map[string]string{
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"cats":           "cat",
+		"feed":           "feed",
+		"agreed":         "agre",
+		"plastered":      "plaster",
+		"motoring":       "motor",
+		"sing":           "sing",
+		"conflated":      "conflat",
+		"hopping":        "hop",
+		"falling":        "fall",
+		"filing":         "file",
+		"happy":          "happi",
+		"relational":     "relat",
+		"conditional":    "condit",
+		"rational":       "ration",
+		"generalization": "gener",
+		"connections":    "connect",
+		"connecting":     "connect",
+		"electrical":     "electr",
+		"adjustment":     "adjust",
+		"controlling":    "control",
+		"roll":           "roll",
+		"running":        "run",
+		"probate":        "probat",
+		"rate":           "rate",
+		"cease":          "ceas",
+		"go":             "go",
+		"c++":            "c++",
+	} {
+		if x := Stem(w); x != s {
+			t.Errorf("%s: expect %s, got %s", w, s, x)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package useragent
 
-import "github.com/avct/uasurfer"
+import (
+	"strings"
+
+	"github.com/avct/uasurfer"
+)
 
 const (
 	Unknown = 0
@@ -75,3 +79,39 @@ func StringToEnum(in string) int {
 	}
 	return Unknown
 }
+
+func EnumToString(v int) string {
+	switch v {
+	case Windows:
+		return "Windows"
+	case MacOSX:
+		return "MacOSX"
+	case Linux:
+		return "Linux"
+	case Android:
+		return "Android"
+	case iOS:
+		return "iOS"
+	case Mobile:
+		return "Mobile"
+	case Desktop:
+		return "Desktop"
+	case Tablet:
+		return "Tablet"
+	case Others:
+		return "Others"
+	case Bot:
+		return "Bot"
+	case User1:
+		return "User1"
+	}
+	return "Unknown"
+}
+
+func (ua UserAgents) String() string {
+	res := make([]string, len(ua))
+	for i, v := range ua {
+		res[i] = EnumToString(v)
+	}
+	return strings.Join(res, " ")
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
@@ -192,6 +193,23 @@ func ParseDuration(v string) time.Duration {
 	return d
 }
 
+// MaskIP masks the last octet of IPv4 (/24) or the last 48 bits of IPv6 (/80)
+func MaskIP(ip net.IP) string {
+	ip = append(net.IP{}, ip...)
+	if len(ip) == net.IPv4len {
+		ip[3] = 0 // \24
+	} else if len(ip) == net.IPv6len {
+		ip4 := ip.To4()
+		if ip4 != nil {
+			ip = ip4
+			ip[3] = 0
+		} else {
+			copy(ip[10:], "\x00\x00\x00\x00\x00\x00") // \80
+		}
+	}
+	return ip.String()
+}
+
 func PushIP(dataIP, ip string) string {
 	if ips := append(strings.Split(dataIP, ","), ip); len(ips) > 5 {
 		return strings.Join(ips[len(ips)-5:], ",")
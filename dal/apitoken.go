@@ -0,0 +1,131 @@
+package dal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	maxAPITokens   = 20
+	apiTokenPrefix = "api+"
+)
+
+// API tokens are stored in u/<user_id>/api_tokens: Extras[hash of secret] = JSON of model.APIToken,
+// the session part of the user token is "api+<secret>"
+func updateAPITokens(uid string, f func(a *model.Article) error) error {
+	return DoUpsertArticle(makeAPITokensID(uid), func(a *model.Article) error {
+		// Expired tokens are useless, drop them so they won't count toward maxAPITokens
+		for k, v := range a.Extras {
+			var t model.APIToken
+			if json.Unmarshal([]byte(v), &t) == nil && t.Expired() {
+				delete(a.Extras, k)
+			}
+		}
+		return f(a)
+	})
+}
+
+func CreateAPIToken(uid, label string, scope model.APIScope, expire time.Time) (string, error) {
+	secret := GenSession()
+	t := model.APIToken{
+		Label:  label,
+		Scope:  scope,
+		Create: time.Now(),
+		Expire: expire,
+	}
+	buf, _ := json.Marshal(t)
+	return ik.MakeUserToken(uid, apiTokenPrefix+secret), updateAPITokens(uid, func(a *model.Article) error {
+		if len(a.Extras) >= maxAPITokens {
+			return fmt.Errorf("e:too_many_api_tokens")
+		}
+		a.Extras[SessionHash(secret)] = string(buf)
+		return nil
+	})
+}
+
+func getAPIToken(uid, session string) (model.APIToken, bool) {
+	var t model.APIToken
+	if !strings.HasPrefix(session, apiTokenPrefix) {
+		return t, false
+	}
+	a, err := GetArticle(makeAPITokensID(uid))
+	if err != nil {
+		return t, false
+	}
+	t.ID = SessionHash(strings.TrimPrefix(session, apiTokenPrefix))
+	v, ok := a.Extras[t.ID]
+	if !ok || json.Unmarshal([]byte(v), &t) != nil || t.Expired() {
+		return t, false
+	}
+	return t, true
+}
+
+func GetAPITokens(u *model.User) []model.APIToken {
+	res := []model.APIToken{}
+	if u.APIToken != "" {
+		res = append(res, model.APIToken{ID: "legacy", Label: "API Token", Scope: model.ScopeAll, Legacy: true})
+	}
+
+	a, _ := GetArticle(makeAPITokensID(u.ID))
+	if a != nil {
+		for k, v := range a.Extras {
+			var t model.APIToken
+			if json.Unmarshal([]byte(v), &t) == nil {
+				t.ID = k
+				res = append(res, t)
+			}
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Create.After(res[j].Create) })
+	return res
+}
+
+func RevokeAPIToken(uid, hash string) error {
+	if hash == "legacy" {
+		_, err := DoUpdateUser(uid, "APIToken", "")
+		return err
+	}
+	return updateAPITokens(uid, func(a *model.Article) error {
+		delete(a.Extras, hash)
+		return nil
+	})
+}
+
+// TouchAPIToken records the last use time and IP of the token, at most once per 'sessionTouchSecs'
+func TouchAPIToken(u *model.User, g *gin.Context) {
+	session := u.CurrentSession()
+	if !u.IsAPI() || !strings.HasPrefix(session, apiTokenPrefix) {
+		return
+	}
+	hash := SessionHash(strings.TrimPrefix(session, apiTokenPrefix))
+	key := "u/" + u.ID + "/api-token-touch/" + hash
+	if v, ok := CacheGet(key); ok {
+		if t, _ := strconv.ParseInt(v, 10, 64); time.Now().Unix()-t < sessionTouchSecs {
+			return
+		}
+	}
+	CacheSet(key, strconv.FormatInt(time.Now().Unix(), 10))
+
+	ip := common.MaskIP(g.MustGet("ip").(net.IP))
+	go updateAPITokens(u.ID, func(a *model.Article) error {
+		var t model.APIToken
+		if v, ok := a.Extras[hash]; !ok || json.Unmarshal([]byte(v), &t) != nil {
+			return nil
+		}
+		t.LastUse, t.LastIP = time.Now(), ip
+		buf, _ := json.Marshal(t)
+		a.Extras[hash] = string(buf)
+		return nil
+	})
+}
@@ -0,0 +1,146 @@
+package dal
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+// Bookmarks are private, they are stored in their own chain (ik.IDBookmark) as cmd articles:
+// u/<user_id>/bookmark/<article_id>: Extras["bookmark"] = true/false, Extras["folder"] = folder,
+// folders are listed in u/<user_id>/bookmark_folders: Extras[folder] = create time.
+// Unlike likes, bookmarks never go into timelines and never notify authors
+func BookmarkArticle(uid, to, folder string, bookmarking bool) error {
+	folder = strings.TrimSpace(folder)
+	if utf8.RuneCountInString(folder) > 32 || strings.ContainsAny(folder, "/\n") {
+		return fmt.Errorf("e:invalid_folder")
+	}
+	if bookmarking && folder != "" {
+		if err := addBookmarkFolder(uid, folder); err != nil {
+			return err
+		}
+	}
+
+	id := makeBookmarkID(uid, to)
+	common.LockKey(id)
+	defer common.UnlockKey(id)
+
+	a, err := GetArticle(id)
+	if err == model.ErrNotExisted {
+		if !bookmarking {
+			return nil
+		}
+		toa, err := GetArticle(to)
+		if err != nil {
+			return err
+		}
+		if toa.IsDeleted() {
+			return fmt.Errorf("e:article_deleted")
+		}
+		_, _, err = DoInsertArticle(ik.NewID(ik.IDBookmark, uid).String(), false, model.Article{
+			ID:         id,
+			Cmd:        model.CmdBookmark,
+			Media:      toa.Media,
+			CreateTime: time.Now(),
+			Extras:     map[string]string{"to": toa.ID, "bookmark": "true", "folder": folder},
+		})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	a.Extras = common.DefaultMap(a.Extras)
+	a.Extras["bookmark"] = strconv.FormatBool(bookmarking)
+	if bookmarking {
+		a.Extras["folder"] = folder
+	}
+	return m.db.Set(a.ID, a.Marshal())
+}
+
+func IsBookmarking(from, to string) bool {
+	p, _ := WeakGetArticle(makeBookmarkID(from, to))
+	return p != nil && p.Extras["bookmark"] == "true"
+}
+
+func addBookmarkFolder(uid, folder string) error {
+	return DoUpsertArticle(makeBookmarkFoldersID(uid), func(a *model.Article) error {
+		if _, ok := a.Extras[folder]; ok {
+			return nil
+		}
+		if len(a.Extras) >= common.Cfg.MaxBookmarkFolders {
+			return fmt.Errorf("e:too_many_folders")
+		}
+		a.Extras[folder] = strconv.FormatInt(time.Now().Unix(), 10)
+		return nil
+	})
+}
+
+// DeleteBookmarkFolder removes the folder from the list, bookmarks inside it can still be found in all bookmarks
+func DeleteBookmarkFolder(uid, folder string) error {
+	return DoUpsertArticle(makeBookmarkFoldersID(uid), func(a *model.Article) error {
+		delete(a.Extras, folder)
+		return nil
+	})
+}
+
+func GetBookmarkFolders(uid string) []string {
+	res := []string{}
+	a, _ := GetArticle(makeBookmarkFoldersID(uid))
+	if a != nil {
+		for k := range a.Extras {
+			res = append(res, k)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// WalkBookmarks walks the bookmark chain of 'uid' starting from 'cursor' (empty means the beginning),
+// only bookmarks in 'folder' will be returned if it is not empty
+func WalkBookmarks(uid, folder string, media bool, n int, cursor string) (a []*model.Article, next string) {
+	if cursor == "" {
+		root, err := GetArticle(ik.NewID(ik.IDBookmark, uid).String())
+		if err != nil {
+			return nil, ""
+		}
+		cursor = root.PickNextID(media)
+	} else if !strings.HasPrefix(cursor, makeBookmarkID(uid, "")) {
+		return nil, ""
+	}
+
+	startTime := time.Now()
+	for len(a) < n && cursor != "" {
+		if time.Since(startTime).Seconds() > 1 {
+			log.Println("[mgr.WalkBookmarks] Break out slow walk at", cursor)
+			break
+		}
+
+		p, err := GetArticle(cursor)
+		if err != nil {
+			log.Println("[mgr.WalkBookmarks] Failed to get:", cursor, err)
+			break
+		}
+
+		if p.Extras["bookmark"] == "true" && (folder == "" || p.Extras["folder"] == folder) {
+			a2, err := GetArticle(p.Extras["to"])
+			if err == nil {
+				a = append(a, a2)
+			} else {
+				log.Println("[mgr.WalkBookmarks] Failed to get:", p.Extras["to"], err)
+			}
+		}
+
+		cursor = p.PickNextID(media)
+	}
+
+	return a, cursor
+}
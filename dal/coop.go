@@ -68,7 +68,7 @@ CHECK:
 	u.ID = id
 	u.Session = session
 	u.PasswordHash = passwordHash
-	u.Email = email
+	u.PendingEmail = email
 	u.TLogin = uint32(time.Now().Unix())
 	u.TSignup = uint32(time.Now().Unix())
 	u.DataIP = ip
@@ -93,9 +93,31 @@ func DoUpdateArticle(id string, f ...interface{}) (*model.Article, error) {
 	if a.Likes < 0 {
 		a.Likes = 0
 	}
+	if a.Reposts < 0 {
+		a.Reposts = 0
+	}
 	return a, m.db.Set(a.ID, a.Marshal())
 }
 
+// DoUpsertArticle updates the article, or creates an empty one if it doesn't exist
+func DoUpsertArticle(id string, f func(a *model.Article) error) error {
+	common.LockKey(id)
+	defer common.UnlockKey(id)
+
+	a, err := GetArticle(id)
+	if err == model.ErrNotExisted {
+		a, err = &model.Article{ID: id, CreateTime: time.Now()}, nil
+	}
+	if err != nil {
+		return err
+	}
+	a.Extras = common.DefaultMap(a.Extras)
+	if err := f(a); err != nil {
+		return err
+	}
+	return m.db.Set(a.ID, a.Marshal())
+}
+
 func DoInsertArticle(rootID string, isReply bool, a model.Article) (A, R model.Article, E error) {
 	if a.CreateTime.IsZero() || a.CreateTime == (time.Time{}) {
 		a.CreateTime = time.Now()
@@ -197,6 +219,10 @@ func DoInsertArticle(rootID string, isReply bool, a model.Article) (A, R model.A
 		return model.Article{}, model.Article{}, err
 	}
 
+	if x := ik.ParseID(rootID); x.Header() == ik.IDAuthor && !isReply {
+		go fanoutArticle(x.Tag(), a)
+	}
+
 	return a, *root, nil
 }
 
@@ -0,0 +1,74 @@
+package dal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/model"
+)
+
+// Drafts are stored in u/<user_id>/drafts: Extras[draft_id] = JSON of model.Draft
+// SaveDraft creates a new draft if d.ID is empty, otherwise it overwrites the existing one
+func SaveDraft(uid string, d *model.Draft) (string, error) {
+	isNew := d.ID == ""
+	if isNew {
+		d.ID = strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	d.Update = time.Now()
+
+	return d.ID, DoUpsertArticle(makeDraftsID(uid), func(a *model.Article) error {
+		if _, ok := a.Extras[d.ID]; !ok {
+			if !isNew {
+				return fmt.Errorf("e:draft_not_found")
+			}
+			if len(a.Extras) >= common.Cfg.MaxDrafts {
+				return fmt.Errorf("e:too_many_drafts")
+			}
+		}
+		buf, _ := json.Marshal(d)
+		a.Extras[d.ID] = string(buf)
+		return nil
+	})
+}
+
+func GetDraft(uid, id string) (*model.Draft, error) {
+	a, err := GetArticle(makeDraftsID(uid))
+	if err != nil && err != model.ErrNotExisted {
+		return nil, err
+	}
+	d := &model.Draft{}
+	if a == nil || a.Extras[id] == "" || json.Unmarshal([]byte(a.Extras[id]), d) != nil {
+		return nil, fmt.Errorf("e:draft_not_found")
+	}
+	d.ID = id
+	return d, nil
+}
+
+// GetDrafts returns drafts of 'uid', the latest updated first
+func GetDrafts(uid string) []*model.Draft {
+	res := []*model.Draft{}
+	a, _ := GetArticle(makeDraftsID(uid))
+	if a == nil {
+		return res
+	}
+	for k, v := range a.Extras {
+		d := &model.Draft{}
+		if json.Unmarshal([]byte(v), d) == nil {
+			d.ID = k
+			res = append(res, d)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Update.After(res[j].Update) })
+	return res
+}
+
+func DeleteDraft(uid, id string) error {
+	return DoUpsertArticle(makeDraftsID(uid), func(a *model.Article) error {
+		delete(a.Extras, id)
+		return nil
+	})
+}
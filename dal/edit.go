@@ -0,0 +1,117 @@
+package dal
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/model"
+)
+
+// Versions of an edited article are stored in rev/<article_id>/<n>, rev 0 is the original one
+// and rev <a.Revisions> is the current one, each record's Author is the editor
+func CanEdit(a *model.Article, u *model.User) bool {
+	return u != nil && a.Author == u.ID && !a.IsDeleted() && a.Cmd == model.CmdNone && a.ReferID == "" &&
+		common.Cfg.EditWindow > 0 && time.Since(a.CreateTime) < time.Duration(common.Cfg.EditWindow)*time.Minute
+}
+
+func EditArticle(u *model.User, id, content string) (*model.Article, error) {
+	var olds []string
+	a, err := DoUpdateArticle(id, func(a *model.Article) error {
+		if a.Author != u.ID || a.IsDeleted() || a.Cmd != model.CmdNone || a.ReferID != "" {
+			return fmt.Errorf("e:cannot_edit")
+		}
+		if !CanEdit(a, u) {
+			return fmt.Errorf("e:edit_window_passed")
+		}
+		if len(content) < 3 && a.Media == "" {
+			return fmt.Errorf("e:content_too_short")
+		}
+		if content == a.Content {
+			return fmt.Errorf("e:content_not_changed")
+		}
+
+		if a.Revisions == 0 {
+			if err := m.db.Set(makeRevisionID(a.ID, 0), (&model.Article{
+				ID:         makeRevisionID(a.ID, 0),
+				Content:    a.Content,
+				Author:     a.Author,
+				CreateTime: a.CreateTime,
+			}).Marshal()); err != nil {
+				return err
+			}
+		}
+		for _, r := range GetRevisions(a.ID, a.Revisions) {
+			olds = append(olds, r.Content)
+		}
+
+		a.Revisions++
+		if err := m.db.Set(makeRevisionID(a.ID, a.Revisions), (&model.Article{
+			ID:         makeRevisionID(a.ID, a.Revisions),
+			Content:    content,
+			Author:     u.ID,
+			CreateTime: time.Now(),
+		}).Marshal()); err != nil {
+			return err
+		}
+		a.Content = content
+		a.History += fmt.Sprintf("{edit_by:%s:%v}", u.ID, time.Now().Unix())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		indexArticle(*a)
+		mentionNewOnly(a, olds)
+	}()
+	return a, nil
+}
+
+// mentionNewOnly notifies users and tags which never appeared in any of the previous versions
+func mentionNewOnly(a *model.Article, olds []string) {
+	seen := map[string]bool{}
+	for _, c := range olds {
+		ids, tags := common.ExtractMentionsAndTags(c)
+		for _, id := range ids {
+			seen["@"+id] = true
+		}
+		for _, tag := range tags {
+			seen["#"+tag] = true
+		}
+	}
+
+	newIDs, newTags := []string{}, []string{}
+	ids, tags := common.ExtractMentionsAndTags(a.Content)
+	for _, id := range ids {
+		if !seen["@"+id] {
+			newIDs = append(newIDs, id)
+		}
+	}
+	for _, tag := range tags {
+		if !seen["#"+tag] {
+			newTags = append(newTags, tag)
+		}
+	}
+	if err := MentionUserAndTags(a, newIDs, newTags); err != nil {
+		log.Println("[EditArticle] mention:", a.ID, err)
+	}
+}
+
+// GetRevisions returns versions of article 'id' from rev 0 to rev 'n', missing ones are skipped
+func GetRevisions(id string, n int32) []*model.Article {
+	res := []*model.Article{}
+	for i := int32(0); i <= n; i++ {
+		r, err := GetArticle(makeRevisionID(id, i))
+		if err != nil {
+			if err != model.ErrNotExisted {
+				log.Println("[GetRevisions] Failed to get:", id, i, err)
+			}
+			continue
+		}
+		res = append(res, r)
+	}
+	return res
+}
@@ -0,0 +1,184 @@
+// Package feed stores materialized home feeds in redis:
+//
+//	feed:<user_id>: zset of "<article_id>|<owner_id>" scored by article time (ms), bounded in size
+//	feed:p:<user_id>: set of IDs (users or #tags) whose articles are pulled at read time instead
+//	feed:m:<user_id>: marker of a built feed, articles will be pushed only to built feeds
+package feed
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/dal/storage"
+	"github.com/gomodule/redigo/redis"
+)
+
+var p *redis.Pool
+
+func Init(redisConfig *storage.RedisConfig) {
+	p = storage.NewGlobalCache(redisConfig).Pool
+}
+
+type Item struct {
+	ID    string
+	Owner string // owner of the timeline which the article comes from
+	Time  int64  // ms
+}
+
+func (i Item) member() string { return i.ID + "|" + i.Owner }
+
+func parseItem(member string, score int64) Item {
+	idx := strings.LastIndex(member, "|")
+	if idx == -1 {
+		return Item{ID: member, Time: score}
+	}
+	return Item{ID: member[:idx], Owner: member[idx+1:], Time: score}
+}
+
+func feedKey(uid string) string   { return "feed:" + uid }
+func pullKey(uid string) string   { return "feed:p:" + uid }
+func markerKey(uid string) string { return "feed:m:" + uid }
+
+// Push the item into a built feed and trim it to 'size'
+var pushScript = redis.NewScript(2, `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('ZADD', KEYS[2], ARGV[2], ARGV[1])
+redis.call('ZREMRANGEBYRANK', KEYS[2], 0, -tonumber(ARGV[3]) - 1)
+redis.call('PEXPIRE', KEYS[2], ARGV[4])
+return 1
+`)
+
+var removeOwnerScript = redis.NewScript(1, `
+local suffix, n = '|' .. ARGV[1], 0
+for _, m in ipairs(redis.call('ZRANGE', KEYS[1], 0, -1)) do
+	if string.sub(m, -#suffix) == suffix then
+		n = n + redis.call('ZREM', KEYS[1], m)
+	end
+end
+return n
+`)
+
+// Push pushes the item into feeds of 'uids' which have been built, feeds are trimmed to 'size' and expired after 'ttl'
+func Push(uids []string, item Item, size int, ttl time.Duration) error {
+	if len(uids) == 0 {
+		return nil
+	}
+	c := p.Get()
+	defer c.Close()
+
+	for _, uid := range uids {
+		if err := pushScript.Send(c, markerKey(uid), feedKey(uid), item.member(), item.Time, size, ttl.Milliseconds()); err != nil {
+			return err
+		}
+	}
+	if err := c.Flush(); err != nil {
+		return err
+	}
+	for range uids {
+		if _, err := c.Receive(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Build resets the feed of 'uid' with 'items' and pull sources, then marks it built for 'ttl'
+func Build(uid string, items []Item, pulls []string, size int, ttl time.Duration) error {
+	c := p.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("DEL", feedKey(uid), pullKey(uid))
+	for _, item := range items {
+		c.Send("ZADD", feedKey(uid), item.Time, item.member())
+	}
+	c.Send("ZREMRANGEBYRANK", feedKey(uid), 0, -size-1)
+	c.Send("PEXPIRE", feedKey(uid), ttl.Milliseconds())
+	for _, id := range pulls {
+		c.Send("SADD", pullKey(uid), id)
+	}
+	c.Send("PEXPIRE", pullKey(uid), ttl.Milliseconds())
+	c.Send("SET", markerKey(uid), time.Now().Unix(), "PX", ttl.Milliseconds())
+	_, err := c.Do("EXEC")
+	return err
+}
+
+// Built returns true if the feed has been built and not expired
+func Built(uid string) (bool, error) {
+	c := p.Get()
+	defer c.Close()
+	return redis.Bool(c.Do("EXISTS", markerKey(uid)))
+}
+
+// Add adds items into the feed without checking whether it has been built, used for backfill
+func Add(uid string, items []Item, size int) error {
+	if len(items) == 0 {
+		return nil
+	}
+	c := p.Get()
+	defer c.Close()
+
+	args := redis.Args{feedKey(uid)}
+	for _, item := range items {
+		args = args.Add(item.Time, item.member())
+	}
+	c.Send("ZADD", args...)
+	_, err := c.Do("ZREMRANGEBYRANK", feedKey(uid), 0, -size-1)
+	return err
+}
+
+// Range returns at most 'n' items whose time is in (min, max), newest first
+func Range(uid string, max, min int64, n int) ([]Item, error) {
+	c := p.Get()
+	defer c.Close()
+
+	res, err := redis.Strings(c.Do("ZREVRANGEBYSCORE", feedKey(uid),
+		"("+strconv.FormatInt(max, 10), "("+strconv.FormatInt(min, 10), "WITHSCORES", "LIMIT", 0, n))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(res)/2)
+	for i := 0; i+1 < len(res); i += 2 {
+		score, _ := strconv.ParseInt(res[i+1], 10, 64)
+		items = append(items, parseItem(res[i], score))
+	}
+	return items, nil
+}
+
+// RemoveOwner removes all items coming from the timeline of 'owner'
+func RemoveOwner(uid, owner string) error {
+	c := p.Get()
+	defer c.Close()
+	_, err := removeOwnerScript.Do(c, feedKey(uid), owner)
+	return err
+}
+
+func Pulls(uid string) ([]string, error) {
+	c := p.Get()
+	defer c.Close()
+	return redis.Strings(c.Do("SMEMBERS", pullKey(uid)))
+}
+
+func SetPull(uid, id string, pull bool) error {
+	c := p.Get()
+	defer c.Close()
+
+	cmd := "SREM"
+	if pull {
+		cmd = "SADD"
+	}
+	_, err := c.Do(cmd, pullKey(uid), id)
+	return err
+}
+
+// Reset drops the feed, it will be rebuilt on next read
+func Reset(uid string) error {
+	c := p.Get()
+	defer c.Close()
+	_, err := c.Do("DEL", feedKey(uid), pullKey(uid), markerKey(uid))
+	return err
+}
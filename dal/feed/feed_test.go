@@ -0,0 +1,62 @@
+package feed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/coyove/iis/dal/storage"
+)
+
+func initMock(t *testing.T) {
+	svr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(svr.Close)
+	Init(&storage.RedisConfig{Addr: svr.Addr()})
+}
+
+func TestFeed(t *testing.T) {
+	initMock(t)
+
+	if err := Build("a", []Item{{"1", "x", 1}, {"2", "y", 2}}, []string{"#tag"}, 3, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := Built("a"); !ok {
+		t.Fatal("not built")
+	}
+
+	// 'b' is not built, so nothing will be pushed into it
+	for i := int64(3); i <= 4; i++ {
+		if err := Push([]string{"a", "b"}, Item{ID: string(rune('0' + i)), Owner: "x", Time: i}, 3, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if items, _ := Range("b", 100, 0, 10); len(items) != 0 {
+		t.Fatal(items)
+	}
+
+	items, err := Range("a", 100, 0, 10)
+	if err != nil || len(items) != 3 || items[0].ID != "4" || items[2].ID != "2" || items[2].Owner != "y" {
+		t.Fatal(items, err)
+	}
+	if items, _ := Range("a", 4, 2, 10); len(items) != 1 || items[0].ID != "3" {
+		t.Fatal(items)
+	}
+
+	RemoveOwner("a", "x")
+	if items, _ := Range("a", 100, 0, 10); len(items) != 1 || items[0].ID != "2" {
+		t.Fatal(items)
+	}
+
+	SetPull("a", "big", true)
+	if pulls, _ := Pulls("a"); len(pulls) != 2 {
+		t.Fatal(pulls)
+	}
+
+	Reset("a")
+	if ok, _ := Built("a"); ok {
+		t.Fatal("not reset")
+	}
+}
@@ -0,0 +1,264 @@
+package dal
+
+import (
+	"bytes"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal/feed"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+// The home feed is materialized in redis when 'HomeFeed' is on: articles inserted into users' timelines
+// are pushed into their followers' feeds. Timelines of tags and accounts with more than 'HomeFeedFanout'
+// followers are pulled at read time instead, they are recorded as pull sources when the feed is built
+var feedCursorPrefix = []byte("feed:")
+
+func feedTTL() time.Duration { return time.Duration(common.Cfg.HomeFeedTTL) * time.Hour }
+
+func feedTime(id string) int64 { return ik.ParseID(id).Time().UnixNano() / 1e6 }
+
+// shouldPull allows some overlap with pushing, so accounts growing beyond the limit won't be missed
+func shouldPull(u *model.User) bool { return int(u.Followers) > common.Cfg.HomeFeedFanout*4/5 }
+
+func shouldPush(u *model.User) bool { return int(u.Followers) <= common.Cfg.HomeFeedFanout }
+
+// chainOwner returns the owner of the timeline which the article is inserted into
+func chainOwner(a *model.Article) string {
+	if a.Cmd == model.CmdTimelineLike {
+		return a.Extras["from"]
+	}
+	if a.T_RepostBy != "" {
+		return a.T_RepostBy
+	}
+	return a.Author
+}
+
+func walkFollowers(uid string, f func(follower string)) {
+	root, err := GetArticle(ik.NewID(ik.IDFollower, uid).String())
+	if err != nil {
+		return
+	}
+	for cursor := root.NextID; strings.HasPrefix(cursor, "u/"); {
+		a, err := GetArticle(cursor)
+		if err != nil {
+			log.Println("[HomeFeed] walk followers:", cursor, err)
+			return
+		}
+		if a.Extras["followed"] == "true" {
+			f(a.Extras["to"])
+		}
+		cursor = a.NextID
+	}
+}
+
+// fanoutArticle pushes the article inserted into the timeline of 'owner' to its followers' feeds
+func fanoutArticle(owner string, a model.Article) {
+	if !common.Cfg.HomeFeed || (a.ReferID != "" && a.Author == "") { // referrals of master timelines
+		return
+	}
+	u, _ := WeakGetUser(owner)
+	if u == nil {
+		return
+	}
+
+	uids := []string{owner}
+	if shouldPush(u) {
+		walkFollowers(owner, func(follower string) {
+			if IsBlocking(follower, owner) {
+				return
+			}
+			if u.FollowApply != 0 {
+				if following, accepted := IsFollowingWithAcceptance(follower, u); !following || !accepted {
+					return
+				}
+			}
+			uids = append(uids, follower)
+		})
+	}
+
+	item := feed.Item{ID: a.ID, Owner: owner, Time: feedTime(a.ID)}
+	for len(uids) > 0 {
+		batch := uids
+		if len(batch) > 500 {
+			batch = batch[:500]
+		}
+		if err := feed.Push(batch, item, common.Cfg.HomeFeedSize, feedTTL()); err != nil {
+			log.Println("[HomeFeed] push:", owner, a.ID, err)
+			return
+		}
+		uids = uids[len(batch):]
+	}
+}
+
+func articlesToFeedItems(as []*model.Article) []feed.Item {
+	items := make([]feed.Item, 0, len(as))
+	for _, a := range as {
+		if owner := chainOwner(a); owner != "" {
+			items = append(items, feed.Item{ID: a.ID, Owner: owner, Time: feedTime(a.ID)})
+		}
+	}
+	return items
+}
+
+// buildHomeFeed fills the feed with recent articles of followings, tags and big accounts become pull sources
+func buildHomeFeed(u *model.User) error {
+	cursors := []ik.ID{ik.NewID(ik.IDAuthor, u.ID)}
+	pulls := []string{}
+
+	list, _ := GetFollowingList(ik.NewID(ik.IDFollowing, u.ID), "", 1e6, false)
+	for _, f := range list {
+		if !f.Followed {
+			continue
+		}
+		if strings.HasPrefix(f.ID, "#") {
+			pulls = append(pulls, f.ID)
+			continue
+		}
+		fu, _ := WeakGetUser(f.ID)
+		if fu == nil {
+			continue
+		}
+		if shouldPull(fu) {
+			pulls = append(pulls, f.ID)
+		}
+		if shouldPush(fu) {
+			cursors = append(cursors, ik.NewID(ik.IDAuthor, f.ID))
+		}
+	}
+
+	as, _ := WalkMulti(false, common.Cfg.HomeFeedSize, cursors...)
+	return feed.Build(u.ID, articlesToFeedItems(as), pulls, common.Cfg.HomeFeedSize, feedTTL())
+}
+
+// backfillHomeFeed adds recent articles of the newly followed account into the feed of 'uid'
+func backfillHomeFeed(uid string, to *model.User) {
+	if ok, _ := feed.Built(uid); !ok {
+		return
+	}
+	if shouldPull(to) {
+		feed.SetPull(uid, to.ID, true)
+	}
+	if !shouldPush(to) {
+		return
+	}
+	as, _ := WalkMulti(false, common.Cfg.HomeFeedBackfill, ik.NewID(ik.IDAuthor, to.ID))
+	if err := feed.Add(uid, articlesToFeedItems(as), common.Cfg.HomeFeedSize); err != nil {
+		log.Println("[HomeFeed] backfill:", uid, to.ID, err)
+	}
+}
+
+// onFollowChanged updates the feed of 'from' after following/unfollowing 'to'
+func onFollowChanged(from, to string, following bool) {
+	if !common.Cfg.HomeFeed {
+		return
+	}
+	if strings.HasPrefix(to, "#") {
+		if ok, _ := feed.Built(from); ok {
+			feed.SetPull(from, to, following)
+		}
+		return
+	}
+	if !following {
+		feed.SetPull(from, to, false)
+		feed.RemoveOwner(from, to)
+		return
+	}
+	toUser, _ := WeakGetUser(to)
+	if toUser == nil {
+		return
+	}
+	if toUser.FollowApply != 0 {
+		if _, accepted := IsFollowingWithAcceptance(from, toUser); !accepted {
+			return // backfill after being accepted
+		}
+	}
+	backfillHomeFeed(from, toUser)
+}
+
+func IsHomeFeedCursor(cursor string) bool {
+	_, payload := ik.SplitIDs(cursor)
+	return bytes.HasPrefix(payload, feedCursorPrefix)
+}
+
+// WalkHome reads the home feed of 'u', articles of pull sources are merged in by time:
+// only feed items newer than the pulling progress are returned, so the two sides won't skip each other
+func WalkHome(u *model.User, media bool, n int, cursor string) (a []*model.Article, next string) {
+	var pulls []ik.ID
+	before := time.Now().Add(time.Hour).UnixNano() / 1e6
+
+	if cursor == "" {
+		if ok, _ := feed.Built(u.ID); !ok {
+			if err := buildHomeFeed(u); err != nil {
+				log.Println("[HomeFeed] build:", u.ID, err)
+			}
+		}
+		ids, _ := feed.Pulls(u.ID)
+		for _, id := range ids {
+			if strings.HasPrefix(id, "#") {
+				pulls = append(pulls, ik.NewID(ik.IDTag, id[1:]))
+			} else {
+				pulls = append(pulls, ik.NewID(ik.IDAuthor, id))
+			}
+		}
+	} else {
+		var payload []byte
+		pulls, payload = ik.SplitIDs(cursor)
+		if !bytes.HasPrefix(payload, feedCursorPrefix) {
+			return nil, ""
+		}
+		before, _ = strconv.ParseInt(string(payload[len(feedCursorPrefix):]), 10, 64)
+	}
+
+	var cutoff int64
+	if len(pulls) > 0 {
+		var pulled []*model.Article
+		pulled, pulls = WalkMulti(media, n, pulls...)
+		a = append(a, pulled...)
+
+		valid := pulls[:0]
+		for _, c := range pulls {
+			if !c.Valid() {
+				continue
+			}
+			valid = append(valid, c)
+			if c.IsRoot() {
+				cutoff = before // the chain hasn't been walked, so nothing in the feed is safe to show
+			} else if t := c.Time().UnixNano() / 1e6; t > cutoff {
+				cutoff = t
+			}
+		}
+		pulls = valid
+	}
+
+	items, err := feed.Range(u.ID, before, cutoff, n)
+	if err != nil {
+		log.Println("[HomeFeed] range:", u.ID, err)
+	}
+
+	dedup := map[string]bool{}
+	for _, p := range a {
+		dedup[p.ID] = true
+	}
+	for _, item := range items {
+		p, err := WeakGetArticle(item.ID)
+		if err != nil || dedup[p.ID] || p.Content == model.DeletionMarker || (media && p.Media == "") {
+			continue
+		}
+		dedup[p.ID] = true
+		a = append(a, p)
+	}
+	sort.SliceStable(a, func(i, j int) bool { return feedTime(a[i].ID) > feedTime(a[j].ID) })
+
+	if len(items) == n {
+		before = items[len(items)-1].Time
+	} else if before = cutoff + 1; len(pulls) == 0 {
+		return a, ""
+	}
+	return a, ik.CombineIDs(append(append([]byte{}, feedCursorPrefix...), strconv.FormatInt(before, 10)...), pulls...)
+}
@@ -0,0 +1,291 @@
+// Package index stores the inverted search index in redis:
+//
+//	idx:t:<term>: zset of article IDs scored by article time (ms)
+//	idx:f:<term>: hash of article ID -> "<tf>,<doc_len>", only for text terms
+//	idx:d:<article_id>: "<doc_len> <term> <term>...", indexed terms of the article, used to unindex it
+//	idx:stats: hash of "docs" and "len", total number and length of indexed articles
+//	idx:s:<term>: hash of "<user_id>/<search_id>" -> query, saved searches routed by the term
+//
+// Text terms are bounded in size, the oldest articles are trimmed out, filter terms (tf = 0) are not bounded.
+// Articles are persisted in the KV, so the index can always be rebuilt from them, see dal.RebuildSearchIndex.
+package index
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/coyove/iis/dal/storage"
+	"github.com/gomodule/redigo/redis"
+)
+
+var p *redis.Pool
+
+func Init(redisConfig *storage.RedisConfig) {
+	p = storage.NewGlobalCache(redisConfig).Pool
+}
+
+const statsKey = "idx:stats"
+
+func docKey(id string) string { return "idx:d:" + id }
+
+type Posting struct {
+	TF, Len int
+}
+
+func parsePosting(v string) (p Posting) {
+	idx := strings.Index(v, ",")
+	if idx == -1 {
+		return
+	}
+	p.TF, _ = strconv.Atoi(v[:idx])
+	p.Len, _ = strconv.Atoi(v[idx+1:])
+	return
+}
+
+// Remove the old index of the article, then add the new one if provided, all in one go
+// KEYS: doc, stats; ARGV: id, time, limit, doc_len, then pairs of term and tf
+var indexScript = redis.NewScript(2, `
+local id = ARGV[1]
+local old = redis.call('GET', KEYS[1])
+if old then
+	local first = true
+	for w in string.gmatch(old, '%S+') do
+		if first then
+			redis.call('HINCRBY', KEYS[2], 'docs', -1)
+			redis.call('HINCRBY', KEYS[2], 'len', -tonumber(w))
+			first = false
+		else
+			redis.call('ZREM', 'idx:t:' .. w, id)
+			redis.call('HDEL', 'idx:f:' .. w, id)
+		end
+	end
+	redis.call('DEL', KEYS[1])
+end
+if #ARGV <= 4 then
+	return 0
+end
+
+local score, limit, len, terms = ARGV[2], tonumber(ARGV[3]), ARGV[4], {ARGV[4]}
+for i = 5, #ARGV, 2 do
+	local term, tf = ARGV[i], ARGV[i + 1]
+	local t = 'idx:t:' .. term
+	redis.call('ZADD', t, score, id)
+	if tf ~= '0' then
+		local f = 'idx:f:' .. term
+		redis.call('HSET', f, id, tf .. ',' .. len)
+		local n = redis.call('ZCARD', t)
+		if n > limit + 16 then -- trim the posting after it exceeds the limit by 16
+			local olds = redis.call('ZRANGE', t, 0, n - limit - 1)
+			redis.call('ZREMRANGEBYRANK', t, 0, n - limit - 1)
+			redis.call('HDEL', f, unpack(olds))
+		end
+	end
+	terms[#terms + 1] = term
+end
+redis.call('SET', KEYS[1], table.concat(terms, ' '))
+redis.call('HINCRBY', KEYS[2], 'docs', 1)
+redis.call('HINCRBY', KEYS[2], 'len', len)
+return 1
+`)
+
+// Returns IDs in ARGV which are in all zsets of KEYS
+var filterScript = redis.NewScript(-1, `
+local res = {}
+for i = 1, #ARGV do
+	local ok = true
+	for _, k in ipairs(KEYS) do
+		if not redis.call('ZSCORE', k, ARGV[i]) then
+			ok = false
+			break
+		end
+	end
+	if ok then
+		res[#res + 1] = ARGV[i]
+	end
+end
+return res
+`)
+
+// Add replaces the index of article 'id' created at 't' (ms), 'terms' maps terms to their frequencies, tf = 0 for filter terms.
+// Text terms are bounded to 'limit' articles
+func Add(id string, t int64, terms map[string]int, limit int) error {
+	length := 0
+	args := redis.Args{docKey(id), statsKey, id, t, limit, 0}
+	for term, tf := range terms {
+		length += tf
+		args = args.Add(term, tf)
+	}
+	args[5] = length
+
+	c := p.Get()
+	defer c.Close()
+	_, err := indexScript.Do(c, args...)
+	return err
+}
+
+// Remove removes article 'id' from the index
+func Remove(id string) error {
+	c := p.Get()
+	defer c.Close()
+	_, err := indexScript.Do(c, docKey(id), statsKey, id, 0, 0, 0)
+	return err
+}
+
+// Exists returns false if the index has never been built, or has been lost along with redis
+func Exists() (bool, error) {
+	c := p.Get()
+	defer c.Close()
+	return redis.Bool(c.Do("EXISTS", statsKey))
+}
+
+// Stats returns the total number and length of indexed articles
+func Stats() (docs, length int, err error) {
+	c := p.Get()
+	defer c.Close()
+
+	res, err := redis.Ints(c.Do("HMGET", statsKey, "docs", "len"))
+	if err != nil {
+		return 0, 0, err
+	}
+	return res[0], res[1], nil
+}
+
+// Postings returns postings of text terms in one round trip
+func Postings(terms []string) (map[string]map[string]Posting, error) {
+	c := p.Get()
+	defer c.Close()
+
+	for _, term := range terms {
+		if err := c.Send("HGETALL", "idx:f:"+term); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.Flush(); err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]map[string]Posting, len(terms))
+	for _, term := range terms {
+		m, err := redis.StringMap(c.Receive())
+		if err != nil {
+			return nil, err
+		}
+		posting := make(map[string]Posting, len(m))
+		for id, v := range m {
+			posting[id] = parsePosting(v)
+		}
+		res[term] = posting
+	}
+	return res, nil
+}
+
+// Filter returns IDs in 'ids' which contain all 'terms'
+func Filter(ids []string, terms []string) (map[string]bool, error) {
+	res := make(map[string]bool, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	args := redis.Args{len(terms)}
+	for _, term := range terms {
+		args = args.Add("idx:t:" + term)
+	}
+	args = args.AddFlat(ids)
+
+	c := p.Get()
+	defer c.Close()
+	matched, err := redis.Strings(filterScript.Do(c, args...))
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range matched {
+		res[id] = true
+	}
+	return res, nil
+}
+
+// Latest returns at most 'n' newest IDs which contain all 'terms'
+func Latest(terms []string, n int) ([]string, error) {
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	if len(terms) == 1 {
+		return redis.Strings(c.Do("ZREVRANGE", "idx:t:"+terms[0], 0, n-1))
+	}
+
+	buf := make([]byte, 8)
+	rand.Read(buf)
+	tmp := "idx:tmp:" + hex.EncodeToString(buf)
+
+	args := redis.Args{tmp, len(terms)}
+	for _, term := range terms {
+		args = args.Add("idx:t:" + term)
+	}
+	c.Send("MULTI")
+	c.Send("ZINTERSTORE", args.Add("AGGREGATE", "MAX")...)
+	c.Send("ZREVRANGE", tmp, 0, n-1)
+	c.Send("DEL", tmp)
+	res, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return nil, err
+	}
+	return redis.Strings(res[1], nil)
+}
+
+// Subscribe registers the saved search 'key' under 'terms', articles containing any of them will be matched against 'query'
+func Subscribe(key string, terms []string, query string) error {
+	c := p.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	for _, term := range terms {
+		c.Send("HSET", "idx:s:"+term, key, query)
+	}
+	_, err := c.Do("EXEC")
+	return err
+}
+
+func Unsubscribe(key string, terms []string) error {
+	c := p.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	for _, term := range terms {
+		c.Send("HDEL", "idx:s:"+term, key)
+	}
+	_, err := c.Do("EXEC")
+	return err
+}
+
+// Subscriptions returns saved searches registered under any of 'terms' in one round trip
+func Subscriptions(terms []string) (map[string]string, error) {
+	c := p.Get()
+	defer c.Close()
+
+	for _, term := range terms {
+		if err := c.Send("HGETALL", "idx:s:"+term); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.Flush(); err != nil {
+		return nil, err
+	}
+
+	res := map[string]string{}
+	for range terms {
+		m, err := redis.StringMap(c.Receive())
+		if err != nil {
+			return nil, err
+		}
+		for k, q := range m {
+			res[k] = q
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,85 @@
+package index
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/coyove/iis/dal/storage"
+)
+
+func initMock(t *testing.T) {
+	svr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(svr.Close)
+	Init(&storage.RedisConfig{Addr: svr.Addr()})
+}
+
+func TestIndex(t *testing.T) {
+	initMock(t)
+
+	if ok, _ := Exists(); ok {
+		t.Fatal("index exists")
+	}
+
+	// filter terms are never trimmed
+	for i := 1; i <= 40; i++ {
+		if err := Add(strconv.Itoa(i), int64(i), map[string]int{"foo": 2, "from:x": 0}, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if docs, length, err := Stats(); err != nil || docs != 40 || length != 80 {
+		t.Fatal(docs, length, err)
+	}
+	if ok, _ := Exists(); !ok {
+		t.Fatal("index not exists")
+	}
+	ps, err := Postings([]string{"foo"})
+	if err != nil || len(ps["foo"]) > 10+16 || ps["foo"]["40"] != (Posting{2, 2}) || ps["foo"]["1"] != (Posting{}) {
+		t.Fatal(ps, err)
+	}
+	if ids, _ := Latest([]string{"from:x"}, 100); len(ids) != 40 || ids[0] != "40" {
+		t.Fatal(ids)
+	}
+
+	// replace the index of '40'
+	Add("40", 40, map[string]int{"bar": 1, "from:x": 0, "#t": 0}, 10)
+	if docs, length, _ := Stats(); docs != 40 || length != 79 {
+		t.Fatal(docs, length)
+	}
+	if ps, _ := Postings([]string{"foo", "bar"}); ps["foo"]["40"] != (Posting{}) || ps["bar"]["40"] != (Posting{1, 1}) {
+		t.Fatal(ps)
+	}
+	if ids, _ := Latest([]string{"from:x", "#t"}, 100); len(ids) != 1 || ids[0] != "40" {
+		t.Fatal(ids)
+	}
+	if m, _ := Filter([]string{"1", "39", "40"}, []string{"from:x", "#t"}); len(m) != 1 || !m["40"] {
+		t.Fatal(m)
+	}
+
+	Remove("40")
+	Remove("40")
+	if docs, length, _ := Stats(); docs != 39 || length != 78 {
+		t.Fatal(docs, length)
+	}
+	if ids, _ := Latest([]string{"from:x", "#t"}, 100); len(ids) != 0 {
+		t.Fatal(ids)
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	initMock(t)
+
+	Subscribe("a/1", []string{"foo", "bar"}, "foo bar")
+	Subscribe("b/1", []string{"from:x"}, "from:x")
+	if m, err := Subscriptions([]string{"bar", "from:x", "zzz"}); err != nil || len(m) != 2 || m["a/1"] != "foo bar" {
+		t.Fatal(m, err)
+	}
+
+	Unsubscribe("a/1", []string{"foo", "bar"})
+	if m, _ := Subscriptions([]string{"foo", "bar"}); len(m) != 0 {
+		t.Fatal(m)
+	}
+}
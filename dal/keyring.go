@@ -0,0 +1,138 @@
+package dal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/model"
+)
+
+// Keys promoted by admins are stored in keyringID, sealed by Cfg.KeyringKey: base64(nonce || sealed keys),
+// the record never contains any key in plaintext so leaking the db alone won't expose them
+const keyringID = "keyring"
+
+func keyringAEAD() (cipher.AEAD, error) {
+	if common.Cfg.KeyringKey == "" {
+		return nil, fmt.Errorf("e:keyring_key_not_set")
+	}
+	h := sha256.Sum256([]byte(common.Cfg.KeyringKey))
+	blk, err := aes.NewCipher(h[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(blk)
+}
+
+func sealKeyring(keys []string) (string, error) {
+	aead, err := keyringAEAD()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	rand.Read(nonce)
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(strings.Join(keys, "\n")), []byte(keyringID))), nil
+}
+
+func openKeyring(v string) ([]string, error) {
+	aead, err := keyringAEAD()
+	if err != nil {
+		return nil, err
+	}
+	buf, err := base64.StdEncoding.DecodeString(v)
+	if err != nil || len(buf) < aead.NonceSize() {
+		return nil, fmt.Errorf("invalid sealed keyring")
+	}
+	p, err := aead.Open(nil, buf[:aead.NonceSize()], buf[aead.NonceSize():], []byte(keyringID))
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(string(p), "\n"), nil
+}
+
+func saveKeyring(keys []string) error {
+	sealed, err := sealKeyring(keys)
+	if err != nil {
+		return err
+	}
+	a := &model.Article{
+		ID:         keyringID,
+		Content:    sealed,
+		CreateTime: time.Now(),
+		Extras:     map[string]string{"sealed": "1"},
+	}
+	return m.db.Set(a.ID, a.Marshal())
+}
+
+// storedKeys returns keys promoted by admins, newest first
+func storedKeys() ([]string, error) {
+	a, err := GetArticle(keyringID)
+	if err != nil {
+		if err == model.ErrNotExisted {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if a.Extras["sealed"] == "" {
+		return nil, fmt.Errorf("keyring is not sealed")
+	}
+	return openKeyring(a.Content)
+}
+
+// loadKeyring merges keys promoted by admins (stored in db) with keys in config,
+// note that keys in config are always valid, to retire them you should edit the config.
+func loadKeyring() error {
+	keys, err := storedKeys()
+	if err != nil {
+		return err
+	}
+	keys = append(keys, common.Cfg.Key)
+	common.SetKeyring(append(keys, common.Cfg.OldKeys...)...)
+	return nil
+}
+
+func watchKeyring() {
+	for range time.Tick(time.Minute) {
+		if err := loadKeyring(); err != nil {
+			log.Println("[Keyring] reload:", err)
+		}
+	}
+}
+
+// PromoteKey makes 'key' the active key, older keys will be used for verification only,
+// keys beyond Cfg.MaxKeys will be retired.
+func PromoteKey(key string) (*common.Key, error) {
+	k, err := common.NewKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	common.LockKey(keyringID)
+	defer common.UnlockKey(keyringID)
+
+	stored, err := storedKeys()
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{key}
+	for _, old := range stored {
+		if old != key {
+			keys = append(keys, old)
+		}
+	}
+	if common.Cfg.MaxKeys > 0 && len(keys) > common.Cfg.MaxKeys {
+		keys = keys[:common.Cfg.MaxKeys]
+	}
+
+	if err := saveKeyring(keys); err != nil {
+		return nil, err
+	}
+	return k, loadKeyring()
+}
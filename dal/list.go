@@ -0,0 +1,328 @@
+package dal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+// Lists of a user are stored in u/<user_id>/lists: Extras[list_id] = JSON of model.UserList,
+// members are stored in u/<user_id>/list/<list_id>: Extras[member_id] = unix time of being added,
+// subscriptions are stored in u/<user_id>/list_subs: Extras["<owner_id>/<list_id>"] = unix time of subscribing
+func checkListName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > 32 {
+		return "", fmt.Errorf("e:invalid_list_name")
+	}
+	return name, nil
+}
+
+func CreateList(uid, name, desc string, public bool) (string, error) {
+	name, err := checkListName(name)
+	if err != nil {
+		return "", err
+	}
+
+	lid := strconv.FormatInt(time.Now().UnixNano(), 36)
+	return lid, DoUpsertArticle(makeListsID(uid), func(a *model.Article) error {
+		if len(a.Extras) >= common.Cfg.MaxLists {
+			return fmt.Errorf("e:too_many_lists")
+		}
+		buf, _ := json.Marshal(model.UserList{
+			Name:        name,
+			Description: common.SoftTrunc(desc, 256),
+			Public:      public,
+			Create:      time.Now(),
+		})
+		a.Extras[lid] = string(buf)
+		return nil
+	})
+}
+
+func updateList(uid, lid string, f func(l *model.UserList) error) error {
+	return DoUpsertArticle(makeListsID(uid), func(a *model.Article) error {
+		var l model.UserList
+		if v, ok := a.Extras[lid]; !ok || json.Unmarshal([]byte(v), &l) != nil {
+			return fmt.Errorf("e:list_not_found")
+		}
+		if err := f(&l); err != nil {
+			return err
+		}
+		if l.Members < 0 {
+			l.Members = 0
+		}
+		if l.Subscribers < 0 {
+			l.Subscribers = 0
+		}
+		buf, _ := json.Marshal(l)
+		a.Extras[lid] = string(buf)
+		return nil
+	})
+}
+
+func UpdateList(uid, lid, name, desc string, public bool) error {
+	name, err := checkListName(name)
+	if err != nil {
+		return err
+	}
+	return updateList(uid, lid, func(l *model.UserList) error {
+		l.Name, l.Description, l.Public = name, common.SoftTrunc(desc, 256), public
+		return nil
+	})
+}
+
+// DeleteList removes the list and its members, subscriptions to it will be ignored when reading
+func DeleteList(uid, lid string) error {
+	if err := DoUpsertArticle(makeListsID(uid), func(a *model.Article) error {
+		if _, ok := a.Extras[lid]; !ok {
+			return fmt.Errorf("e:list_not_found")
+		}
+		delete(a.Extras, lid)
+		return nil
+	}); err != nil {
+		return err
+	}
+	return DoUpsertArticle(makeListMembersID(uid, lid), func(a *model.Article) error {
+		a.Extras = map[string]string{}
+		return nil
+	})
+}
+
+func parseLists(owner string, a *model.Article) []*model.UserList {
+	res := []*model.UserList{}
+	if a == nil {
+		return res
+	}
+	for k, v := range a.Extras {
+		l := &model.UserList{}
+		if json.Unmarshal([]byte(v), l) == nil {
+			l.ID, l.Owner = k, owner
+			res = append(res, l)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Create.Before(res[j].Create) })
+	return res
+}
+
+func GetList(owner, lid string) (*model.UserList, error) {
+	a, err := GetArticle(makeListsID(owner))
+	if err != nil && err != model.ErrNotExisted {
+		return nil, err
+	}
+	for _, l := range parseLists(owner, a) {
+		if l.ID == lid {
+			return l, nil
+		}
+	}
+	return nil, fmt.Errorf("e:list_not_found")
+}
+
+// GetLists returns lists owned by 'owner', private ones are included only if 'withPrivate' is true
+func GetLists(owner string, withPrivate bool) []*model.UserList {
+	a, _ := GetArticle(makeListsID(owner))
+	res := parseLists(owner, a)
+	if !withPrivate {
+		public := res[:0]
+		for _, l := range res {
+			if l.Public {
+				public = append(public, l)
+			}
+		}
+		res = public
+	}
+	return res
+}
+
+func AddListMember(uid, lid, member string) error {
+	if _, err := GetList(uid, lid); err != nil {
+		return err
+	}
+	if mu, _ := WeakGetUser(member); mu == nil {
+		return fmt.Errorf("e:user_not_found_by_id")
+	}
+	if IsBlocking(member, uid) {
+		return fmt.Errorf("e:cannot_add_to_list")
+	}
+
+	var added bool
+	if err := DoUpsertArticle(makeListMembersID(uid, lid), func(a *model.Article) error {
+		if _, ok := a.Extras[member]; ok {
+			return nil
+		}
+		if len(a.Extras) >= common.Cfg.MaxListMembers {
+			return fmt.Errorf("e:too_many_list_members")
+		}
+		a.Extras[member] = strconv.FormatInt(time.Now().Unix(), 10)
+		added = true
+		return nil
+	}); err != nil || !added {
+		return err
+	}
+	return updateList(uid, lid, func(l *model.UserList) error { l.Members++; return nil })
+}
+
+func RemoveListMember(uid, lid, member string) error {
+	var removed bool
+	if err := DoUpsertArticle(makeListMembersID(uid, lid), func(a *model.Article) error {
+		_, removed = a.Extras[member]
+		delete(a.Extras, member)
+		return nil
+	}); err != nil || !removed {
+		return err
+	}
+	return updateList(uid, lid, func(l *model.UserList) error { l.Members--; return nil })
+}
+
+func IsListMember(owner, lid, member string) bool {
+	a, _ := WeakGetArticle(makeListMembersID(owner, lid))
+	return a != nil && a.Extras[member] != ""
+}
+
+// GetListMembers returns IDs of members, the latest added first
+func GetListMembers(owner, lid string) []string {
+	a, _ := GetArticle(makeListMembersID(owner, lid))
+	if a == nil {
+		return nil
+	}
+	res := make([]string, 0, len(a.Extras))
+	for k := range a.Extras {
+		res = append(res, k)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		ti, _ := strconv.ParseInt(a.Extras[res[i]], 10, 64)
+		tj, _ := strconv.ParseInt(a.Extras[res[j]], 10, 64)
+		if ti == tj {
+			return res[i] < res[j]
+		}
+		return ti > tj
+	})
+	return res
+}
+
+func GetListMemberStates(owner, lid, cursor string, n int) ([]FollowingState, string) {
+	a, _ := GetArticle(makeListMembersID(owner, lid))
+	members := GetListMembers(owner, lid)
+	start, _ := strconv.Atoi(cursor)
+	if start < 0 || start >= len(members) {
+		return nil, ""
+	}
+
+	end := start + n
+	if end > len(members) {
+		end = len(members)
+	}
+
+	res := make([]FollowingState, 0, end-start)
+	for _, id := range members[start:end] {
+		s := FollowingState{ID: id}
+		s.FullUser, _ = WeakGetUser(id)
+		if t, _ := strconv.ParseInt(a.Extras[id], 10, 64); t > 0 {
+			s.Time = time.Unix(t, 0)
+		}
+		res = append(res, s)
+	}
+
+	if end == len(members) {
+		return res, ""
+	}
+	return res, strconv.Itoa(end)
+}
+
+func SubscribeList(uid, owner, lid string, subscribing bool) error {
+	if subscribing {
+		l, err := GetList(owner, lid)
+		if err != nil {
+			return err
+		}
+		if !l.Public || owner == uid {
+			return fmt.Errorf("e:cannot_subscribe_list")
+		}
+	}
+
+	var changed bool
+	if err := DoUpsertArticle(makeListSubsID(uid), func(a *model.Article) error {
+		k := owner + "/" + lid
+		if _, ok := a.Extras[k]; ok == subscribing {
+			return nil
+		}
+		if subscribing {
+			if len(a.Extras) >= common.Cfg.MaxLists {
+				return fmt.Errorf("e:too_many_lists")
+			}
+			a.Extras[k] = strconv.FormatInt(time.Now().Unix(), 10)
+		} else {
+			delete(a.Extras, k)
+		}
+		changed = true
+		return nil
+	}); err != nil || !changed {
+		return err
+	}
+	err := updateList(owner, lid, func(l *model.UserList) error {
+		l.Subscribers += common.BoolInt2(subscribing)
+		return nil
+	})
+	if err != nil && !subscribing {
+		return nil // the list may have been deleted
+	}
+	return err
+}
+
+func IsSubscribingList(uid, owner, lid string) bool {
+	a, _ := WeakGetArticle(makeListSubsID(uid))
+	return a != nil && a.Extras[owner+"/"+lid] != ""
+}
+
+// GetSubscribedLists returns public lists subscribed by 'uid', deleted or private ones are skipped
+func GetSubscribedLists(uid string) []*model.UserList {
+	res := []*model.UserList{}
+	a, _ := GetArticle(makeListSubsID(uid))
+	if a == nil {
+		return res
+	}
+	for k := range a.Extras {
+		idx := strings.Index(k, "/")
+		if idx == -1 {
+			continue
+		}
+		if l, _ := GetList(k[:idx], k[idx+1:]); l != nil && l.Public {
+			res = append(res, l)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Create.Before(res[j].Create) })
+	return res
+}
+
+// ListCursors returns timeline cursors of list members whose articles are visible to 'you' (can be nil)
+func ListCursors(l *model.UserList, you *model.User) []ik.ID {
+	cursors := []ik.ID{}
+	for _, id := range GetListMembers(l.Owner, l.ID) {
+		mu, _ := WeakGetUser(id)
+		if mu == nil {
+			continue
+		}
+		if you != nil && you.ID != mu.ID {
+			if IsBlocking(mu.ID, you.ID) {
+				continue
+			}
+		}
+		if mu.FollowApply != 0 && (you == nil || you.ID != mu.ID) {
+			if you == nil {
+				continue
+			}
+			if following, accepted := IsFollowingWithAcceptance(you.ID, mu); !following || !accepted {
+				continue
+			}
+		}
+		cursors = append(cursors, ik.NewID(ik.IDAuthor, id))
+	}
+	return cursors
+}
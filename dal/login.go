@@ -0,0 +1,112 @@
+package dal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal/ratelimit"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+// Failed logins are counted per account and per IP during 'LoginWindow', every failure
+// beyond 'LoginFreeFailures' doubles the lockout, up to 'LoginMaxLockout'
+func loginKeys(uid, ip string) (account, addr string) {
+	return "login/u/" + uid, "login/ip/" + ip
+}
+
+func loginBackoff(failures int) time.Duration {
+	n := failures - common.Cfg.LoginFreeFailures
+	if n <= 0 {
+		return 0
+	}
+	max := time.Duration(common.Cfg.LoginMaxLockout) * time.Minute
+	if n > 20 {
+		return max
+	}
+	if d := time.Second << uint(n-1); d < max {
+		return d
+	}
+	return max
+}
+
+// CheckLogin returns how long the login must wait, and whether a challenge is required
+// because the account or the IP looks like being under attack
+func CheckLogin(uid, ip string) (wait time.Duration, challenge bool) {
+	account, addr := loginKeys(uid, ip)
+	for _, k := range []string{account, addr} {
+		if d, _ := ratelimit.Blocked(k); d > wait {
+			wait = d
+		}
+		if n, _ := ratelimit.Count(k); n >= common.Cfg.LoginFreeFailures {
+			challenge = true
+		}
+	}
+	if n, _ := ratelimit.CountDistinct(account); n >= common.Cfg.LoginChallengeIPs {
+		challenge = true
+	}
+	return
+}
+
+// LoginFailed records the failure, the owner will be alerted once per window
+// if the account exists and fails too many times
+func LoginFailed(uid, ip string, exists bool) {
+	account, addr := loginKeys(uid, ip)
+	window := time.Duration(common.Cfg.LoginWindow) * time.Minute
+
+	n, err := ratelimit.Hit(account, window)
+	if err != nil {
+		log.Println("[LoginFailed]", uid, err)
+		return
+	}
+	ratelimit.Block(account, loginBackoff(n))
+	ratelimit.AddDistinct(account, ip, window)
+
+	if m, err := ratelimit.Hit(addr, window); err == nil {
+		ratelimit.Block(addr, loginBackoff(m))
+	}
+
+	if !exists || n < common.Cfg.LoginAlertAfter {
+		return
+	}
+	if first, _ := ratelimit.Once(account, window); first {
+		ips, _ := ratelimit.CountDistinct(account)
+		go notifyLoginAlert(uid, n, ips)
+	}
+}
+
+// LoginSucceeded clears the records of the account and the IP
+func LoginSucceeded(uid, ip string) {
+	account, addr := loginKeys(uid, ip)
+	ratelimit.Reset(account)
+	ratelimit.Reset(addr)
+}
+
+func notifyLoginAlert(uid string, failures, ips int) {
+	if _, _, err := DoInsertArticle(ik.NewID(ik.IDInbox, uid).String(), false, model.Article{
+		Cmd: model.CmdInboxLoginAlert,
+		Extras: map[string]string{
+			"failures": strconv.Itoa(failures),
+			"ips":      strconv.Itoa(ips),
+		},
+	}); err != nil {
+		log.Println("[LoginAlert]", uid, err)
+		return
+	}
+	IncUnread(uid)
+}
+
+// UseTOTPCode returns true only for the first use of the TOTP code among all nodes
+func UseTOTPCode(key string) bool {
+	h := sha256.Sum256([]byte(key))
+	first, err := ratelimit.Once("totp/"+hex.EncodeToString(h[:]), ik.TOTPWindow)
+	if err != nil {
+		log.Println("[TOTP] use code:", err)
+		return false
+	}
+	return first
+}
@@ -40,6 +40,12 @@ func Init(redisConfig *storage.RedisConfig, region string, ak, sk string) {
 
 	m.db = db
 	m.activeUsers = c
+
+	if err := loadKeyring(); err != nil {
+		log.Println("[Keyring] load:", err)
+	}
+	go watchKeyring()
+	go watchScheduledPosts()
 }
 
 func ModKV() KeyValueOp {
@@ -84,13 +90,15 @@ func getterArticle(getter func(string) ([]byte, error), id string, dontOverrideN
 	if err != nil {
 		return nil, err
 	}
-	if a.ReferID == "" {
+	if a.ReferID == "" || a.IsDeleted() {
+		// Canceled reposts stay in chains as deleted referrals
 		return a, nil
 	}
 	a2, err := getterArticle(getter, a.ReferID)
 	if err != nil {
 		return nil, err
 	}
+	a2.T_RepostBy = a.Author
 	if len(dontOverrideNextID) == 1 && dontOverrideNextID[0] {
 		return a2, nil
 	}
@@ -188,7 +196,7 @@ func WalkMulti(media bool, n int, cursors ...ik.ID) (a []*model.Article, next []
 
 		if err == nil {
 			ok := !idm[p.ID] && p.Content != model.DeletionMarker && !latest.IsRoot()
-			// 1. 'p' is not duplicated
+			// 1. 'p' is not duplicated, reposts are resolved to their originals so they will be deduplicated too
 			// 2. 'p' is not deleted
 			// 3. 'p' is not a root article
 
@@ -285,7 +293,12 @@ func Post(a *model.Article, author *model.User) (*model.Article, error) {
 	if _, _, err := DoInsertArticle(ik.NewID(ik.IDAuthor, a.Author).String(), false, *a); err != nil {
 		return nil, err
 	}
+	afterPost(a)
+	return a, nil
+}
 
+// afterPost starts side effects of the posted article: master timeline, mentions, quote, search index and saved searches
+func afterPost(a *model.Article) {
 	go func() {
 		if a.PostOptions&model.PostOptionNoMasterTimeline == 0 {
 			master := "master"
@@ -298,12 +311,38 @@ func Post(a *model.Article, author *model.User) (*model.Article, error) {
 		}
 		ids, tags := common.ExtractMentionsAndTags(a.Content)
 		MentionUserAndTags(a, ids, tags)
+		if a.Extras["quote"] != "" {
+			notifyQuote(a)
+		}
 	}()
+	go indexArticle(*a)
+	go matchSavedSearches(*a)
+}
 
-	return a, nil
+func indexArticle(a model.Article) {
+	if err := IndexArticle(&a); err != nil {
+		log.Println("[IndexArticle]", a.ID, err)
+	}
 }
 
 func PostReply(parent string, a *model.Article, author *model.User) (*model.Article, error) {
+	p, err := checkReply(parent, author)
+	if err != nil {
+		return nil, err
+	}
+
+	a.ID = ik.NewGeneralID().String()
+	a.Parent = p.ID
+
+	if a, err = insertReply(p, a); err != nil {
+		return nil, err
+	}
+	afterReply(p, a)
+	return a, nil
+}
+
+// checkReply returns the parent article if 'author' can reply to it
+func checkReply(parent string, author *model.User) (*model.Article, error) {
 	p, err := GetArticle(parent)
 	if err != nil {
 		return nil, err
@@ -350,22 +389,28 @@ func PostReply(parent string, a *model.Article, author *model.User) (*model.Arti
 		}
 	}
 
-	a.ID = ik.NewGeneralID().String()
-	a.Parent = p.ID
+	return p, nil
+}
 
+// insertReply puts 'a' into the reply chain of 'p', and its author's timeline unless PostOptionNoTimeline is set
+func insertReply(p, a *model.Article) (*model.Article, error) {
 	a2, _, err := DoInsertArticle(p.ID, true, *a)
 	if err != nil {
 		return nil, err
 	}
-	a = &a2
 
-	if a.PostOptions&model.PostOptionNoTimeline == 0 {
+	if a2.PostOptions&model.PostOptionNoTimeline == 0 {
 		// Add reply to its author's timeline
-		if _, _, err := DoInsertArticle(ik.NewID(ik.IDAuthor, a.Author).String(), false, *a); err != nil {
+		if _, _, err := DoInsertArticle(ik.NewID(ik.IDAuthor, a2.Author).String(), false, a2); err != nil {
 			return nil, err
 		}
 	}
+	return &a2, nil
+}
 
+// afterReply starts side effects of the reply: notifying the parent's author, mentions and search index
+func afterReply(p, a *model.Article) {
+	go indexArticle(*a)
 	go func() {
 		if p.Content != model.DeletionMarker && a.Author != p.Author {
 			if pauthor, _ := GetUser(p.Author); pauthor != nil && pauthor.NotifyFollowerActOnly == 1 && !IsFollowing(p.Author, a.Author) {
@@ -384,6 +429,4 @@ func PostReply(parent string, a *model.Article, author *model.User) (*model.Arti
 		ids, tags := common.ExtractMentionsAndTags(a.Content)
 		MentionUserAndTags(a, ids, tags)
 	}()
-
-	return a, nil
 }
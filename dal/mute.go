@@ -0,0 +1,57 @@
+package dal
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/model"
+)
+
+// Mutes are stored in u/<user_id>/mutes: Extras[mute_key] = expire unix timestamp,
+// see model.MakeMuteKey for mute keys. Unlike blocking, mutes are invisible to others
+func MuteItem(uid, key string, expire time.Time) error {
+	return DoUpsertArticle(makeMutesID(uid), func(a *model.Article) error {
+		for _, e := range model.ParseMuteEntries(a.Extras) {
+			if e.Expired() {
+				delete(a.Extras, e.Key)
+			}
+		}
+		if _, ok := a.Extras[key]; !ok && len(a.Extras) >= common.Cfg.MaxMutes {
+			return fmt.Errorf("e:too_many_mutes")
+		}
+		a.Extras[key] = "0"
+		if !expire.IsZero() {
+			a.Extras[key] = strconv.FormatInt(expire.Unix(), 10)
+		}
+		return nil
+	})
+}
+
+func UnmuteItem(uid, key string) error {
+	return DoUpsertArticle(makeMutesID(uid), func(a *model.Article) error {
+		delete(a.Extras, key)
+		return nil
+	})
+}
+
+func GetMuteEntries(uid string) []model.MuteEntry {
+	a, _ := GetArticle(makeMutesID(uid))
+	if a == nil {
+		return []model.MuteEntry{}
+	}
+	return model.ParseMuteEntries(a.Extras)
+}
+
+func GetMutes(uid string) *model.Mutes {
+	a, _ := WeakGetArticle(makeMutesID(uid))
+	if a == nil {
+		return nil
+	}
+	return model.NewMutes(a.Extras)
+}
+
+func IsMuting(from, to string) bool {
+	return GetMutes(from).IsMutingUser(to)
+}
@@ -0,0 +1,255 @@
+package dal
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal/ratelimit"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+const (
+	oauthAccessPrefix  = "oauth+"
+	oauthRefreshPrefix = "refresh+"
+	maxOAuthClients    = 10
+)
+
+func randomHex(n int) string {
+	p := make([]byte, n)
+	rand.Read(p)
+	return hex.EncodeToString(p)
+}
+
+// CreateOAuthClient registers a client owned by the user, returns the client and its secret
+func CreateOAuthClient(owner, name string, redirectURIs []string, public bool) (*model.OAuthClient, string, error) {
+	secret := GenSession()
+	c := &model.OAuthClient{
+		ID:           randomHex(8),
+		Name:         name,
+		Owner:        owner,
+		RedirectURIs: redirectURIs,
+		SecretHash:   SessionHash(secret),
+		Public:       public,
+		Create:       time.Now(),
+	}
+
+	if err := DoUpsertArticle(makeOAuthClientsID(owner), func(a *model.Article) error {
+		if len(a.Extras) >= maxOAuthClients {
+			return fmt.Errorf("e:too_many_oauth_clients")
+		}
+		a.Extras[c.ID] = c.Name
+		return nil
+	}); err != nil {
+		return nil, "", err
+	}
+
+	buf, _ := json.Marshal(c)
+	a := &model.Article{ID: makeOAuthClientID(c.ID), Content: string(buf), CreateTime: c.Create}
+	return c, secret, m.db.Set(a.ID, a.Marshal())
+}
+
+func GetOAuthClient(id string) (*model.OAuthClient, error) {
+	a, err := GetArticle(makeOAuthClientID(id))
+	if err != nil {
+		return nil, err
+	}
+	c := &model.OAuthClient{}
+	if err := json.Unmarshal([]byte(a.Content), c); err != nil || c.ID == "" {
+		// Deleted clients are stored as empty contents
+		return nil, model.ErrNotExisted
+	}
+	return c, nil
+}
+
+func GetOAuthClients(owner string) []*model.OAuthClient {
+	res := []*model.OAuthClient{}
+	a, _ := GetArticle(makeOAuthClientsID(owner))
+	if a == nil {
+		return res
+	}
+	for id := range a.Extras {
+		if c, _ := GetOAuthClient(id); c != nil {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func DeleteOAuthClient(owner, id string) error {
+	c, err := GetOAuthClient(id)
+	if err != nil {
+		return err
+	}
+	if c.Owner != owner {
+		return fmt.Errorf("e:user_not_permitted")
+	}
+	if err := DoUpsertArticle(makeOAuthClientsID(owner), func(a *model.Article) error {
+		delete(a.Extras, id)
+		return nil
+	}); err != nil {
+		return err
+	}
+	_, err = DoUpdateArticle(makeOAuthClientID(id), "Content", "")
+	return err
+}
+
+// UseOAuthCode returns true only for the first use of the authorization code among all nodes
+func UseOAuthCode(code string, ttl time.Duration) bool {
+	h := sha256.Sum256([]byte(code))
+	first, err := ratelimit.Once("oauth-code/"+hex.EncodeToString(h[:]), ttl)
+	if err != nil {
+		log.Println("[OAuth] use code:", err)
+		return false
+	}
+	return first
+}
+
+// CheckOAuthClientSecret returns true if the client is public or the secret matches
+func CheckOAuthClientSecret(c *model.OAuthClient, secret string) bool {
+	return c.Public || (secret != "" && SessionHash(secret) == c.SecretHash)
+}
+
+// OAuth grants are stored in u/<user_id>/oauth_grants: Extras[grant_id] = JSON of model.OAuthGrant,
+// access token is "oauth+<grant_id>+<secret>" and refresh token is "refresh+<grant_id>+<secret>"
+func updateOAuthGrants(uid string, f func(a *model.Article) error) error {
+	return DoUpsertArticle(makeOAuthGrantsID(uid), f)
+}
+
+func issueOAuthTokens(uid string, g *model.OAuthGrant) (access, refresh string) {
+	as, rs := GenSession(), GenSession()
+	g.AccessHash, g.RefreshHash = SessionHash(as), SessionHash(rs)
+	g.AccessExpire = time.Now().Add(time.Duration(common.Cfg.OAuthAccessTTL) * time.Second)
+	access = ik.MakeUserToken(uid, oauthAccessPrefix+g.ID+"+"+as)
+	refresh = ik.MakeUserToken(uid, oauthRefreshPrefix+g.ID+"+"+rs)
+	return
+}
+
+// CreateOAuthGrant authorizes the client to act for the user, the existing grant of the same client will be replaced
+func CreateOAuthGrant(uid, clientID string, scope model.APIScope) (access, refresh string, err error) {
+	g := &model.OAuthGrant{
+		ID:       randomHex(8),
+		ClientID: clientID,
+		Scope:    scope,
+		Create:   time.Now(),
+	}
+	access, refresh = issueOAuthTokens(uid, g)
+	buf, _ := json.Marshal(g)
+	err = updateOAuthGrants(uid, func(a *model.Article) error {
+		for k, v := range a.Extras {
+			var old model.OAuthGrant
+			if json.Unmarshal([]byte(v), &old) == nil && old.ClientID == clientID {
+				delete(a.Extras, k)
+			}
+		}
+		a.Extras[g.ID] = string(buf)
+		return nil
+	})
+	return
+}
+
+func splitOAuthSession(session, prefix string) (grantID, secret string, ok bool) {
+	if !strings.HasPrefix(session, prefix) {
+		return "", "", false
+	}
+	p := strings.SplitN(strings.TrimPrefix(session, prefix), "+", 2)
+	if len(p) != 2 {
+		return "", "", false
+	}
+	return p[0], p[1], true
+}
+
+func getOAuthGrant(uid, grantID string) (*model.OAuthGrant, bool) {
+	a, err := GetArticle(makeOAuthGrantsID(uid))
+	if err != nil {
+		return nil, false
+	}
+	g := &model.OAuthGrant{}
+	if json.Unmarshal([]byte(a.Extras[grantID]), g) != nil {
+		return nil, false
+	}
+	g.ID = grantID
+	return g, true
+}
+
+func checkOAuthAccess(uid, session string) (*model.OAuthGrant, bool) {
+	id, secret, ok := splitOAuthSession(session, oauthAccessPrefix)
+	if !ok {
+		return nil, false
+	}
+	g, ok := getOAuthGrant(uid, id)
+	if !ok || g.AccessHash != SessionHash(secret) || time.Now().After(g.AccessExpire) {
+		return nil, false
+	}
+	if c, _ := GetOAuthClient(g.ClientID); c == nil {
+		// Grants can't be found by the client, so they are invalidated here after the client being deleted
+		return nil, false
+	}
+	return g, true
+}
+
+// RefreshOAuthGrant issues new tokens using the refresh token, the old refresh token will be invalidated,
+// if it is used again, the grant will be revoked because the token might have been stolen.
+func RefreshOAuthGrant(clientID, refreshToken string) (access, refresh string, g *model.OAuthGrant, err error) {
+	uid, session, err := ik.ParseUserToken(refreshToken)
+	if err != nil {
+		return "", "", nil, err
+	}
+	id, secret, ok := splitOAuthSession(session, oauthRefreshPrefix)
+	if !ok {
+		return "", "", nil, fmt.Errorf("invalid refresh token")
+	}
+	if c, _ := GetOAuthClient(clientID); c == nil {
+		return "", "", nil, fmt.Errorf("invalid client")
+	}
+
+	err = updateOAuthGrants(uid, func(a *model.Article) error {
+		g = &model.OAuthGrant{}
+		if json.Unmarshal([]byte(a.Extras[id]), g) != nil || g.ClientID != clientID {
+			return fmt.Errorf("invalid grant")
+		}
+		if g.RefreshHash != SessionHash(secret) {
+			delete(a.Extras, id)
+			return nil
+		}
+		g.ID = id
+		access, refresh = issueOAuthTokens(uid, g)
+		buf, _ := json.Marshal(g)
+		a.Extras[id] = string(buf)
+		return nil
+	})
+	if err == nil && access == "" {
+		err = fmt.Errorf("refresh token reused, grant revoked")
+	}
+	return
+}
+
+func GetOAuthGrants(uid string) []model.OAuthGrant {
+	res := []model.OAuthGrant{}
+	a, _ := GetArticle(makeOAuthGrantsID(uid))
+	if a == nil {
+		return res
+	}
+	for k, v := range a.Extras {
+		var g model.OAuthGrant
+		if json.Unmarshal([]byte(v), &g) == nil {
+			g.ID = k
+			res = append(res, g)
+		}
+	}
+	return res
+}
+
+func RevokeOAuthGrant(uid, grantID string) error {
+	return updateOAuthGrants(uid, func(a *model.Article) error {
+		delete(a.Extras, grantID)
+		return nil
+	})
+}
@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+var hitScript = redis.NewScript(1, `
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`)
+
+var distinctScript = redis.NewScript(1, `
+redis.call('SADD', KEYS[1], ARGV[1])
+if redis.call('PTTL', KEYS[1]) < 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return redis.call('SCARD', KEYS[1])
+`)
+
+// Hit increases the counter and returns its new value, the counter expires 'ttl' after the first hit
+func Hit(key string, ttl time.Duration) (int, error) {
+	c := p.Get()
+	defer c.Close()
+	return redis.Int(hitScript.Do(c, "rl:c:"+key, ttl.Milliseconds()))
+}
+
+// Count returns the value of the counter
+func Count(key string) (int, error) {
+	c := p.Get()
+	defer c.Close()
+	n, err := redis.Int(c.Do("GET", "rl:c:"+key))
+	if err == redis.ErrNil {
+		return 0, nil
+	}
+	return n, err
+}
+
+// AddDistinct adds the member to the set and returns the number of distinct members,
+// the set expires 'ttl' after the first member being added
+func AddDistinct(key, member string, ttl time.Duration) (int, error) {
+	c := p.Get()
+	defer c.Close()
+	return redis.Int(distinctScript.Do(c, "rl:s:"+key, member, ttl.Milliseconds()))
+}
+
+// CountDistinct returns the number of distinct members in the set
+func CountDistinct(key string) (int, error) {
+	c := p.Get()
+	defer c.Close()
+	return redis.Int(c.Do("SCARD", "rl:s:"+key))
+}
+
+// Block blocks the key for 'd', an existing longer block will be kept
+func Block(key string, d time.Duration) error {
+	if left, err := Blocked(key); err != nil || left >= d {
+		return err
+	}
+	c := p.Get()
+	defer c.Close()
+	_, err := c.Do("SET", "rl:l:"+key, 1, "PX", d.Milliseconds())
+	return err
+}
+
+// Blocked returns the remaining time of the block, zero if not blocked
+func Blocked(key string) (time.Duration, error) {
+	c := p.Get()
+	defer c.Close()
+	ms, err := redis.Int64(c.Do("PTTL", "rl:l:"+key))
+	if err != nil || ms <= 0 {
+		return 0, err
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// Once returns true only for the first call during 'ttl'
+func Once(key string, ttl time.Duration) (bool, error) {
+	c := p.Get()
+	defer c.Close()
+	_, err := redis.String(c.Do("SET", "rl:o:"+key, 1, "NX", "PX", ttl.Milliseconds()))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Release clears the mark set by Once, so the key can be claimed again
+func Release(key string) error {
+	c := p.Get()
+	defer c.Close()
+	_, err := c.Do("DEL", "rl:o:"+key)
+	return err
+}
+
+// Reset clears the counter, the distinct set and the block of the key
+func Reset(key string) error {
+	c := p.Get()
+	defer c.Close()
+	_, err := c.Do("DEL", "rl:c:"+key, "rl:s:"+key, "rl:l:"+key)
+	return err
+}
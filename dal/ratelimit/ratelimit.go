@@ -0,0 +1,131 @@
+package ratelimit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/coyove/iis/dal/storage"
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	TokenBucket   = "bucket"
+	SlidingWindow = "window"
+)
+
+var p *redis.Pool
+
+func Init(redisConfig *storage.RedisConfig) {
+	p = storage.NewGlobalCache(redisConfig).Pool
+}
+
+// Token bucket: 'limit' tokens at most, refilled evenly during 'window' milliseconds
+var bucketScript = redis.NewScript(1, `
+local limit, window, now = tonumber(ARGV[1]), tonumber(ARGV[2]), tonumber(ARGV[3])
+local v = redis.call('HMGET', KEYS[1], 't', 'ts')
+local tokens, ts = tonumber(v[1]), tonumber(v[2])
+if tokens == nil or ts == nil then
+	tokens, ts = limit, now
+end
+tokens = math.min(limit, tokens + math.max(0, now - ts) * limit / window)
+local allowed = 0
+if tokens >= 1 then
+	tokens, allowed = tokens - 1, 1
+end
+redis.call('HMSET', KEYS[1], 't', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], window)
+return {allowed, tostring(tokens)}
+`)
+
+// Sliding window log: requests in the last 'window' milliseconds are recorded in a zset
+var windowScript = redis.NewScript(1, `
+local limit, window, now = tonumber(ARGV[1]), tonumber(ARGV[2]), tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local n = redis.call('ZCARD', KEYS[1])
+local allowed = 0
+if n < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[4])
+	n, allowed = n + 1, 1
+end
+redis.call('PEXPIRE', KEYS[1], window)
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+local reset = 0
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, limit - n, reset}
+`)
+
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration // until the quota is fully restored
+	RetryAfter time.Duration // until the next request can be allowed, zero if allowed
+}
+
+// Take consumes one request from the quota identified by 'key', zero limit blocks all requests
+func Take(algo, key string, limit int, window time.Duration) (Result, error) {
+	r := Result{Limit: limit}
+	ms := window.Milliseconds()
+	if ms <= 0 {
+		r.Allowed = true
+		return r, nil
+	}
+	if limit <= 0 {
+		r.Reset, r.RetryAfter = window, window
+		return r, nil
+	}
+	c := p.Get()
+	defer c.Close()
+
+	now := time.Now().UnixNano() / 1e6
+	perToken := time.Duration(ms/int64(limit)) * time.Millisecond
+
+	switch algo {
+	case SlidingWindow:
+		var x [6]byte
+		rand.Read(x[:])
+		res, err := redis.Values(windowScript.Do(c, "rl:w:"+key, limit, ms, now,
+			strconv.FormatInt(now, 36)+hex.EncodeToString(x[:])))
+		if err != nil {
+			return r, err
+		}
+		allowed, _ := redis.Int(res[0], nil)
+		remain, _ := redis.Int(res[1], nil)
+		reset, _ := redis.Int64(res[2], nil)
+		r.Allowed, r.Remaining, r.Reset = allowed == 1, remain, time.Duration(reset)*time.Millisecond
+		if !r.Allowed {
+			r.RetryAfter = r.Reset
+		}
+	default:
+		res, err := redis.Values(bucketScript.Do(c, "rl:b:"+key, limit, ms, now))
+		if err != nil {
+			return r, err
+		}
+		allowed, _ := redis.Int(res[0], nil)
+		tokensStr, _ := redis.String(res[1], nil)
+		tokens, _ := strconv.ParseFloat(tokensStr, 64)
+		r.Allowed, r.Remaining = allowed == 1, int(tokens)
+		r.Reset = time.Duration((float64(limit) - tokens) * float64(perToken))
+		if !r.Allowed {
+			r.RetryAfter = time.Duration((1 - tokens) * float64(perToken))
+		}
+	}
+	return r, nil
+}
+
+// Clear resets the quota identified by 'key'
+func Clear(algo, key string) error {
+	c := p.Get()
+	defer c.Close()
+
+	prefix := "rl:b:"
+	if algo == SlidingWindow {
+		prefix = "rl:w:"
+	}
+	_, err := c.Do("DEL", prefix+key)
+	return err
+}
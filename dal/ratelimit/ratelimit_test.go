@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/coyove/iis/dal/storage"
+)
+
+func initMock(t *testing.T) {
+	svr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(svr.Close)
+	Init(&storage.RedisConfig{Addr: svr.Addr()})
+}
+
+func TestTokenBucket(t *testing.T) {
+	initMock(t)
+
+	for i := 0; i < 3; i++ {
+		r, err := Take(TokenBucket, "a", 3, 300*time.Millisecond)
+		if err != nil || !r.Allowed || r.Remaining != 2-i {
+			t.Fatal(i, r, err)
+		}
+	}
+	r, _ := Take(TokenBucket, "a", 3, 300*time.Millisecond)
+	if r.Allowed || r.RetryAfter <= 0 || r.RetryAfter > 100*time.Millisecond {
+		t.Fatal(r)
+	}
+	if r, _ := Take(TokenBucket, "b", 3, 300*time.Millisecond); !r.Allowed {
+		t.Fatal(r)
+	}
+
+	time.Sleep(r.RetryAfter + 10*time.Millisecond)
+	if r, _ := Take(TokenBucket, "a", 3, 300*time.Millisecond); !r.Allowed {
+		t.Fatal(r)
+	}
+
+	Clear(TokenBucket, "a")
+	if r, _ := Take(TokenBucket, "a", 3, 300*time.Millisecond); !r.Allowed || r.Remaining != 2 {
+		t.Fatal(r)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	initMock(t)
+
+	for i := 0; i < 2; i++ {
+		r, err := Take(SlidingWindow, "a", 2, 200*time.Millisecond)
+		if err != nil || !r.Allowed || r.Remaining != 1-i {
+			t.Fatal(i, r, err)
+		}
+	}
+	r, _ := Take(SlidingWindow, "a", 2, 200*time.Millisecond)
+	if r.Allowed || r.RetryAfter <= 0 || r.RetryAfter > 200*time.Millisecond {
+		t.Fatal(r)
+	}
+
+	time.Sleep(r.RetryAfter + 10*time.Millisecond)
+	if r, _ := Take(SlidingWindow, "a", 2, 200*time.Millisecond); !r.Allowed {
+		t.Fatal(r)
+	}
+}
+
+func TestCounter(t *testing.T) {
+	initMock(t)
+
+	for i := 1; i <= 3; i++ {
+		if n, err := Hit("a", time.Minute); err != nil || n != i {
+			t.Fatal(n, err)
+		}
+	}
+	if n, _ := Count("a"); n != 3 {
+		t.Fatal(n)
+	}
+	if n, _ := Count("b"); n != 0 {
+		t.Fatal(n)
+	}
+
+	AddDistinct("a", "1.1.1.1", time.Minute)
+	AddDistinct("a", "1.1.1.1", time.Minute)
+	if n, _ := AddDistinct("a", "2.2.2.2", time.Minute); n != 2 {
+		t.Fatal(n)
+	}
+
+	Block("a", time.Minute)
+	Block("a", time.Second)
+	if d, _ := Blocked("a"); d <= time.Second {
+		t.Fatal(d)
+	}
+	if d, _ := Blocked("b"); d != 0 {
+		t.Fatal(d)
+	}
+
+	if ok, _ := Once("a", time.Minute); !ok {
+		t.Fatal()
+	}
+	if ok, _ := Once("a", time.Minute); ok {
+		t.Fatal()
+	}
+	Release("a")
+	if ok, _ := Once("a", time.Minute); !ok {
+		t.Fatal()
+	}
+
+	Reset("a")
+	n, _ := Count("a")
+	d, _ := Blocked("a")
+	m, _ := CountDistinct("a")
+	if n != 0 || d != 0 || m != 0 {
+		t.Fatal(n, d, m)
+	}
+}
@@ -0,0 +1,146 @@
+package dal
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+// Reposts are referrals inserted into the reposter's timeline: {ID: repost_id, ReferID: article_id, Author: reposter},
+// the state is stored in u/<user_id>/repost/<article_id>: Extras["repost"] = true/false, Extras["repost_id"] = repost_id.
+// Canceled reposts will be marked as deleted referrals, because articles can't be removed from chains
+func RepostArticle(u *model.User, to string, reposting bool) error {
+	orig, err := GetArticle(to)
+	if err != nil {
+		return err
+	}
+	if reposting {
+		if err := checkRepostable(u, orig); err != nil {
+			return err
+		}
+	}
+
+	var repostID string
+	var updated, inserted bool
+	if err := DoUpsertArticle(makeRepostID(u.ID, orig.ID), func(a *model.Article) error {
+		if (a.Extras["repost"] == "true") == reposting {
+			return nil
+		}
+		updated, inserted = true, reposting && a.Extras["repost_id"] == ""
+		a.Cmd = model.CmdRepost
+		a.Extras["to"] = orig.ID
+		a.Extras["repost"] = strconv.FormatBool(reposting)
+		if reposting {
+			a.Extras["repost_id"] = ik.NewGeneralID().String()
+		}
+		repostID = a.Extras["repost_id"]
+		return nil
+	}); err != nil {
+		return err
+	}
+	if !updated {
+		return nil
+	}
+
+	if reposting {
+		if _, _, err := DoInsertArticle(ik.NewID(ik.IDAuthor, u.ID).String(), false, model.Article{
+			ID:      repostID,
+			ReferID: orig.ID,
+			Author:  u.ID,
+			Media:   orig.Media,
+		}); err != nil {
+			return err
+		}
+	} else if err := cancelRepost(repostID); err != nil {
+		return err
+	}
+
+	go func() {
+		if _, err := DoUpdateArticle(orig.ID, func(a *model.Article) { a.Reposts += int32(common.BoolInt2(reposting)) }); err != nil {
+			log.Println("RepostArticle error:", err)
+		}
+		if inserted && orig.Author != u.ID && !orig.Anonymous {
+			DoInsertArticle(ik.NewID(ik.IDInbox, orig.Author).String(), false, model.Article{
+				Cmd:    model.CmdInboxRepost,
+				Extras: map[string]string{"from": u.ID, "article_id": orig.ID},
+			})
+			IncUnread(orig.Author)
+		}
+	}()
+	return nil
+}
+
+// checkRepostable checks whether 'u' can repost or quote 'a'
+func checkRepostable(u *model.User, a *model.Article) error {
+	if a.IsDeleted() || a.Cmd != model.CmdNone || a.ReferID != "" {
+		return fmt.Errorf("e:cannot_repost")
+	}
+	if a.Author == u.ID {
+		return nil
+	}
+	if IsBlocking(a.Author, u.ID) {
+		return fmt.Errorf("e:cannot_repost")
+	}
+	if author, _ := WeakGetUser(a.Author); author != nil && author.FollowApply != 0 {
+		// Articles of protected accounts shouldn't be spread
+		return fmt.Errorf("e:cannot_repost")
+	}
+	return nil
+}
+
+// cancelRepost marks the referral as deleted, it can't be done by DoUpdateArticle which resolves the referral
+func cancelRepost(id string) error {
+	common.LockKey(id)
+	defer common.UnlockKey(id)
+
+	p, err := m.db.Get(id)
+	if err != nil {
+		return err
+	}
+	if len(p) == 0 {
+		return model.ErrNotExisted
+	}
+	a, err := model.UnmarshalArticle(p)
+	if err != nil {
+		return err
+	}
+	if a.ReferID == "" {
+		return fmt.Errorf("not a repost: %s", id)
+	}
+	a.Content = model.DeletionMarker
+	a.Media = ""
+	return m.db.Set(a.ID, a.Marshal())
+}
+
+func IsReposting(from, to string) bool {
+	p, _ := WeakGetArticle(makeRepostID(from, to))
+	return p != nil && p.Extras["repost"] == "true"
+}
+
+// CheckQuote validates the article to be quoted by 'u'
+func CheckQuote(u *model.User, id string) error {
+	a, err := GetArticle(id)
+	if err != nil {
+		return fmt.Errorf("e:cannot_repost")
+	}
+	return checkRepostable(u, a)
+}
+
+func notifyQuote(a *model.Article) {
+	q, _ := WeakGetArticle(a.Extras["quote"])
+	if q == nil || q.Author == a.Author || q.Anonymous || IsBlocking(q.Author, a.Author) {
+		return
+	}
+	if _, _, err := DoInsertArticle(ik.NewID(ik.IDInbox, q.Author).String(), false, model.Article{
+		Cmd:    model.CmdInboxQuote,
+		Extras: map[string]string{"from": a.Author, "article_id": a.ID},
+	}); err != nil {
+		log.Println("[Quote] notify:", q.Author, err)
+		return
+	}
+	IncUnread(q.Author)
+}
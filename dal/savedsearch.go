@@ -0,0 +1,263 @@
+package dal
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coyove/common/lru"
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal/index"
+	"github.com/coyove/iis/dal/ratelimit"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+// Saved searches are stored in u/<user_id>/saved_searches: Extras[search_id] = JSON of model.SavedSearch,
+// unmuted ones are also registered in the search index under their routing terms (see savedSearchRoutes),
+// new posts will be matched against searches registered under their terms.
+// Users are listed in savedSearchUsersID (Author = user ID) when saving their first search,
+// so RebuildSearchIndex can register the searches again after the index is lost
+const savedSearchUsersID = "saved_search_users"
+
+var savedSearchQueries = lru.NewCache(4096)
+
+func parseSavedSearch(query string) *model.SearchQuery {
+	if q, ok := savedSearchQueries.Get(query); ok {
+		return q.(*model.SearchQuery)
+	}
+	q := model.ParseSearchQuery(query)
+	savedSearchQueries.Add(query, &q)
+	return &q
+}
+
+// savedSearchRoutes returns terms to register the query under, a matched article must contain the first required term,
+// or any of the text terms, queries having neither of them are registered under the empty term and matched against every post
+func savedSearchRoutes(q *model.SearchQuery) []string {
+	if r := q.Required(); len(r) > 0 {
+		return r[:1]
+	}
+	if t := q.Terms(); len(t) > 0 {
+		return t
+	}
+	return []string{""}
+}
+
+func updateSavedSearches(uid string, f func(a *model.Article) error) error {
+	return DoUpsertArticle(makeSavedSearchesID(uid), f)
+}
+
+func registerSavedSearch(uid, sid, query string, register bool) error {
+	routes := savedSearchRoutes(parseSavedSearch(query))
+	if register {
+		return index.Subscribe(uid+"/"+sid, routes, query)
+	}
+	return index.Unsubscribe(uid+"/"+sid, routes)
+}
+
+func SaveSearch(uid, query string) (string, error) {
+	query = strings.TrimSpace(common.SoftTrunc(query, 256))
+	if q := model.ParseSearchQuery(query); q.IsEmpty() {
+		return "", fmt.Errorf("e:invalid_query")
+	}
+
+	sid := strconv.FormatInt(time.Now().UnixNano(), 36)
+	if err := updateSavedSearches(uid, func(a *model.Article) error {
+		if len(a.Extras) >= common.Cfg.MaxSavedSearches {
+			return fmt.Errorf("e:too_many_saved_searches")
+		}
+		for _, v := range a.Extras {
+			var s model.SavedSearch
+			if json.Unmarshal([]byte(v), &s) == nil && s.Query == query {
+				return fmt.Errorf("e:duplicated_saved_search")
+			}
+		}
+		if a.Content == "" {
+			if _, _, err := DoInsertArticle(savedSearchUsersID, false, model.Article{Author: uid}); err != nil {
+				return err
+			}
+			a.Content = "listed"
+		}
+		buf, _ := json.Marshal(model.SavedSearch{Query: query, Create: time.Now()})
+		a.Extras[sid] = string(buf)
+		return nil
+	}); err != nil {
+		return "", err
+	}
+	return sid, registerSavedSearch(uid, sid, query, true)
+}
+
+func GetSavedSearches(uid string) []model.SavedSearch {
+	res := []model.SavedSearch{}
+	a, _ := GetArticle(makeSavedSearchesID(uid))
+	if a != nil {
+		for k, v := range a.Extras {
+			var s model.SavedSearch
+			if json.Unmarshal([]byte(v), &s) == nil {
+				s.ID = k
+				res = append(res, s)
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Create.After(res[j].Create) })
+	return res
+}
+
+func DeleteSavedSearch(uid, sid string) error {
+	var s model.SavedSearch
+	if err := updateSavedSearches(uid, func(a *model.Article) error {
+		json.Unmarshal([]byte(a.Extras[sid]), &s)
+		delete(a.Extras, sid)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if s.Query == "" {
+		return nil
+	}
+	return registerSavedSearch(uid, sid, s.Query, false)
+}
+
+// MuteSavedSearch stops or resumes notifications of the saved search
+func MuteSavedSearch(uid, sid string, muted bool) error {
+	var query string
+	if err := updateSavedSearches(uid, func(a *model.Article) error {
+		var s model.SavedSearch
+		if v, ok := a.Extras[sid]; !ok || json.Unmarshal([]byte(v), &s) != nil {
+			return fmt.Errorf("e:saved_search_not_found")
+		}
+		s.Muted = muted
+		buf, _ := json.Marshal(s)
+		a.Extras[sid] = string(buf)
+		query = s.Query
+		return nil
+	}); err != nil {
+		return err
+	}
+	return registerSavedSearch(uid, sid, query, !muted)
+}
+
+// matchSavedSearches runs the new article against saved searches registered under its terms,
+// each matched user will be notified once for the first matched search which has not been notified
+// 'SavedSearchNotify' times in the last hour
+func matchSavedSearches(a model.Article) {
+	if !a.Searchable() {
+		return
+	}
+
+	var parentAuthor string
+	if a.Parent != "" {
+		if p, _ := WeakGetArticle(a.Parent); p != nil && !p.Anonymous {
+			parentAuthor = p.Author
+		}
+	}
+	content, special := a.SearchTerms(parentAuthor)
+
+	routes := append([]string{""}, special...)
+	for term := range content {
+		routes = append(routes, term)
+	}
+	subs, err := index.Subscriptions(routes)
+	if err != nil {
+		log.Println("[SavedSearch] subscriptions:", a.ID, err)
+		return
+	}
+
+	matches := map[string][]string{}
+	for k, query := range subs {
+		idx := strings.Index(k, "/")
+		if idx == -1 || k[:idx] == a.Author {
+			continue
+		}
+		if q := parseSavedSearch(query); q.MatchTerms(content, special) && q.Match(&a, parentAuthor) {
+			matches[k[:idx]] = append(matches[k[:idx]], k[idx+1:])
+		}
+	}
+	if len(matches) == 0 {
+		return
+	}
+
+	author, _ := WeakGetUser(a.Author)
+	if author == nil {
+		return
+	}
+
+	for uid, sids := range matches {
+		if IsBlocking(uid, a.Author) || IsBlocking(a.Author, uid) {
+			continue
+		}
+		if GetMutes(uid).Match(common.IfStr(a.Anonymous, "", a.Author), a.Content) {
+			continue
+		}
+		if author.FollowApply != 0 {
+			if following, accepted := IsFollowingWithAcceptance(uid, author); !following || !accepted {
+				continue
+			}
+		}
+
+		sort.Strings(sids)
+		for _, sid := range sids {
+			k := uid + "/" + sid
+			r, err := ratelimit.Take(ratelimit.TokenBucket, "saved_search:"+k, common.Cfg.SavedSearchNotify, time.Hour)
+			if err != nil {
+				// Don't notify if the limit can't be checked, otherwise an outage will flood inboxes
+				log.Println("[SavedSearch] ratelimit:", k, err)
+				continue
+			}
+			if r.Allowed {
+				notifySavedSearch(uid, sid, subs[k], a.ID)
+				break
+			}
+		}
+	}
+}
+
+// registerAllSavedSearches registers unmuted saved searches of all listed users into the search index again
+func registerAllSavedSearches() (count int) {
+	root, err := GetArticle(savedSearchUsersID)
+	if err != nil {
+		if err != model.ErrNotExisted {
+			log.Println("[SavedSearch] users:", err)
+		}
+		return
+	}
+
+	for cursor := root.NextID; cursor != ""; {
+		a, err := GetArticle(cursor)
+		if err != nil {
+			log.Println("[SavedSearch] Failed to get:", cursor, err)
+			break
+		}
+		for _, s := range GetSavedSearches(a.Author) {
+			if s.Muted {
+				continue
+			}
+			if err := registerSavedSearch(a.Author, s.ID, s.Query, true); err != nil {
+				log.Println("[SavedSearch] register:", a.Author, s.ID, err)
+				continue
+			}
+			count++
+		}
+		cursor = a.NextID
+	}
+	return
+}
+
+func notifySavedSearch(uid, sid, query, articleID string) {
+	if _, _, err := DoInsertArticle(ik.NewID(ik.IDInbox, uid).String(), false, model.Article{
+		Cmd: model.CmdInboxSearch,
+		Extras: map[string]string{
+			"article_id": articleID,
+			"search_id":  sid,
+			"query":      query,
+		},
+	}); err != nil {
+		log.Println("[SavedSearch]", uid, err)
+		return
+	}
+	IncUnread(uid)
+}
@@ -0,0 +1,286 @@
+package dal
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal/ratelimit"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+// Scheduled articles are stored in u/<user_id>/scheduled/<schedule_id> until being published,
+// all pending ones are queued in scheduledQueueID: Extras["<user_id>/<schedule_id>"] = unix time of publishing,
+// the time is negated if publishing failed permanently, Extras["failed"] of the article tells the reason.
+// Articles get their IDs by Post() when published, so timelines are still ordered by the publish time
+const (
+	scheduledQueueID    = "scheduled_queue"
+	scheduledClaimTTL   = time.Minute // a failed publishing will be retried after the claim expires
+	scheduledMaxRetries = 5
+)
+
+type ScheduledPost struct {
+	ID      string
+	At      time.Time
+	Failed  string
+	Article *model.Article
+}
+
+func checkScheduleTime(at time.Time) error {
+	if !at.After(time.Now()) || at.After(time.Now().AddDate(0, 0, common.Cfg.MaxScheduleDays)) {
+		return fmt.Errorf("e:invalid_schedule_time")
+	}
+	return nil
+}
+
+// SchedulePost stores 'a' which will be published by 'author' at 'at', it returns the schedule ID
+func SchedulePost(a *model.Article, author *model.User, at time.Time) (string, error) {
+	if err := checkScheduleTime(at); err != nil {
+		return "", err
+	}
+
+	sid := ik.NewGeneralID().String()
+	a.ID = makeScheduledID(author.ID, sid)
+	a.Author = author.ID
+
+	return sid, DoUpsertArticle(scheduledQueueID, func(q *model.Article) error {
+		n := 0
+		for k := range q.Extras {
+			if strings.HasPrefix(k, author.ID+"/") {
+				n++
+			}
+		}
+		if n >= common.Cfg.MaxScheduledPosts {
+			return fmt.Errorf("e:too_many_scheduled_posts")
+		}
+		if err := m.db.Set(a.ID, a.Marshal()); err != nil {
+			return err
+		}
+		q.Extras[author.ID+"/"+sid] = strconv.FormatInt(at.Unix(), 10)
+		return nil
+	})
+}
+
+func GetScheduledPosts(uid string) []ScheduledPost {
+	res := []ScheduledPost{}
+	q, _ := GetArticle(scheduledQueueID)
+	if q == nil {
+		return res
+	}
+	for k, v := range q.Extras {
+		if !strings.HasPrefix(k, uid+"/") {
+			continue
+		}
+		sid := k[len(uid)+1:]
+		a, err := GetArticle(makeScheduledID(uid, sid))
+		if err != nil {
+			log.Println("[GetScheduledPosts] Failed to get:", k, err)
+			continue
+		}
+		p := ScheduledPost{ID: sid, Article: a}
+		if t, _ := strconv.ParseInt(v, 10, 64); t < 0 {
+			p.At, p.Failed = time.Unix(-t, 0), common.IfStr(a.Extras["failed"] == "", "unknown", a.Extras["failed"])
+		} else {
+			p.At = time.Unix(t, 0)
+		}
+		res = append(res, p)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].At.Before(res[j].At) })
+	return res
+}
+
+// UpdateScheduledPost changes the content and the publish time (if not zero) of a pending article
+func UpdateScheduledPost(uid, sid, content string, at time.Time) error {
+	if !at.IsZero() {
+		if err := checkScheduleTime(at); err != nil {
+			return err
+		}
+	}
+	return DoUpsertArticle(scheduledQueueID, func(q *model.Article) error {
+		k := uid + "/" + sid
+		if _, ok := q.Extras[k]; !ok {
+			return fmt.Errorf("e:scheduled_post_not_found")
+		}
+		if !claimScheduledPost(k, q.Extras[k]) {
+			return fmt.Errorf("e:scheduled_post_publishing")
+		}
+		defer ratelimit.Release(scheduledClaimKey(k, q.Extras[k]))
+
+		a, err := GetArticle(makeScheduledID(uid, sid))
+		if err != nil {
+			return err
+		}
+		if len(content) < 3 && a.Media == "" {
+			return fmt.Errorf("e:content_too_short")
+		}
+		a.Content = content
+		if !at.IsZero() {
+			delete(a.Extras, "failed")
+		}
+		if err := m.db.Set(a.ID, a.Marshal()); err != nil {
+			return err
+		}
+		if !at.IsZero() {
+			q.Extras[k] = strconv.FormatInt(at.Unix(), 10)
+		}
+		return nil
+	})
+}
+
+func CancelScheduledPost(uid, sid string) error {
+	k := uid + "/" + sid
+	q, _ := GetArticle(scheduledQueueID)
+	if q == nil || q.Extras[k] == "" {
+		return fmt.Errorf("e:scheduled_post_not_found")
+	}
+	if !claimScheduledPost(k, q.Extras[k]) {
+		return fmt.Errorf("e:scheduled_post_publishing")
+	}
+	if err := markScheduledPostDone(makeScheduledID(uid, sid), ""); err != nil {
+		return err
+	}
+	return removeScheduledPost(k, q.Extras[k])
+}
+
+func scheduledClaimKey(k, t string) string { return "scheduled/" + k + "/" + t }
+
+// claimScheduledPost claims the key queued at 't' across nodes, only the one who claims it can publish, edit or cancel it.
+// Rescheduling changes 't' so the post can be claimed again
+func claimScheduledPost(k, t string) bool {
+	ok, err := ratelimit.Once(scheduledClaimKey(k, t), scheduledClaimTTL)
+	if err != nil {
+		log.Println("[ScheduledPost] claim:", k, err)
+		return false
+	}
+	return ok
+}
+
+// removeScheduledPost removes the key from the queue if it is still queued at 't'
+func removeScheduledPost(k, t string) error {
+	return DoUpsertArticle(scheduledQueueID, func(q *model.Article) error {
+		if q.Extras[k] == t {
+			delete(q.Extras, k)
+		}
+		return nil
+	})
+}
+
+func markScheduledPostDone(id, published string) error {
+	return m.db.Set(id, (&model.Article{
+		ID:         id,
+		Content:    model.DeletionMarker,
+		CreateTime: time.Now(),
+		Extras:     map[string]string{"published": published},
+	}).Marshal())
+}
+
+// failScheduledPost keeps the article in the queue but it won't be published until being rescheduled
+func failScheduledPost(k, id, reason string) {
+	log.Println("[ScheduledPost] failed:", id, reason)
+	if _, err := DoUpdateArticle(id, func(a *model.Article) error {
+		a.Extras["failed"] = reason
+		return nil
+	}); err != nil {
+		log.Println("[ScheduledPost] mark failed:", id, err)
+		return
+	}
+	if err := DoUpsertArticle(scheduledQueueID, func(q *model.Article) error {
+		if v := q.Extras[k]; v != "" && !strings.HasPrefix(v, "-") {
+			q.Extras[k] = "-" + v
+		}
+		return nil
+	}); err != nil {
+		log.Println("[ScheduledPost] mark failed:", id, err)
+	}
+}
+
+func publishScheduledPost(k, t string) {
+	idx := strings.Index(k, "/")
+	if idx == -1 || !claimScheduledPost(k, t) {
+		return
+	}
+
+	id := makeScheduledID(k[:idx], k[idx+1:])
+	a, err := GetArticle(id)
+	if err != nil && err != model.ErrNotExisted {
+		log.Println("[ScheduledPost] Failed to get:", id, err)
+		return
+	}
+	published, _ := ratelimit.Blocked("scheduled-published/" + k)
+	if a == nil || a.IsDeleted() || published > 0 {
+		// Published or cancelled, but not removed from the queue
+		if err := removeScheduledPost(k, t); err != nil {
+			log.Println("[ScheduledPost] remove:", k, err)
+		}
+		return
+	}
+
+	author, err := GetUser(a.Author)
+	if err != nil && err != model.ErrNotExisted {
+		log.Println("[ScheduledPost] Failed to get author:", id, err)
+		return
+	}
+	if author == nil {
+		failScheduledPost(k, id, "user_not_found")
+		return
+	}
+	if author.Banned {
+		failScheduledPost(k, id, "user_banned")
+		return
+	}
+
+	a.CreateTime = time.Now()
+	if _, err := Post(a, author); err != nil {
+		log.Println("[ScheduledPost] publish:", id, err)
+		if n, _ := ratelimit.Hit("scheduled/"+k, time.Hour); n >= scheduledMaxRetries {
+			failScheduledPost(k, id, err.Error())
+		}
+		return
+	}
+
+	// Any of these is enough to stop the post from being published again
+	if err := ratelimit.Block("scheduled-published/"+k, 24*time.Hour); err != nil {
+		log.Println("[ScheduledPost] mark:", id, err)
+	}
+	if err := removeScheduledPost(k, t); err != nil {
+		log.Println("[ScheduledPost] remove:", k, err)
+	}
+	if err := markScheduledPostDone(id, a.ID); err != nil {
+		log.Println("[ScheduledPost] mark:", id, err)
+	}
+}
+
+func publishDuePosts() {
+	q, err := GetArticle(scheduledQueueID)
+	if err != nil {
+		if err != model.ErrNotExisted {
+			log.Println("[ScheduledPost] queue:", err)
+		}
+		return
+	}
+
+	now := time.Now().Unix()
+	due := []string{}
+	for k, v := range q.Extras {
+		if t, _ := strconv.ParseInt(v, 10, 64); t > 0 && t <= now {
+			due = append(due, k)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return q.Extras[due[i]] < q.Extras[due[j]] })
+
+	for _, k := range due {
+		publishScheduledPost(k, q.Extras[k])
+	}
+}
+
+// watchScheduledPosts publishes due articles, those overdue during downtime will be published at the first tick
+func watchScheduledPosts() {
+	for range time.Tick(10 * time.Second) {
+		publishDuePosts()
+	}
+}
@@ -0,0 +1,194 @@
+package dal
+
+import (
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal/index"
+	"github.com/coyove/iis/dal/ratelimit"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+// The article search index is an inverted index stored in redis, see package index.
+// Special terms (from:, to:, #tag, has:, is:) are stored with tf = 0 and used only for filtering,
+// their postings are not bounded by 'SearchPostingSize'.
+const (
+	bm25K1         = 1.2
+	bm25B          = 0.75
+	maxSearchTerms = 16
+)
+
+// IndexArticle puts the article into the search index, the old index of the same article will be replaced
+func IndexArticle(a *model.Article) error {
+	if !a.Searchable() {
+		return UnindexArticle(a.ID)
+	}
+
+	var parentAuthor string
+	if a.Parent != "" {
+		if p, _ := WeakGetArticle(a.Parent); p != nil && !p.Anonymous {
+			parentAuthor = p.Author
+		}
+	}
+
+	terms, special := a.SearchTerms(parentAuthor)
+	for _, term := range special {
+		terms[term] = 0
+	}
+	return index.Add(a.ID, ik.ParseID(a.ID).Time().UnixNano()/1e6, terms, common.Cfg.SearchPostingSize)
+}
+
+// UnindexArticle removes the article from the search index
+func UnindexArticle(id string) error {
+	return index.Remove(id)
+}
+
+// SearchArticles returns IDs of the matched articles ranked by BM25 with recency decay, or by time if 'latest' is true.
+// Articles must contain all 'required' terms, and at least one of 'terms' if provided
+func SearchArticles(terms, required []string, latest bool) []string {
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+	if len(terms) == 0 && len(required) == 0 {
+		return nil
+	}
+
+	docs, avgLen := 1.0, 1.0
+	if n, l, err := index.Stats(); err == nil {
+		docs = math.Max(float64(n), 1)
+		avgLen = math.Max(float64(l)/docs, 1)
+	}
+
+	scores := map[string]float64{}
+	if len(terms) == 0 {
+		ids, err := index.Latest(required, common.Cfg.SearchPostingSize)
+		if err != nil {
+			log.Println("[Search] latest:", required, err)
+		}
+		for _, id := range ids {
+			scores[id] = 0
+		}
+	} else {
+		postings, err := index.Postings(terms)
+		if err != nil {
+			log.Println("[Search] get postings:", terms, err)
+			return nil
+		}
+
+		var candidates map[string]bool
+		if len(required) > 0 {
+			ids := []string{}
+			for _, posting := range postings {
+				for id := range posting {
+					ids = append(ids, id)
+				}
+			}
+			if candidates, err = index.Filter(ids, required); err != nil {
+				log.Println("[Search] filter:", required, err)
+				return nil
+			}
+		}
+
+		for _, term := range terms {
+			posting := postings[term]
+			n := float64(len(posting))
+			idf := math.Log(1 + (docs-n+0.5)/(n+0.5))
+			for id, p := range posting {
+				if candidates != nil && !candidates[id] {
+					continue
+				}
+				tf := float64(p.TF)
+				scores[id] += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*float64(p.Len)/avgLen))
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(scores))
+	times := make(map[string]time.Time, len(scores))
+	for id, score := range scores {
+		ids = append(ids, id)
+		times[id] = ik.ParseID(id).Time()
+		scores[id] = score * recencyDecay(times[id])
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if latest || scores[ids[i]] == scores[ids[j]] {
+			return times[ids[i]].After(times[ids[j]])
+		}
+		return scores[ids[i]] > scores[ids[j]]
+	})
+	return ids
+}
+
+// recencyDecay returns the factor applied to the score, 'SearchRecencyWeight' of the score halves every 'SearchRecencyHalfLife' days
+func recencyDecay(t time.Time) float64 {
+	if common.Cfg.SearchRecencyHalfLife <= 0 {
+		return 1
+	}
+	w := math.Min(math.Max(common.Cfg.SearchRecencyWeight, 0), 1)
+	age := time.Since(t).Hours() / 24 / float64(common.Cfg.SearchRecencyHalfLife)
+	return 1 - w + w*math.Pow(0.5, math.Max(age, 0))
+}
+
+// EnsureSearchIndex rebuilds the index with at most 'n' articles in the background if it doesn't exist,
+// e.g. redis has been flushed or runs in memory, only one node will do the rebuilding
+func EnsureSearchIndex(n int) {
+	ok, err := index.Exists()
+	if err != nil {
+		log.Println("[RebuildSearchIndex] check:", err)
+		return
+	}
+	if ok {
+		return
+	}
+	if claimed, _ := ratelimit.Once("search-rebuild", time.Hour); !claimed {
+		return
+	}
+	go func() {
+		start := time.Now()
+		log.Println("[RebuildSearchIndex] index not found, rebuilding")
+		log.Println("[RebuildSearchIndex]", RebuildSearchIndex(n), "articles indexed in", time.Since(start))
+	}()
+}
+
+// RebuildSearchIndex walks master timelines and reindexes at most 'n' articles along with their replies,
+// saved searches are registered again before that
+func RebuildSearchIndex(n int) (count int) {
+	log.Println("[RebuildSearchIndex]", registerAllSavedSearches(), "saved searches registered")
+
+	cursors := make([]ik.ID, Masters)
+	for i := range cursors {
+		master := "master"
+		if i > 0 {
+			master += strconv.Itoa(i)
+		}
+		cursors[i] = ik.NewID(ik.IDAuthor, master)
+	}
+
+	for count < n {
+		var as []*model.Article
+		if as, cursors = WalkMulti(false, 50, cursors...); len(as) == 0 {
+			break
+		}
+		for _, a := range as {
+			if err := IndexArticle(a); err != nil {
+				log.Println("[RebuildSearchIndex]", a.ID, err)
+			}
+			count++
+
+			for cursor := a.ReplyChain; cursor != ""; {
+				var replies []*model.Article
+				replies, cursor = WalkReply(50, cursor)
+				for _, r := range replies {
+					IndexArticle(r)
+					count++
+				}
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,176 @@
+package dal
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/common/geoip"
+	"github.com/coyove/iis/common/useragent"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	maxSessions      = 20
+	sessionTouchSecs = 600
+)
+
+// SessionHash hashes the session secret, only hashes are stored and shown to users
+func SessionHash(session string) string {
+	h := sha1.Sum([]byte(session))
+	return hex.EncodeToString(h[:6])
+}
+
+// Sessions are stored in u/<user_id>/sessions: Extras[hash] = "create,last_use,ip,device"
+func parseSession(id, v string) (s model.Session, ok bool) {
+	p := strings.Split(v, ",")
+	if len(p) != 4 {
+		return s, false
+	}
+	create, _ := strconv.ParseInt(p[0], 36, 64)
+	last, _ := strconv.ParseInt(p[1], 36, 64)
+	s = model.Session{
+		ID:      id,
+		Create:  time.Unix(create, 0),
+		LastUse: time.Unix(last, 0),
+		IP:      p[2],
+		Device:  p[3],
+	}
+	return s, true
+}
+
+func formatSession(s model.Session) string {
+	return strconv.FormatInt(s.Create.Unix(), 36) + "," + strconv.FormatInt(s.LastUse.Unix(), 36) + "," +
+		strings.Replace(s.IP, ",", "", -1) + "," + strings.Replace(s.Device, ",", "", -1)
+}
+
+func updateSessions(uid string, f func(a *model.Article)) error {
+	return DoUpsertArticle(makeSessionsID(uid), func(a *model.Article) error { f(a); return nil })
+}
+
+func sessionInfo(g *gin.Context) (ip, device string) {
+	return common.MaskIP(g.MustGet("ip").(net.IP)), useragent.Parser(g.Request.UserAgent()).String()
+}
+
+// NewSession creates a new session for the user and returns its secret
+func NewSession(uid string, g *gin.Context) (string, error) {
+	session := GenSession()
+	ip, device := sessionInfo(g)
+	now := time.Now()
+	return session, updateSessions(uid, func(a *model.Article) {
+		a.Extras[SessionHash(session)] = formatSession(model.Session{Create: now, LastUse: now, IP: ip, Device: device})
+
+		if len(a.Extras) > maxSessions {
+			// Drop the least recently used one
+			var lru model.Session
+			for k, v := range a.Extras {
+				if s, ok := parseSession(k, v); ok && (lru.ID == "" || s.LastUse.Before(lru.LastUse)) {
+					lru = s
+				}
+			}
+			delete(a.Extras, lru.ID)
+		}
+	})
+}
+
+func CheckSession(uid, session string) bool {
+	if session == "" {
+		return false
+	}
+	a, err := GetArticle(makeSessionsID(uid))
+	if err != nil {
+		return false
+	}
+	_, ok := a.Extras[SessionHash(session)]
+	return ok
+}
+
+// TouchSession updates the last use time and IP of the session, at most once per 'sessionTouchSecs'
+func TouchSession(u *model.User, g *gin.Context) {
+	session := u.CurrentSession()
+	if session == "" || session == u.Session {
+		return
+	}
+	key := "u/" + u.ID + "/session-touch/" + SessionHash(session)
+	if v, ok := CacheGet(key); ok {
+		if t, _ := strconv.ParseInt(v, 10, 64); time.Now().Unix()-t < sessionTouchSecs {
+			return
+		}
+	}
+	CacheSet(key, strconv.FormatInt(time.Now().Unix(), 10))
+
+	ip, device := sessionInfo(g)
+	go updateSessions(u.ID, func(a *model.Article) {
+		h := SessionHash(session)
+		s, ok := parseSession(h, a.Extras[h])
+		if !ok {
+			return
+		}
+		s.LastUse, s.IP, s.Device = time.Now(), ip, device
+		a.Extras[h] = formatSession(s)
+	})
+}
+
+func GetSessions(u *model.User) []model.Session {
+	res := []model.Session{}
+	if u.Session != "" {
+		// Legacy single session
+		res = append(res, model.Session{
+			ID:      SessionHash(u.Session),
+			Create:  u.Login(),
+			LastUse: u.Login(),
+			Current: u.CurrentSession() == u.Session,
+		})
+	}
+
+	a, _ := GetArticle(makeSessionsID(u.ID))
+	if a != nil {
+		current := SessionHash(u.CurrentSession())
+		for k, v := range a.Extras {
+			if s, ok := parseSession(k, v); ok {
+				s.Location, _ = geoip.LookupIP(s.IP)
+				s.Current = k == current
+				res = append(res, s)
+			}
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].LastUse.After(res[j].LastUse) })
+	return res
+}
+
+// RevokeSession revokes the session by its hash, or all sessions except the current one if 'hash' is empty
+func RevokeSession(u *model.User, hash string) error {
+	current := SessionHash(u.CurrentSession())
+	if u.Session != "" && (SessionHash(u.Session) == hash || (hash == "" && u.Session != u.CurrentSession())) {
+		if _, err := DoUpdateUser(u.ID, "Session", ""); err != nil {
+			return err
+		}
+	}
+	return updateSessions(u.ID, func(a *model.Article) {
+		for k := range a.Extras {
+			if k == hash || (hash == "" && k != current) {
+				delete(a.Extras, k)
+			}
+		}
+	})
+}
+
+func GenSession() string {
+	p := [12]byte{}
+	rand.Read(p[:])
+	for i := range p {
+		if p[i] == 0 {
+			p[i] = 1
+		}
+	}
+	return base64.URLEncoding.EncodeToString(p[:])
+}
@@ -0,0 +1,132 @@
+package dal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+)
+
+// A thread is an article followed by its author's own chained replies: part N+1 replies to part N.
+// Threads submitted by the composer have Extras["thread"] set on the first part, but any self-reply chain can be unrolled
+func PostThread(parent string, a *model.Article, parts []string, author *model.User) (*model.Article, error) {
+	if len(parts) == 0 {
+		if parent == "" {
+			return Post(a, author)
+		}
+		return PostReply(parent, a, author)
+	}
+
+	if a.Anonymous {
+		return nil, fmt.Errorf("e:thread_not_supported")
+	}
+	for _, part := range parts {
+		if len(part) < 3 {
+			return nil, fmt.Errorf("e:content_too_short")
+		}
+	}
+
+	var p *model.Article
+	if parent != "" {
+		var err error
+		if p, err = checkReply(parent, author); err != nil {
+			return nil, err
+		}
+	}
+
+	// The head is stored alone and parts are chained under it, the thread becomes reachable
+	// only when the head is inserted into the timeline or the parent at last
+	a.ID = ik.NewGeneralID().String()
+	a.Author = author.ID
+	a.Extras["thread"] = "1"
+	if a.CreateTime.IsZero() {
+		a.CreateTime = time.Now()
+	}
+	if p != nil {
+		a.Parent = p.ID
+	}
+	if err := m.db.Set(a.ID, a.Marshal()); err != nil {
+		return nil, err
+	}
+
+	posted := []*model.Article{a}
+	for _, part := range parts {
+		prev := posted[len(posted)-1]
+		r, _, err := DoInsertArticle(prev.ID, true, model.Article{
+			ID:            ik.NewGeneralID().String(),
+			Author:        author.ID,
+			Content:       part,
+			IP:            a.IP,
+			Parent:        prev.ID,
+			PostOptions:   a.PostOptions | model.PostOptionNoTimeline,
+			NSFW:          a.NSFW,
+			Asc:           a.Asc,
+			ReplyLockMode: a.ReplyLockMode,
+			CreateTime:    time.Now(),
+			Extras:        map[string]string{},
+		})
+		if err != nil {
+			// Nothing has been linked or notified, parts inserted so far are unreachable
+			return nil, err
+		}
+		posted = append(posted, &r)
+	}
+
+	// Read the head again, its reply chain has been updated by the first part
+	head, err := GetArticle(a.ID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		if _, _, err := DoInsertArticle(ik.NewID(ik.IDAuthor, author.ID).String(), false, *head); err != nil {
+			return nil, err
+		}
+		afterPost(head)
+	} else {
+		if head, err = insertReply(p, head); err != nil {
+			return nil, err
+		}
+		afterReply(p, head)
+	}
+	for i, r := range posted[1:] {
+		afterReply(posted[i], r)
+	}
+	return head, nil
+}
+
+// WalkThread returns at most 'n' parts of the thread starting from 'id',
+// the earliest self-reply of each part is considered as the next part
+func WalkThread(id string, n int) ([]*model.Article, error) {
+	a, err := GetArticle(id)
+	if err != nil {
+		return nil, err
+	}
+	res := []*model.Article{a}
+	for len(res) < n {
+		next := firstSelfReply(res[len(res)-1])
+		if next == nil {
+			break
+		}
+		res = append(res, next)
+	}
+	return res, nil
+}
+
+func firstSelfReply(p *model.Article) (first *model.Article) {
+	cursor := p.ReplyChain
+	for scanned := 0; cursor != "" && scanned < 500; {
+		var replies []*model.Article
+		replies, cursor = WalkReply(50, cursor)
+		for _, r := range replies {
+			if r.Author == p.Author && (first == nil || r.CreateTime.Before(first.CreateTime)) {
+				first = r
+			}
+		}
+		scanned += len(replies)
+		if len(replies) == 0 {
+			break
+		}
+	}
+	return first
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal/feed"
 	"github.com/coyove/iis/dal/tagrank"
 	"github.com/coyove/iis/ik"
 	"github.com/coyove/iis/model"
@@ -18,6 +19,7 @@ import (
 
 func init() {
 	model.DalIsBlocking = IsBlocking
+	model.DalIsMuting = IsMuting
 	model.DalIsFollowing = IsFollowing
 	model.DalIsFollowingWithAcceptance = IsFollowingWithAcceptance
 }
@@ -51,13 +53,34 @@ func getterUser(getter func(string) ([]byte, error), id string) (*model.User, er
 }
 
 func GetUserByContext(g *gin.Context) *model.User {
-	u, _ := GetUserByToken(g.PostForm("api2_uid"), g.GetBool("allow-api"))
+	// API tokens are only accepted by handlers requiring a scope, see middleware.RequireScope
+	s, _ := g.Get("api-scope")
+	scope, _ := s.(model.APIScope)
+	u, _ := GetUserByToken(ContextToken(g), scope != 0)
 	if u != nil && u.Banned {
 		return nil
 	}
+	if u != nil && u.IsAPI() {
+		if !u.HasScope(scope) {
+			return nil
+		}
+		TouchAPIToken(u, g)
+	}
 	return u
 }
 
+// ContextToken returns the user token in the request, either in form or in the
+// "Authorization: Bearer" header used by OAuth clients
+func ContextToken(g *gin.Context) string {
+	if tok := g.PostForm("api2_uid"); tok != "" {
+		return tok
+	}
+	if h := g.GetHeader("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return strings.TrimSpace(h[7:])
+	}
+	return ""
+}
+
 func GetUserByToken(tok string, allowAPI bool) (*model.User, error) {
 	id, session, err := ik.ParseUserToken(tok)
 	if err != nil {
@@ -70,16 +93,71 @@ func GetUserByToken(tok string, allowAPI bool) (*model.User, error) {
 	}
 
 	if allowAPI && tok == u.APIToken {
-		u.SetIsAPI(true)
+		// Legacy API token
+		u.SetIsAPI(true).SetAPIScope(model.ScopeAll)
 		return u, nil
 	}
 
-	if u.Session != string(session) {
+	if allowAPI {
+		if t, ok := getAPIToken(u.ID, session); ok {
+			u.SetIsAPI(true).SetAPIScope(t.Scope).SetCurrentSession(session)
+			return u, nil
+		}
+		if g, ok := checkOAuthAccess(u.ID, session); ok {
+			u.SetIsAPI(true).SetAPIScope(g.Scope).SetCurrentSession(session)
+			return u, nil
+		}
+	}
+
+	if session == "" || (u.Session != session && !CheckSession(u.ID, session)) {
 		return nil, fmt.Errorf("invalid token session")
 	}
+	u.SetCurrentSession(session)
 	return u, nil
 }
 
+// GetUserByEmail finds the user by verified email, the result is always checked against User.Email
+// because the index is never cleared when the user changes email
+func GetUserByEmail(email string) (*model.User, error) {
+	if email == "" {
+		return nil, model.ErrNotExisted
+	}
+	a, err := GetArticle(makeEmailID(email))
+	if err != nil {
+		return nil, err
+	}
+	u, err := GetUser(a.Content)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(u.VerifiedEmail(), email) {
+		return nil, model.ErrNotExisted
+	}
+	return u, nil
+}
+
+// VerifyUserEmail makes the pending email the verified one
+func VerifyUserEmail(uid, email string) error {
+	if _, err := DoUpdateUser(uid, func(u *model.User) error {
+		if u.PendingEmail != email {
+			return fmt.Errorf("e:expired_session")
+		}
+		u.Email, u.EmailVerified, u.PendingEmail = email, true, ""
+		return nil
+	}); err != nil {
+		return err
+	}
+	return indexEmail(uid, email)
+}
+
+func indexEmail(uid, email string) error {
+	if email == "" {
+		return nil
+	}
+	a := &model.Article{ID: makeEmailID(email), Content: uid, CreateTime: time.Now()}
+	return m.db.Set(a.ID, a.Marshal())
+}
+
 func ClearInbox(uid string) error {
 	_, err := DoUpdateArticle(ik.NewID(ik.IDInbox, uid).String(), func(a *model.Article) {
 		a.NextID, a.NextMediaID = "", ""
@@ -132,6 +210,7 @@ func FollowUser(from, to string, following bool) (E error) {
 
 		go func() {
 			DoUpdateUser(from, func(u *model.User) { u.Followings += int32(common.BoolInt2(following)) })
+			onFollowChanged(from, to, following)
 			if !strings.HasPrefix(to, "#") {
 				notifyNewFollower(from, to, following)
 
@@ -202,6 +281,9 @@ func BlockUser(from, to string, blocking bool) (E error) {
 			log.Println("Unaccept user:", to, "unfollow error:", err)
 		}
 	}
+	if blocking && common.Cfg.HomeFeed {
+		go feed.RemoveOwner(from, to)
+	}
 	_, _, err := DoUpdateOrInsertCmdArticle(
 		ik.NewID(ik.IDBlacklist, from).String(),
 		makeBlockID(from, to),
@@ -220,6 +302,9 @@ func AcceptUser(from, to string, accept bool) (E error) {
 				Extras: map[string]string{"from": from},
 			})
 			IncUnread(to)
+			if fromUser, _ := WeakGetUser(from); fromUser != nil && common.Cfg.HomeFeed {
+				backfillHomeFeed(to, fromUser)
+			}
 		}()
 	}
 	return m.db.Set(id, (&model.Article{
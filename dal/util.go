@@ -49,10 +49,78 @@ func makeBlockID(from, to string) string {
 	return "u/" + from + "/block/" + to
 }
 
+func makeMutesID(from string) string {
+	return "u/" + from + "/mutes"
+}
+
 func makeLikeID(from, to string) string {
 	return "u/" + from + "/like/" + to
 }
 
+func makeRepostID(from, to string) string {
+	return "u/" + from + "/repost/" + to
+}
+
+func makeBookmarkID(from, to string) string {
+	return "u/" + from + "/bookmark/" + to
+}
+
+func makeBookmarkFoldersID(from string) string {
+	return "u/" + from + "/bookmark_folders"
+}
+
+func makeListsID(from string) string {
+	return "u/" + from + "/lists"
+}
+
+func makeListMembersID(from, lid string) string {
+	return "u/" + from + "/list/" + lid
+}
+
+func makeListSubsID(from string) string {
+	return "u/" + from + "/list_subs"
+}
+
+func makeScheduledID(from, sid string) string {
+	return "u/" + from + "/scheduled/" + sid
+}
+
+func makeDraftsID(from string) string {
+	return "u/" + from + "/drafts"
+}
+
+func makeRevisionID(id string, n int32) string {
+	return "rev/" + id + "/" + strconv.Itoa(int(n))
+}
+
+func makeEmailID(email string) string {
+	return "email/" + strings.ToLower(email)
+}
+
+func makeAPITokensID(from string) string {
+	return "u/" + from + "/api_tokens"
+}
+
+func makeOAuthClientID(id string) string {
+	return "oauth_client/" + id
+}
+
+func makeOAuthClientsID(from string) string {
+	return "u/" + from + "/oauth_clients"
+}
+
+func makeOAuthGrantsID(from string) string {
+	return "u/" + from + "/oauth_grants"
+}
+
+func makeSavedSearchesID(from string) string {
+	return "u/" + from + "/saved_searches"
+}
+
+func makeSessionsID(from string) string {
+	return "u/" + from + "/sessions"
+}
+
 func makeCheckpointID(from string, t time.Time) string {
 	return "u/" + from + "/checkpoint/" + t.Format("2006-01")
 }
@@ -21,13 +21,20 @@ type ArticleView struct {
 	ParentLink    string
 	Others        []*ArticleView
 	Parent        *ArticleView
+	Quote         *ArticleView
 	Author        *model.User
+	RepostBy      *model.User
 	You           *model.User
 	Cmd           string
 	Replies       int
 	Likes         int
+	Reposts       int
+	Revisions     int
 	ReplyLockMode byte
 	Liked         bool
+	Reposted      bool
+	Bookmarked    bool
+	Editable      bool
 	NSFW          bool
 	NoAvatar      bool
 	GreyOutReply  bool
@@ -40,6 +47,7 @@ type ArticleView struct {
 	Content       string
 	ShortContent  string
 	ContentHTML   template.HTML
+	Snippet       template.HTML // highlighted search result
 	Media         template.HTML
 	MediaType     string
 	History       string
@@ -63,6 +71,8 @@ func (a *ArticleView) from(a2 *model.Article, opt int, u *model.User) *ArticleVi
 	a.Link = "/S/" + a.ID[1:]
 	a.Replies = int(a2.Replies)
 	a.Likes = int(a2.Likes)
+	a.Reposts = int(a2.Reposts)
+	a.Revisions = int(a2.Revisions)
 	a.ReplyLockMode = a2.ReplyLockMode
 	a.NSFW = a2.NSFW
 	a.StickOnTop = a2.T_StickOnTop
@@ -84,12 +94,18 @@ func (a *ArticleView) from(a2 *model.Article, opt int, u *model.User) *ArticleVi
 	if a2.Extras["is_bot"] != "" {
 		a.Author.SetIsAPI(true)
 	}
+	if a2.T_RepostBy != "" {
+		a.RepostBy, _ = dal.WeakGetUser(a2.T_RepostBy)
+	}
 
 	a.You = u
 	if a.You == nil {
 		a.You = &model.User{}
 	} else {
 		a.Liked = dal.IsLiking(u.ID, a2.ID)
+		a.Reposted = dal.IsReposting(u.ID, a2.ID)
+		a.Bookmarked = dal.IsBookmarking(u.ID, a2.ID)
+		a.Editable = dal.CanEdit(a2, u)
 
 		if a.Extras["poll_title"] != "" {
 			pa, err := dal.GetArticle("u/" + u.ID + "/poll/" + a.ID)
@@ -153,12 +169,20 @@ func (a *ArticleView) from(a2 *model.Article, opt int, u *model.User) *ArticleVi
 		a.ParentLink = "/S/" + a2.Parent[1:]
 	}
 
+	if q := a2.Extras["quote"]; q != "" && opt != aTimelineReply {
+		a.Quote = &ArticleView{}
+		if p, _ := dal.WeakGetArticle(q); p != nil && !p.IsDeleted() {
+			a.Quote.from(p, aTimelineReply, u)
+			a.Quote.NoAvatar = false
+		}
+	}
+
 	a.GreyOutReply = opt == aReplyParent
 	a.NoAvatar = opt == aTimelineReply
 	a.OpenBlank = opt != aReply
 
 	switch a2.Cmd {
-	case model.CmdInboxReply, model.CmdInboxMention:
+	case model.CmdInboxReply, model.CmdInboxMention, model.CmdInboxQuote:
 		p, _ := dal.WeakGetArticle(a2.Extras["article_id"])
 		if p == nil {
 			*a = ArticleView{}
@@ -167,6 +191,20 @@ func (a *ArticleView) from(a2 *model.Article, opt int, u *model.User) *ArticleVi
 
 		a.from(p, opt, u)
 		a.Cmd = string(a2.Cmd)
+	case model.CmdInboxSearch:
+		p, _ := dal.WeakGetArticle(a2.Extras["article_id"])
+		if p == nil || p.IsDeleted() {
+			*a = ArticleView{}
+			return a
+		}
+
+		a.from(p, opt, u)
+		a.Cmd = string(a2.Cmd)
+		extras := map[string]string{"search_query": a2.Extras["query"]}
+		for k, v := range a.Extras {
+			extras[k] = v
+		}
+		a.Extras = extras
 	case model.CmdInboxFwAccepted:
 		dummy := &model.Article{
 			ID:         ik.NewGeneralID().String(),
@@ -175,6 +213,19 @@ func (a *ArticleView) from(a2 *model.Article, opt int, u *model.User) *ArticleVi
 		}
 		a.from(dummy, opt, u)
 		a.Cmd = model.CmdInboxFwAccepted
+	case model.CmdInboxLoginAlert:
+		if u == nil {
+			*a = ArticleView{}
+			return a
+		}
+		dummy := &model.Article{
+			ID:         ik.NewGeneralID().String(),
+			CreateTime: a2.CreateTime,
+			Author:     u.ID,
+			Extras:     a2.Extras,
+		}
+		a.from(dummy, opt, u)
+		a.Cmd = model.CmdInboxLoginAlert
 	case model.CmdInboxFwApply:
 		following, accepted := dal.IsFollowingWithAcceptance(a2.Extras["from"], u)
 		if following && accepted {
@@ -189,6 +240,21 @@ func (a *ArticleView) from(a2 *model.Article, opt int, u *model.User) *ArticleVi
 		}
 		a.from(dummy, opt, u)
 		a.Cmd = model.CmdInboxFwApply
+	case model.CmdInboxRepost:
+		p, _ := dal.WeakGetArticle(a2.Extras["article_id"])
+		if p == nil || !dal.IsReposting(a2.Extras["from"], p.ID) {
+			*a = ArticleView{}
+			return a
+		}
+
+		dummy := &model.Article{
+			ID:         ik.NewGeneralID().String(),
+			CreateTime: a2.CreateTime,
+			Author:     a2.Extras["from"],
+			Parent:     p.ID,
+		}
+		a.from(dummy, opt, u)
+		a.Cmd = model.CmdInboxRepost
 	case model.CmdInboxLike, model.CmdTimelineLike:
 		p, _ := dal.WeakGetArticle(a2.Extras["article_id"])
 		if p == nil {
@@ -219,6 +285,17 @@ func (a *ArticleView) from(a2 *model.Article, opt int, u *model.User) *ArticleVi
 	return a
 }
 
+// mutedBy returns true if the author or the content is muted, muted views are dropped instead of blanked
+func (a *ArticleView) mutedBy(m *model.Mutes) bool {
+	if m.IsEmpty() || a.ID == "" || a.Author == nil || a.Author.ID == a.You.ID {
+		return false
+	}
+	if a.RepostBy != nil && m.IsMutingUser(a.RepostBy.ID) {
+		return true
+	}
+	return m.Match(a.Author.ID, a.Content)
+}
+
 func fromMultiple(g *gin.Context, a *[]ArticleView, a2 []*model.Article, opt int, u *model.User) {
 	*a = make([]ArticleView, len(a2))
 
@@ -227,9 +304,21 @@ func fromMultiple(g *gin.Context, a *[]ArticleView, a2 []*model.Article, opt int
 	cluster := map[string][]string{}
 	isCrawler := common.IsCrawler(g)
 
+	var mutes *model.Mutes
+	if u != nil {
+		mutes = dal.GetMutes(u.ID)
+	}
+
 	for i, v := range a2 {
 		(*a)[i].from(v, opt, u)
 		(*a)[i].IsCrawler = isCrawler
+		if (*a)[i].mutedBy(mutes) {
+			(*a)[i] = ArticleView{}
+			continue
+		}
+		if p := (*a)[i].Parent; p != nil && p.mutedBy(mutes) {
+			(*a)[i].Parent = nil
+		}
 		lookup[(*a)[i].ID] = &(*a)[i]
 	}
 
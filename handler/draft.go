@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+func Drafts(g *gin.Context) {
+	p := struct {
+		You    *model.User
+		Drafts []*model.Draft
+	}{
+		You: getUser(g),
+	}
+
+	if p.You == nil {
+		redirectVisitor(g)
+		return
+	}
+
+	p.Drafts = dal.GetDrafts(p.You.ID)
+	g.HTML(200, "drafts.html", p)
+}
+
+// APIDraft saves the post box, it accepts the same fields as APINew
+func APIDraft(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	id := g.PostForm("id")
+
+	switch g.PostForm("method") {
+	case "save":
+		replyLock, _ := strconv.Atoi(g.PostForm("reply_lock"))
+		id, err := dal.SaveDraft(u.ID, &model.Draft{
+			ID:        id,
+			Content:   common.SoftTrunc(g.PostForm("content"), int(common.Cfg.MaxContent)),
+			Media:     common.DetectMedia(g.PostForm("media")),
+			Poll:      g.PostForm("poll") == "1",
+			ReplyTo:   g.PostForm("parent"),
+			NSFW:      g.PostForm("nsfw") != "",
+			ReplyLock: byte(replyLock),
+		})
+		throw(err, "")
+		okok(g, id)
+		return
+	case "delete":
+		throw(id == "", "")
+		throw(dal.DeleteDraft(u.ID, id), "")
+	default:
+		throw(true, "")
+	}
+	okok(g)
+}
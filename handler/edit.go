@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"html/template"
+	"net/url"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+func Revisions(g *gin.Context) {
+	type revision struct {
+		Index       int
+		Time        time.Time
+		ContentHTML template.HTML
+	}
+
+	p := struct {
+		You       *model.User
+		Article   ArticleView
+		Revisions []revision
+	}{
+		You: getUser(g),
+	}
+
+	a, _ := dal.GetArticle(g.Param("id"))
+	if a == nil || a.IsDeleted() || a.Cmd != model.CmdNone || a.ReferID != "" {
+		NotFound(g)
+		return
+	}
+
+	author, _ := dal.WeakGetUser(a.Author)
+	if author == nil || (p.You != nil && dal.IsBlocking(author.ID, p.You.ID)) {
+		NotFound(g)
+		return
+	}
+	if !checkFollowApply(g, author, p.You) {
+		return
+	}
+
+	p.Article.from(a, aReplyParent, p.You)
+	revs := dal.GetRevisions(a.ID, a.Revisions)
+	for i := len(revs) - 1; i >= 0; i-- {
+		p.Revisions = append(p.Revisions, revision{
+			Index:       i,
+			Time:        revs[i].CreateTime,
+			ContentHTML: template.HTML(common.SanText(revs[i].Content)),
+		})
+	}
+	g.HTML(200, "revisions.html", p)
+}
+
+func APIEditArticle(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	throw(checkIP(g), "")
+	a, err := dal.EditArticle(u, g.PostForm("id"), common.SoftTrunc(g.PostForm("content"), int(common.Cfg.MaxContent)))
+	throw(err, "")
+	okok(g, url.PathEscape(string(a.ContentHTML())))
+}
@@ -23,9 +23,16 @@ type ArticlesTimelineView struct {
 	IsInbox               bool
 	IsUserTimeline        bool
 	IsUserLikeTimeline    bool
+	IsBookmarkTimeline    bool
+	BookmarkFolder        string
+	BookmarkFolders       []string
+	IsListTimeline        bool
+	IsListSubscribed      bool
+	List                  *model.UserList
 	IsTagTimelineFollowed bool
 	IsTagTimeline         bool
 	IsSearchTimeline      bool
+	SearchSort            string
 	IsUserWaitAccept      bool
 	ShowNewPost           bool
 	MediaOnly             bool
@@ -197,6 +204,13 @@ func Timeline(g *gin.Context) {
 		}
 	} else {
 		pl.ShowNewPost = true
+		if common.Cfg.HomeFeed {
+			a, next := dal.WalkHome(pl.User, pl.MediaOnly, int(common.Cfg.PostsPerPage), "")
+			fromMultiple(g, &pl.Articles, a, 0, pl.You)
+			pl.Next = next
+			g.HTML(200, "timeline.html", pl)
+			return
+		}
 		list, next := dal.GetFollowingList(ik.NewID(ik.IDFollowing, pl.User.ID), "", 1e6, false)
 		for _, id := range list {
 			if id.Followed {
@@ -272,8 +286,9 @@ func APITimeline(g *gin.Context) {
 		}
 
 		start, _ := strconv.Atoi(g.PostForm("cursors"))
-		a, next := searchArticles(you, g.PostForm("searchtag"), start, nil)
+		a, next := searchArticles(you, g.PostForm("searchtag"), g.PostForm("sort"), start, nil)
 		fromMultiple(g, &articles, a, 0, getUser(g))
+		setSearchSnippets(articles, g.PostForm("searchtag"))
 		p.Next = next
 	} else if g.PostForm("likes") == "true" {
 		c := g.PostForm("cursors")
@@ -291,10 +306,33 @@ func APITimeline(g *gin.Context) {
 		a, next := dal.WalkLikes(g.PostForm("media") == "true", int(common.Cfg.PostsPerPage), c)
 		fromMultiple(g, &articles, a, 0, getUser(g))
 		p.Next = next
+	} else if g.PostForm("bookmarks") == "true" {
+		you := getUser(g)
+		if you == nil {
+			g.Status(403)
+			return
+		}
+		c := g.PostForm("cursors")
+		if c == "" {
+			g.Status(400)
+			return
+		}
+		a, next := dal.WalkBookmarks(you.ID, g.PostForm("folder"), g.PostForm("media") == "true", int(common.Cfg.PostsPerPage), c)
+		fromMultiple(g, &articles, a, 0, you)
+		p.Next = next
 	} else if g.PostForm("reply") == "true" {
 		a, next := dal.WalkReply(int(common.Cfg.PostsPerPage), g.PostForm("cursors"))
 		fromMultiple(g, &articles, a, aReply, getUser(g))
 		p.Next = next
+	} else if c := g.PostForm("cursors"); dal.IsHomeFeedCursor(c) {
+		you := getUser(g)
+		if you == nil {
+			g.Status(403)
+			return
+		}
+		a, next := dal.WalkHome(you, g.PostForm("media") == "true", int(common.Cfg.PostsPerPage), c)
+		fromMultiple(g, &articles, a, 0, you)
+		p.Next = next
 	} else {
 		getter := func(key string) string {
 			v := g.PostForm(key)
@@ -397,6 +435,7 @@ func Search(g *gin.Context) {
 		User:             getUser(g),
 		Tag:              g.Param("query"),
 		IsSearchTimeline: true,
+		SearchSort:       g.Query("sort"),
 	}
 
 	if pl.You == nil {
@@ -409,41 +448,86 @@ func Search(g *gin.Context) {
 		return
 	}
 
-	as, next := searchArticles(pl.You, pl.Tag, 0, &pl.PostsUnderTag)
+	as, next := searchArticles(pl.You, pl.Tag, pl.SearchSort, 0, &pl.PostsUnderTag)
 	pl.Next = next
 	fromMultiple(g, &pl.Articles, as, 0, pl.You)
+	setSearchSnippets(pl.Articles, pl.Tag)
 	g.HTML(200, "timeline.html", pl)
 }
 
-func searchArticles(u *model.User, query string, start int, totalCount *int) ([]*model.Article, string) {
-	// timeout := time.Millisecond * 500
-	// if u.IsMod() {
-	// 	timeout = time.Second * 5
-	// }
+// searchArticles sorts results by relevance, or by time if 'sort' is "latest"
+func searchArticles(u *model.User, query, sort string, start int, totalCount *int) ([]*model.Article, string) {
+	q := model.ParseSearchQuery(query)
+	if q.IsEmpty() {
+		return nil, ""
+	}
 
-	res, count := model.Search("art", query, start, common.Cfg.PostsPerPage+1)
+	res := dal.SearchArticles(q.Terms(), q.Required(), sort == "latest")
 	if totalCount != nil {
-		*totalCount = (count)
+		*totalCount = len(res)
 	}
 
+	// Filters are evaluated against articles, scan at most 'maxScan' of them in one request
+	maxScan := common.Cfg.PostsPerPage * 4
 	as := []*model.Article{}
-	for _, id := range res {
-		if a, _ := dal.GetArticle(id.String()); a != nil {
-			if ik.ParseID(a.ID).Header() == ik.IDGeneral && !a.IsDeleted() {
-				as = append(as, a)
+	authors := map[string]*model.User{}
+
+	i := start
+	for ; i < len(res) && i-start < maxScan && len(as) < common.Cfg.PostsPerPage; i++ {
+		if t := ik.ParseID(res[i]).Time(); (!q.Before.IsZero() && !t.Before(q.Before)) || (!q.After.IsZero() && t.Before(q.After)) {
+			continue
+		}
+
+		a, _ := dal.GetArticle(res[i])
+		if a == nil || ik.ParseID(a.ID).Header() != ik.IDGeneral || a.IsDeleted() || a.PostOptions&model.PostOptionNoSearch != 0 {
+			continue
+		}
+
+		if _, ok := authors[a.Author]; !ok {
+			authors[a.Author], _ = dal.WeakGetUser(a.Author)
+		}
+		if author := authors[a.Author]; author == nil || !canSearchAuthor(u, author) {
+			continue
+		}
+
+		var parentAuthor string
+		if q.To != "" && a.Parent != "" {
+			if p, _ := dal.WeakGetArticle(a.Parent); p != nil && !p.Anonymous {
+				parentAuthor = p.Author
 			}
 		}
+		if q.Match(a, parentAuthor) {
+			as = append(as, a)
+		}
 	}
 
 	var next string
-	if len(res) >= common.Cfg.PostsPerPage+1 {
-		if len(as) >= common.Cfg.PostsPerPage+1 {
-			as = as[:common.Cfg.PostsPerPage]
+	if i < len(res) {
+		next = strconv.Itoa(i)
+	}
+	return as, next
+}
+
+func setSearchSnippets(views []ArticleView, query string) {
+	q := model.ParseSearchQuery(query)
+	for i := range views {
+		views[i].Snippet = q.Snippet(views[i].Content, 120)
+		for _, o := range views[i].Others {
+			o.Snippet = q.Snippet(o.Content, 120)
 		}
-		next = strconv.Itoa(start + common.Cfg.PostsPerPage)
 	}
+}
 
-	return as, next
+// canSearchAuthor checks whether posts of a follow-apply author are visible to the user
+func canSearchAuthor(u, author *model.User) bool {
+	if author.FollowApply == 0 || (u != nil && u.ID == author.ID) {
+		return true
+	}
+	if u == nil {
+		return false
+	}
+	following, accepted := dal.IsFollowingWithAcceptance(u.ID, author)
+	return following && accepted
 }
 
 func LocalImage(g *gin.Context) {
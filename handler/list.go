@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/middleware"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+func Lists(g *gin.Context) {
+	p := struct {
+		You        *model.User
+		User       *model.User
+		Lists      []*model.UserList
+		Subscribed []*model.UserList
+	}{
+		You: getUser(g),
+	}
+
+	if uid := g.Param("uid"); uid != "" {
+		p.User, _ = dal.GetUser(uid)
+		if p.User == nil {
+			NotFound(g)
+			return
+		}
+		if !checkFollowApply(g, p.User, p.You) {
+			return
+		}
+		if p.You != nil {
+			p.User.Buildup(p.You)
+		}
+	} else if p.You == nil {
+		redirectVisitor(g)
+		return
+	} else {
+		p.User = p.You
+	}
+
+	own := p.You != nil && p.You.ID == p.User.ID
+	p.Lists = dal.GetLists(p.User.ID, own)
+	if own {
+		p.Subscribed = dal.GetSubscribedLists(p.You.ID)
+	}
+	g.HTML(200, "lists.html", p)
+}
+
+func ListTimeline(g *gin.Context) {
+	pl := ArticlesTimelineView{
+		IsListTimeline: true,
+		You:            getUser(g),
+		MediaOnly:      g.Query("media") != "",
+		IsCrawler:      common.IsCrawler(g),
+	}
+
+	pl.List, _ = dal.GetList(g.Param("uid"), g.Param("lid"))
+	if pl.List == nil || (!pl.List.Public && (pl.You == nil || pl.You.ID != pl.List.Owner)) {
+		NotFound(g)
+		return
+	}
+
+	pl.User, _ = dal.GetUser(pl.List.Owner)
+	if pl.User == nil {
+		NotFound(g)
+		return
+	}
+	if pl.You != nil {
+		pl.IsListSubscribed = dal.IsSubscribingList(pl.You.ID, pl.List.Owner, pl.List.ID)
+	}
+
+	a, next := dal.WalkMulti(pl.MediaOnly, int(common.Cfg.PostsPerPage), dal.ListCursors(pl.List, pl.You)...)
+	fromMultiple(g, &pl.Articles, a, 0, pl.You)
+	pl.Next = ik.CombineIDs(nil, next...)
+
+	if g.Query("rss") == "1" {
+		writeRSS(g, pl)
+	} else {
+		g.HTML(200, "timeline.html", pl)
+	}
+}
+
+func APIList(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	lid, to := g.PostForm("list"), g.PostForm("to")
+
+	switch g.PostForm("method") {
+	case "create":
+		lid, err := dal.CreateList(u.ID, g.PostForm("name"), g.PostForm("desc"), g.PostForm("public") != "")
+		throw(err, "")
+		okok(g, lid)
+		return
+	case "update":
+		throw(dal.UpdateList(u.ID, lid, g.PostForm("name"), g.PostForm("desc"), g.PostForm("public") != ""), "")
+	case "delete":
+		throw(dal.DeleteList(u.ID, lid), "")
+	case "add":
+		throw(to == "", "")
+		throw(dal.AddListMember(u.ID, lid, to), "")
+	case "remove":
+		throw(to == "", "")
+		throw(dal.RemoveListMember(u.ID, lid, to), "")
+	case "subscribe":
+		throw(dal.SubscribeList(u.ID, g.PostForm("owner"), lid, g.PostForm("subscribe") != ""), "")
+	case "menu":
+		// Lists of yours with checkboxes to add/remove 'to', shown in the user info box
+		throw(to == "", "")
+		type item struct {
+			*model.UserList
+			Member bool
+		}
+		p := struct {
+			To    string
+			Lists []item
+		}{To: to}
+		for _, l := range dal.GetLists(u.ID, true) {
+			p.Lists = append(p.Lists, item{l, dal.IsListMember(u.ID, l.ID, to)})
+		}
+		okok(g, middleware.RenderTemplateString("list_menu.html", p))
+		return
+	default:
+		throw(true, "")
+	}
+	okok(g)
+}
@@ -1,7 +1,12 @@
 package handler
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"log"
+	"strconv"
+	"time"
 
 	"github.com/coyove/iis/common"
 	"github.com/coyove/iis/dal"
@@ -16,6 +21,8 @@ func ModUser(g *gin.Context) {
 		You  *model.User
 		Keys map[string]string
 		Raw  string
+
+		RateLimits []common.RateLimitPolicy
 	}
 
 	p.You = getUser(g)
@@ -31,7 +38,9 @@ func ModUser(g *gin.Context) {
 	}
 
 	if g.Query("swap") == "1" && p.You.IsAdmin() {
-		g.SetCookie("id", ik.MakeUserToken(p.User.ID, p.User.Session), 86400, "", "", false, false)
+		session, err := dal.NewSession(p.User.ID, g)
+		throw(err, "")
+		g.SetCookie("id", ik.MakeUserToken(p.User.ID, session), 86400, "", "", false, false)
 	}
 
 	getter := func(h ik.IDHeader) string {
@@ -48,21 +57,25 @@ func ModUser(g *gin.Context) {
 		"Follow":   getter(ik.IDFollowing),
 		"Block":    getter(ik.IDBlacklist),
 		"Like":     getter(ik.IDLike),
+		"Bookmark": getter(ik.IDBookmark),
 		"Timeline": getter(ik.IDAuthor),
 		"Inbox":    getter(ik.IDInbox),
 	}
 
 	p.Raw = fmt.Sprintf("%+v", p.User)
+	p.RateLimits = common.Cfg.RateLimits
 	g.HTML(200, "mod_user.html", p)
 }
 
 func ModKV(g *gin.Context) {
 	p := struct {
-		You *model.User
-		Key string
+		You  *model.User
+		Key  string
+		Keys []*common.Key
 	}{
-		You: getUser(g),
-		Key: g.Query("key"),
+		You:  getUser(g),
+		Key:  g.Query("key"),
+		Keys: common.Keyring(),
 	}
 
 	if p.You == nil || !p.You.IsAdmin() {
@@ -86,7 +99,7 @@ func APIPromoteMod(g *gin.Context) {
 	throw(u, "")
 	throw(!u.IsAdmin(), "")
 	throw(common.Err2(dal.DoUpdateUser(g.PostForm("to"), func(u *model.User) error {
-		if u.IsAdmin() {
+		if u.Role == "admin" || u.ID == common.Cfg.AdminName {
 			return fmt.Errorf("e:already_admin")
 		}
 		if u.Role == "mod" {
@@ -99,6 +112,55 @@ func APIPromoteMod(g *gin.Context) {
 	okok(g)
 }
 
+// APIRateLimitOverride sets the limit of a rate limit policy for the user,
+// an empty limit restores the default one and a negative limit means unlimited
+func APIRateLimitOverride(g *gin.Context) {
+	u := dal.GetUserByContext(g)
+	throw(u, "")
+	throw(!u.IsAdmin(), "")
+
+	policy := g.PostForm("policy")
+	found := false
+	for _, p := range common.Cfg.RateLimits {
+		found = found || p.Name == policy
+	}
+	throw(!found, "invalid_policy")
+
+	limit, err := strconv.Atoi(g.PostForm("limit"))
+	clear := g.PostForm("limit") == ""
+	throw(err != nil && !clear, "invalid_limit")
+
+	throw(common.Err2(dal.DoUpdateUser(g.PostForm("to"), func(u *model.User) error {
+		if clear {
+			delete(u.RateLimits, policy)
+			return nil
+		}
+		if u.RateLimits == nil {
+			u.RateLimits = map[string]int{}
+		}
+		u.RateLimits[policy] = limit
+		return nil
+	})), "")
+	okok(g)
+}
+
+func APIRebuildSearchIndex(g *gin.Context) {
+	u := dal.GetUserByContext(g)
+	throw(u, "")
+	throw(!u.IsAdmin(), "")
+
+	n, _ := strconv.Atoi(g.PostForm("n"))
+	if n <= 0 {
+		n = 10000
+	}
+	go func() {
+		start := time.Now()
+		log.Println("[RebuildSearchIndex] started by", u.ID)
+		log.Println("[RebuildSearchIndex]", dal.RebuildSearchIndex(n), "articles indexed in", time.Since(start))
+	}()
+	okok(g)
+}
+
 func APIModKV(g *gin.Context) {
 	u := dal.GetUserByContext(g)
 	throw(u, "")
@@ -113,3 +175,20 @@ func APIModKV(g *gin.Context) {
 		okok(g, string(v))
 	}
 }
+
+func APIPromoteKey(g *gin.Context) {
+	u := dal.GetUserByContext(g)
+	throw(u, "")
+	throw(!u.IsAdmin(), "")
+
+	key := g.PostForm("key")
+	if key == "" {
+		p := [16]byte{}
+		rand.Read(p[:])
+		key = hex.EncodeToString(p[:])
+	}
+
+	k, err := dal.PromoteKey(key)
+	throw(err, "invalid_key")
+	okok(g, fmt.Sprintf("%02x", k.ID))
+}
@@ -17,6 +17,7 @@ import (
 	"net"
 	"net/url"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -34,19 +35,11 @@ import (
 )
 
 func hashIP(g *gin.Context) string {
-	ip := append(net.IP{}, g.MustGet("ip").(net.IP)...)
-	if len(ip) == net.IPv4len {
-		ip[3] = 0 // \24
-	} else if len(ip) == net.IPv6len {
-		ip4 := ip.To4()
-		if ip4 != nil {
-			ip = ip4
-			ip[3] = 0
-		} else {
-			copy(ip[10:], "\x00\x00\x00\x00\x00\x00") // \80
-		}
-	}
-	return ip.String() + "/" + strconv.FormatInt(time.Now().Unix(), 36)
+	return common.MaskIP(g.MustGet("ip").(net.IP)) + "/" + strconv.FormatInt(time.Now().Unix(), 36)
+}
+
+func clientIP(g *gin.Context) string {
+	return g.MustGet("ip").(net.IP).String()
 }
 
 func PostBox(g *gin.Context) {
@@ -59,7 +52,6 @@ func PostBox(g *gin.Context) {
 
 func APINew(g *gin.Context) {
 	throw(checkIP(g), "")
-	g.Set("allow-api", true)
 
 	var (
 		replyTo      = g.PostForm("parent")
@@ -67,13 +59,22 @@ func APINew(g *gin.Context) {
 		image        = common.DetectMedia(g.PostForm("media"))
 		replyLock, _ = strconv.Atoi(g.PostForm("reply_lock"))
 		pastebin     = false
+		parts        []string
 	)
 
+	if g.PostForm("thread") == "1" {
+		// The first part is the article itself, the rest will be chained self-replies
+		parts = splitThread(g.PostForm("content"))
+		throw(len(parts) > common.Cfg.MaxThreadParts, "too_many_thread_parts")
+		content, parts = parts[0], parts[1:]
+	}
+
 	u := dal.GetUserByContext(g)
 	if u == nil {
 		throw(g.PostForm("api2_uid") != "", "user_not_found")
 		throw(replyTo != "", "cannot_reply")
 		u = &model.User{ID: "pastebin" + strconv.Itoa(rand.Intn(10))}
+		throw(parts != nil, "thread_not_supported")
 		image, replyLock, pastebin = "", 0, true
 
 		if content == "" {
@@ -133,7 +134,22 @@ func APINew(g *gin.Context) {
 			a.PostOptions |= model.PostOptionNoMasterTimeline
 		}
 
-		a2, err := dal.Post(a, u)
+		if q := g.PostForm("quote"); q != "" {
+			throw(dal.CheckQuote(u, q), "")
+			a.Extras["quote"] = q
+		}
+
+		if s := g.PostForm("schedule"); s != "" {
+			throw(len(parts) > 0, "thread_not_supported")
+			t, _ := strconv.ParseInt(s, 10, 64)
+			sid, err := dal.SchedulePost(a, u, time.Unix(t, 0))
+			throw(err, "")
+			deleteDraft(g, u)
+			okok(g, "scheduled:", sid)
+			return
+		}
+
+		a2, err := dal.PostThread("", a, parts, u)
 		throw(err, "")
 		av.from(a2, aTimeline, u)
 	} else {
@@ -157,13 +173,39 @@ func APINew(g *gin.Context) {
 			})), "")
 		}
 
-		a2, err := dal.PostReply(replyTo, a, u)
+		a2, err := dal.PostThread(replyTo, a, parts, u)
 		throw(err, "cannot_reply")
 		av.from(a2, aReply, u)
 	}
+	deleteDraft(g, u)
 	okok(g, url.PathEscape(middleware.RenderTemplateString("row_content.html", av)))
 }
 
+var rxThreadSeparator = regexp.MustCompile(`(?m)^[ \t]*-{3,}[ \t]*\r?$`)
+
+// splitThread splits the composer's content by '---' lines, each part is truncated separately
+func splitThread(content string) []string {
+	parts := []string{}
+	for _, p := range rxThreadSeparator.Split(content, -1) {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, common.SoftTrunc(p, int(common.Cfg.MaxContent)))
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "")
+	}
+	return parts
+}
+
+// deleteDraft removes the draft which has just been published
+func deleteDraft(g *gin.Context, u *model.User) {
+	if id := g.PostForm("draft"); id != "" {
+		if err := dal.DeleteDraft(u.ID, id); err != nil {
+			log.Println("[APINew] delete draft:", u.ID, id, err)
+		}
+	}
+}
+
 func APIDeleteArticle(g *gin.Context) {
 	u := throw(dal.GetUserByContext(g), "").(*model.User)
 	throw(checkIP(g), "")
@@ -177,6 +219,7 @@ func APIDeleteArticle(g *gin.Context) {
 		if a.Parent != "" {
 			go dal.DoUpdateArticle(a.Parent, func(a *model.Article) { a.Replies-- })
 		}
+		go dal.UnindexArticle(a.ID)
 		return nil
 	})), "")
 	okok(g)
@@ -185,14 +228,16 @@ func APIDeleteArticle(g *gin.Context) {
 func APIToggleNSFWArticle(g *gin.Context) {
 	u := throw(dal.GetUserByContext(g), "").(*model.User)
 	throw(checkIP(g), "")
-	throw(common.Err2(dal.DoUpdateArticle(g.PostForm("id"), func(a *model.Article) error {
+	a, err := dal.DoUpdateArticle(g.PostForm("id"), func(a *model.Article) error {
 		if u.ID != a.Author && !u.IsMod() {
 			return fmt.Errorf("e:user_not_permitted")
 		}
 		a.NSFW = !a.NSFW
 		a.History += fmt.Sprintf("{nsfw_by:%s:%v}", u.ID, time.Now().Unix())
 		return nil
-	})), "")
+	})
+	throw(err, "")
+	go dal.IndexArticle(a)
 	okok(g)
 }
 
@@ -304,7 +349,8 @@ func APIUpload(g *gin.Context) {
 	}
 
 	throw(u, "INVALID_USER")
-	throw(!ik.BAdd(u.ID), IR)
+	throw(!u.HasScope(model.ScopeUpload), "INVALID_USER")
+	dal.TouchAPIToken(u, g)
 
 	d, params, err := mime.ParseMediaType(g.GetHeader("Content-Type"))
 	throw(err, IR)
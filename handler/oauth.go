@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+const oauthCodeTTL = time.Minute
+
+type oauthRequest struct {
+	ClientID      string `json:"c"`
+	RedirectURI   string `json:"r"`
+	Scope         string `json:"s"`
+	State         string `json:"-"`
+	CodeChallenge string `json:"p"`
+	UID           string `json:"u,omitempty"`
+}
+
+func oauthConsentID(uid, clientID string) string { return "oauth-consent/" + uid + "/" + clientID }
+
+// parseOAuthRequest validates the authorization request, if the redirect URI is valid,
+// later errors should be sent back to the client using it.
+func parseOAuthRequest(get func(string) string) (r oauthRequest, c *model.OAuthClient, errCode string) {
+	r = oauthRequest{
+		ClientID:      get("client_id"),
+		RedirectURI:   get("redirect_uri"),
+		Scope:         model.ParseAPIScope(get("scope")).String(),
+		State:         get("state"),
+		CodeChallenge: get("code_challenge"),
+	}
+
+	c, _ = dal.GetOAuthClient(r.ClientID)
+	if c == nil {
+		return r, nil, "invalid_client"
+	}
+	if r.RedirectURI == "" && len(c.RedirectURIs) == 1 {
+		r.RedirectURI = c.RedirectURIs[0]
+	}
+	if !c.ValidRedirectURI(r.RedirectURI) {
+		return r, nil, "invalid_redirect_uri"
+	}
+
+	switch {
+	case get("response_type") != "code":
+		return r, c, "unsupported_response_type"
+	case r.CodeChallenge == "" || get("code_challenge_method") != "S256":
+		return r, c, "invalid_request" // PKCE is always required
+	case r.Scope == "":
+		return r, c, "invalid_scope"
+	}
+	return r, c, ""
+}
+
+func oauthRedirect(g *gin.Context, r oauthRequest, q url.Values) {
+	if r.State != "" {
+		q.Set("state", r.State)
+	}
+	sep := "?"
+	if strings.Contains(r.RedirectURI, "?") {
+		sep = "&"
+	}
+	g.Redirect(302, r.RedirectURI+sep+q.Encode())
+}
+
+func OAuthAuthorize(g *gin.Context) {
+	you := getUser(g)
+	if you == nil {
+		redirectVisitor(g)
+		return
+	}
+
+	r, c, errCode := parseOAuthRequest(g.Query)
+	if c == nil {
+		g.Set("error", errCode)
+		NotFound(g)
+		return
+	}
+	if errCode != "" {
+		oauthRedirect(g, r, url.Values{"error": {errCode}})
+		return
+	}
+
+	g.HTML(200, "oauth_consent.html", struct {
+		You     *model.User
+		Client  *model.OAuthClient
+		Request oauthRequest
+		Scopes  []string
+		Token   string
+	}{
+		You:     you,
+		Client:  c,
+		Request: r,
+		Scopes:  strings.Split(r.Scope, ","),
+		Token:   ik.MakeOTT(oauthConsentID(you.ID, c.ID)),
+	})
+}
+
+func APIOAuthAuthorize(g *gin.Context) {
+	you := getUser(g)
+	if you == nil {
+		redirectVisitor(g)
+		return
+	}
+
+	// Parameters are echoed back by the consent page
+	r, c, errCode := parseOAuthRequest(g.PostForm)
+	if c == nil {
+		g.Set("error", errCode)
+		NotFound(g)
+		return
+	}
+	if errCode != "" {
+		oauthRedirect(g, r, url.Values{"error": {errCode}})
+		return
+	}
+	if !ik.ValidateOTT(oauthConsentID(you.ID, c.ID), g.PostForm("token")) {
+		g.Set("error", "expired_session")
+		NotFound(g)
+		return
+	}
+	if g.PostForm("approve") == "" {
+		oauthRedirect(g, r, url.Values{"error": {"access_denied"}})
+		return
+	}
+
+	r.UID = you.ID
+	buf, _ := json.Marshal(r)
+	oauthRedirect(g, r, url.Values{"code": {ik.Seal(buf, oauthCodeTTL)}})
+}
+
+func oauthError(g *gin.Context, code string) {
+	g.JSON(400, map[string]string{"error": code})
+}
+
+func APIOAuthToken(g *gin.Context) {
+	g.Writer.Header().Add("Cache-Control", "no-store")
+
+	clientID, secret, ok := g.Request.BasicAuth()
+	if !ok {
+		clientID, secret = g.PostForm("client_id"), g.PostForm("client_secret")
+	}
+	c, _ := dal.GetOAuthClient(clientID)
+	if c == nil || !dal.CheckOAuthClientSecret(c, secret) {
+		g.JSON(401, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	var access, refresh string
+	var scope model.APIScope
+
+	switch g.PostForm("grant_type") {
+	case "authorization_code":
+		code := g.PostForm("code")
+		buf, ok := ik.Unseal(code)
+		if !ok {
+			oauthError(g, "invalid_grant")
+			return
+		}
+		if !dal.UseOAuthCode(code, oauthCodeTTL) {
+			oauthError(g, "invalid_grant")
+			return
+		}
+
+		var r oauthRequest
+		json.Unmarshal(buf, &r)
+		h := sha256.Sum256([]byte(g.PostForm("code_verifier")))
+		if r.ClientID != c.ID || r.RedirectURI != g.PostForm("redirect_uri") ||
+			base64.RawURLEncoding.EncodeToString(h[:]) != r.CodeChallenge {
+			oauthError(g, "invalid_grant")
+			return
+		}
+
+		var err error
+		scope = model.ParseAPIScope(r.Scope)
+		access, refresh, err = dal.CreateOAuthGrant(r.UID, c.ID, scope)
+		if err != nil {
+			log.Println("[OAuth] create grant:", r.UID, c.ID, err)
+			g.JSON(500, map[string]string{"error": "server_error"})
+			return
+		}
+	case "refresh_token":
+		var gr *model.OAuthGrant
+		var err error
+		access, refresh, gr, err = dal.RefreshOAuthGrant(c.ID, g.PostForm("refresh_token"))
+		if err != nil {
+			oauthError(g, "invalid_grant")
+			return
+		}
+		scope = gr.Scope
+	default:
+		oauthError(g, "unsupported_grant_type")
+		return
+	}
+
+	g.JSON(200, map[string]interface{}{
+		"access_token":  access,
+		"token_type":    "Bearer",
+		"expires_in":    common.Cfg.OAuthAccessTTL,
+		"refresh_token": refresh,
+		"scope":         strings.Replace(scope.String(), ",", " ", -1),
+	})
+}
+
+func APIOAuthClient(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+
+	if id := g.PostForm("delete"); id != "" {
+		throw(dal.DeleteOAuthClient(u.ID, id), "")
+		okok(g)
+		return
+	}
+
+	var uris []string
+	for _, uri := range strings.Split(g.PostForm("redirect_uris"), "\n") {
+		if uri = strings.TrimSpace(uri); uri == "" {
+			continue
+		}
+		x, err := url.Parse(uri)
+		throw(err != nil || x.Scheme == "" || x.Fragment != "", "invalid_redirect_uri")
+		uris = append(uris, uri)
+	}
+	throw(len(uris) == 0, "invalid_redirect_uri")
+
+	name := common.SoftTruncDisplayWidth(g.PostForm("name"), 32)
+	throw(name == "", "")
+
+	c, secret, err := dal.CreateOAuthClient(u.ID, name, uris, g.PostForm("public") != "")
+	throw(err, "")
+	okok(g, c.ID, " ", secret)
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+func ScheduledPosts(g *gin.Context) {
+	p := struct {
+		You   *model.User
+		Posts []dal.ScheduledPost
+	}{
+		You: getUser(g),
+	}
+
+	if p.You == nil {
+		redirectVisitor(g)
+		return
+	}
+
+	p.Posts = dal.GetScheduledPosts(p.You.ID)
+	g.HTML(200, "scheduled.html", p)
+}
+
+func APIScheduledPost(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	sid := g.PostForm("id")
+	throw(sid == "", "")
+
+	switch g.PostForm("method") {
+	case "edit":
+		content := common.SoftTrunc(g.PostForm("content"), int(common.Cfg.MaxContent))
+		var at time.Time
+		if s := g.PostForm("schedule"); s != "" {
+			t, _ := strconv.ParseInt(s, 10, 64)
+			at = time.Unix(t, 0)
+		}
+		throw(dal.UpdateScheduledPost(u.ID, sid, content, at), "")
+	case "cancel":
+		throw(dal.CancelScheduledPost(u.ID, sid), "")
+	default:
+		throw(true, "")
+	}
+	okok(g)
+}
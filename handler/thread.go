@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+func Thread(g *gin.Context) {
+	p := struct {
+		You      *model.User
+		Root     ArticleView
+		Articles []ArticleView
+	}{
+		You: getUser(g),
+	}
+
+	a, err := dal.WalkThread("S"+g.Param("id"), common.Cfg.MaxThreadParts*5)
+	if err != nil || a[0].IsDeleted() || a[0].Anonymous || a[0].Cmd != model.CmdNone || a[0].ReferID != "" {
+		NotFound(g)
+		return
+	}
+
+	author, _ := dal.WeakGetUser(a[0].Author)
+	if author == nil || (p.You != nil && dal.IsBlocking(author.ID, p.You.ID)) {
+		NotFound(g)
+		return
+	}
+	if !checkFollowApply(g, author, p.You) {
+		return
+	}
+
+	p.Articles = make([]ArticleView, len(a))
+	for i, a := range a {
+		p.Articles[i].from(a, aReplyParent, p.You)
+	}
+	p.Root = p.Articles[0]
+	g.HTML(200, "thread.html", p)
+}
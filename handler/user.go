@@ -46,6 +46,7 @@ func UserList(g *gin.Context) {
 		ListType string
 		You      *model.User
 		User     *model.User
+		UserList *model.UserList
 		API      bool
 	}{
 		API:      g.Request.Method == "POST",
@@ -91,6 +92,13 @@ func UserList(g *gin.Context) {
 			return
 		}
 		p.List, p.Next = dal.GetCommonFollowingList(p.You.ID, p.User.ID, next, int(common.Cfg.PostsPerPage))
+	case "list":
+		p.UserList, _ = dal.GetList(p.User.ID, g.Query("list"))
+		if p.UserList == nil || (!p.UserList.Public && p.User.ID != p.You.ID) {
+			NotFound(g)
+			return
+		}
+		p.List, p.Next = dal.GetListMemberStates(p.User.ID, p.UserList.ID, next, int(common.Cfg.PostsPerPage))
 	default:
 		p.List, p.Next = dal.GetFollowingList(ik.NewID(ik.IDFollowing, p.User.ID), next, int(common.Cfg.PostsPerPage), true)
 	}
@@ -167,6 +175,28 @@ func UserLikes(g *gin.Context) {
 	g.HTML(200, "timeline.html", p)
 }
 
+func Bookmarks(g *gin.Context) {
+	p := ArticlesTimelineView{
+		IsBookmarkTimeline: true,
+		MediaOnly:          g.Query("media") != "",
+		BookmarkFolder:     g.Query("folder"),
+		You:                getUser(g),
+	}
+
+	if p.You == nil {
+		redirectVisitor(g)
+		return
+	}
+	p.User = p.You
+	p.BookmarkFolders = dal.GetBookmarkFolders(p.You.ID)
+
+	a, next := dal.WalkBookmarks(p.You.ID, p.BookmarkFolder, p.MediaOnly, int(common.Cfg.PostsPerPage), "")
+	fromMultiple(g, &p.Articles, a, 0, p.You)
+	p.Next = next
+
+	g.HTML(200, "timeline.html", p)
+}
+
 func APIGetUserInfoBox(g *gin.Context) {
 	you := getUser(g)
 	id := g.Param("id")
@@ -195,3 +225,13 @@ func UserSecurity(g *gin.Context) {
 	}
 	g.HTML(200, g.Request.URL.Path[1:]+".html", getUser(g))
 }
+
+func ResetPassword(g *gin.Context) {
+	g.HTML(200, "reset_password.html", struct {
+		UID   string
+		Token string
+	}{
+		UID:   g.Query("uid"),
+		Token: g.Query("token"),
+	})
+}
@@ -1,12 +1,19 @@
 package handler
 
 import (
-	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/common/mail"
+	"github.com/coyove/iis/common/passwd"
 	"github.com/coyove/iis/dal"
 	"github.com/coyove/iis/ik"
 	"github.com/coyove/iis/model"
@@ -31,8 +38,14 @@ func APISignup(g *gin.Context) {
 		throw(admin != nil, "duplicated_id")
 	}
 
-	session := genSession()
-	throw(dal.DoSignUp(username, hashPassword(password), email, session, hashIP(g)), "")
+	throw(dal.DoSignUp(username, passwd.Hash(password), email, "", hashIP(g)), "")
+	if strings.Contains(email, "@") {
+		if err := sendEmailVerification(username, email); err != nil {
+			log.Println("[Signup] verify email:", username, err)
+		}
+	}
+	session, err := dal.NewSession(username, g)
+	throw(err, "")
 
 	tok := ik.MakeUserToken(username, session)
 	g.SetCookie("id", tok, 365*86400, "", "", false, false)
@@ -42,19 +55,64 @@ func APISignup(g *gin.Context) {
 func APILogin(g *gin.Context) {
 	throw(checkIP(g), "")
 
-	u, _ := dal.GetUser(sanUsername(g.PostForm("username")))
-	throw(u, "invalid_id_password")
-	throw(!bytes.Equal(u.PasswordHash, hashPassword(g.PostForm("password"))), "invalid_id_password")
+	uid := sanUsername(g.PostForm("username"))
+	checkLoginGuard(g, uid)
+
+	u, _ := dal.GetUser(uid)
+	if u == nil {
+		dal.LoginFailed(uid, clientIP(g), false)
+		throw(true, "invalid_id_password")
+	}
+	ok, rehash := passwd.Verify(g.PostForm("password"), u.PasswordHash)
+	if !ok {
+		dal.LoginFailed(uid, clientIP(g), true)
+		throw(true, "invalid_id_password")
+	}
+	if rehash {
+		// Legacy or outdated hash, upgrade it using the current hasher
+		throw(common.Err2(dal.DoUpdateUser(u.ID, "PasswordHash", passwd.Hash(g.PostForm("password")))), "")
+	}
+
+	if u.TOTPSecret != "" {
+		// Password is correct, but the second step is still required
+		okok(g, "totp:", ik.MakeOTT(totpPendingID(u.ID, clientIP(g))))
+		return
+	}
+	doLogin(g, u)
+}
+
+// checkLoginGuard requires a captcha if the account or the IP looks like being under attack
+func checkLoginGuard(g *gin.Context, uid string) {
+	if checkLoginCooldown(g, uid) {
+		throw(g.PostForm("uuid") == "", "login_challenge")
+		throw(checkCaptcha(g), "")
+	}
+}
+
+// checkLoginCooldown rejects the login if the account or the IP is locked out,
+// it returns whether a captcha challenge is needed
+func checkLoginCooldown(g *gin.Context, uid string) bool {
+	wait, challenge := dal.CheckLogin(uid, clientIP(g))
+	if wait > 0 {
+		throw(fmt.Sprintf("cooldown`%.0fs", math.Ceil(wait.Seconds())), "")
+	}
+	return challenge
+}
+
+func doLogin(g *gin.Context, u *model.User) {
+	dal.LoginSucceeded(u.ID, clientIP(g))
 	throw(common.Err2(dal.DoUpdateUser(u.ID, func(u2 *model.User) {
 		u2.DataIP = common.PushIP(u.DataIP, hashIP(g))
 		u2.TLogin = uint32(time.Now().Unix())
 	})), "")
+	session, err := dal.NewSession(u.ID, g)
+	throw(err, "")
 
 	ttl := 0
 	if g.PostForm("remember") != "" {
 		ttl = 365 * 86400
 	}
-	g.SetCookie("id", ik.MakeUserToken(u.ID, u.Session), ttl, "", "", false, false)
+	g.SetCookie("id", ik.MakeUserToken(u.ID, session), ttl, "", "", false, false)
 	okok(g)
 }
 
@@ -97,6 +155,16 @@ func APINewCaptcha(g *gin.Context) {
 	g.JSON(200, p)
 }
 
+func APINewCaptchaAudio(g *gin.Context) {
+	buf, ok := ik.MakeAudioToken(g, g.Query("uuid"))
+	if !ok {
+		g.Status(404)
+		return
+	}
+	g.Writer.Header().Add("Cache-Control", "no-store")
+	g.Data(200, "audio/wav", buf)
+}
+
 func APILike(g *gin.Context) {
 	u := throw(dal.GetUserByContext(g), "").(*model.User)
 	to := g.PostForm("to")
@@ -107,10 +175,29 @@ func APILike(g *gin.Context) {
 	okok(g)
 }
 
+func APIRepost(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	to := g.PostForm("to")
+
+	throw(checkIP(g), "")
+	throw(to == "", "")
+	throw(dal.RepostArticle(u, to, g.PostForm("repost") != ""), "")
+	okok(g)
+}
+
+func APIBookmark(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	to := g.PostForm("to")
+
+	throw(to == "", "")
+	throw(dal.BookmarkArticle(u.ID, to, g.PostForm("folder"), g.PostForm("bookmark") != ""), "")
+	okok(g)
+}
+
 func APILogout(g *gin.Context) {
 	u := dal.GetUserByContext(g)
 	if u != nil {
-		dal.DoUpdateUser(u.ID, "Session", "")
+		dal.RevokeSession(u, dal.SessionHash(u.CurrentSession()))
 		g.SetCookie("id", ik.MakeUserToken("", ""), 365*86400, "", "", false, false)
 	}
 	okok(g)
@@ -141,6 +228,18 @@ func APIFollowBlock(g *gin.Context) {
 		throw(dal.AcceptUser(u.ID, to, true), "")
 		// Given the situation that there may be A LOT applications received by one user
 		g.Set("clear-ip-throt", true)
+	case "mute":
+		kind := model.MuteUser
+		if isTag {
+			kind = model.MuteTag
+		}
+		key, err := model.MakeMuteKey(kind, to)
+		throw(err, "")
+		if g.PostForm("mute") != "" {
+			throw(dal.MuteItem(u.ID, key, time.Time{}), "")
+		} else {
+			throw(dal.UnmuteItem(u.ID, key), "")
+		}
 	default:
 		throw(isTag, "cannot_block_tag")
 		throw(dal.BlockUser(u.ID, to, g.PostForm("block") != ""), "")
@@ -153,7 +252,29 @@ func APIUpdateUserSettings(g *gin.Context) {
 
 	switch {
 	case g.PostForm("set-email") != "":
-		throw(common.Err2(dal.DoUpdateUser(u.ID, "Email", common.SoftTrunc(g.PostForm("email"), 256))), "")
+		// The email can be used to reset the password, so changing it requires re-authentication
+		throw(checkIP(g), "")
+		email := common.SoftTrunc(g.PostForm("email"), 256)
+		throw(!strings.Contains(email, "@"), "invalid_email")
+		ok, _ := passwd.Verify(g.PostForm("password"), u.PasswordHash)
+		throw(!ok, "old_password_invalid")
+		if u.TOTPSecret != "" {
+			throw(!checkTOTP(u, g.PostForm("code")), "invalid_totp")
+		}
+		throw(checkEmailVerificationCooldown(u.ID), "")
+		throw(common.Err2(dal.DoUpdateUser(u.ID, "PendingEmail", email)), "")
+		throw(sendEmailVerification(u.ID, email), "")
+		if old := u.VerifiedEmail(); old != "" && !strings.EqualFold(old, email) {
+			ip := hashIP(g)
+			go func() {
+				if err := mail.SendEmailChanging(old, u.ID, email, ip); err != nil {
+					log.Println("[SetEmail] notify:", u.ID, err)
+				}
+			}()
+		}
+	case g.PostForm("set-resend-email") != "":
+		throw(u.PendingEmail == "", "")
+		throw(sendEmailVerification(u.ID, u.PendingEmail), "")
 	case g.PostForm("set-autonsfw") != "":
 		throw(common.Err2(dal.DoUpdateUser(u.ID, "ExpandNSFWImages", common.BoolInt(g.PostForm("autonsfw") != ""))), "")
 	case g.PostForm("set-foldimg") != "":
@@ -166,11 +287,45 @@ func APIUpdateUserSettings(g *gin.Context) {
 		throw(common.Err2(dal.DoUpdateUser(u.ID, "NotifyFollowerActOnly", common.BoolInt(g.PostForm("mfcm") != ""))), "")
 	case g.PostForm("set-description") != "":
 		throw(common.Err2(dal.DoUpdateUser(u.ID, "Description", common.SoftTrunc(g.PostForm("description"), 512))), "")
-	case g.PostForm("set-apisession") != "":
-		apiToken := ik.MakeUserToken(u.ID, "api+"+genSession())
-		throw(common.Err2(dal.DoUpdateUser(u.ID, "APIToken", apiToken)), "")
-		okok(g, apiToken)
+	case g.PostForm("set-new-apitoken") != "":
+		scope := model.ParseAPIScope(g.PostForm("new-apitoken"))
+		throw(scope == 0, "invalid_scope")
+		var expire time.Time
+		if days, _ := strconv.Atoi(g.PostForm("expire")); days > 0 {
+			expire = time.Now().AddDate(0, 0, days)
+		}
+		tok, err := dal.CreateAPIToken(u.ID, common.SoftTrunc(g.PostForm("label"), 32), scope, expire)
+		throw(err, "")
+		okok(g, tok)
 		return
+	case g.PostForm("set-revoke-apitoken") != "":
+		throw(dal.RevokeAPIToken(u.ID, g.PostForm("revoke-apitoken")), "")
+	case g.PostForm("set-save-search") != "":
+		throw(common.Err2(dal.SaveSearch(u.ID, g.PostForm("save-search"))), "")
+	case g.PostForm("set-delete-search") != "":
+		throw(dal.DeleteSavedSearch(u.ID, g.PostForm("delete-search")), "")
+	case g.PostForm("set-mute-search") != "":
+		throw(dal.MuteSavedSearch(u.ID, g.PostForm("mute-search"), true), "")
+	case g.PostForm("set-unmute-search") != "":
+		throw(dal.MuteSavedSearch(u.ID, g.PostForm("unmute-search"), false), "")
+	case g.PostForm("set-delete-bookmark-folder") != "":
+		throw(dal.DeleteBookmarkFolder(u.ID, g.PostForm("delete-bookmark-folder")), "")
+	case g.PostForm("set-mute") != "":
+		key, err := model.MakeMuteKey(g.PostForm("mute-kind"), g.PostForm("mute"))
+		throw(err, "")
+		var expire time.Time
+		if days, _ := strconv.Atoi(g.PostForm("mute-days")); days > 0 {
+			expire = time.Now().AddDate(0, 0, days)
+		}
+		throw(dal.MuteItem(u.ID, key, expire), "")
+	case g.PostForm("set-unmute") != "":
+		throw(dal.UnmuteItem(u.ID, g.PostForm("unmute")), "")
+	case g.PostForm("set-revoke-oauth") != "":
+		throw(dal.RevokeOAuthGrant(u.ID, g.PostForm("revoke-oauth")), "")
+	case g.PostForm("set-revoke-session") != "":
+		throw(dal.RevokeSession(u, g.PostForm("revoke-session")), "")
+	case g.PostForm("set-revoke-others") != "":
+		throw(dal.RevokeSession(u, ""), "")
 	case g.PostForm("set-fw-accept") != "":
 		throw(common.Err2(dal.DoUpdateUser(u.ID, func(u *model.User) {
 			if g.PostForm("fw-accept") != "" {
@@ -205,12 +360,125 @@ func APIUpdateUserPassword(g *gin.Context) {
 	newPassword := common.SoftTrunc(g.PostForm("new-password"), 32)
 
 	throw(len(newPassword) < 3, "new_password_too_short")
-	throw(!bytes.Equal(u.PasswordHash, hashPassword(oldPassword)), "old_password_invalid")
-	throw(common.Err2(dal.DoUpdateUser(u.ID, "PasswordHash", hashPassword(newPassword))), "")
+	ok, _ := passwd.Verify(oldPassword, u.PasswordHash)
+	throw(!ok, "old_password_invalid")
+	throw(common.Err2(dal.DoUpdateUser(u.ID, "PasswordHash", passwd.Hash(newPassword))), "")
+	throw(dal.RevokeSession(u, ""), "")
+	okok(g)
+}
+
+func emailVerificationID(uid, email string) string {
+	return "verify-email/" + uid + "/" + strings.ToLower(email)
+}
+
+func checkEmailVerificationCooldown(uid string) error {
+	if v, ok := dal.CacheGet("u/" + uid + "/verify-mail"); ok {
+		if last, _ := strconv.ParseInt(v, 10, 64); time.Since(time.Unix(last, 0)) < time.Minute {
+			return fmt.Errorf("e:verify_too_frequent")
+		}
+	}
+	return nil
+}
+
+func sendEmailVerification(uid, email string) error {
+	site, err := siteURL()
+	if err != nil {
+		return err
+	}
+
+	if err := checkEmailVerificationCooldown(uid); err != nil {
+		return err
+	}
+	dal.CacheSet("u/"+uid+"/verify-mail", strconv.FormatInt(time.Now().Unix(), 10))
+
+	ttl := time.Duration(common.Cfg.VerifyEmailTTL) * time.Hour
+	link := site + "/verify_email?" + url.Values{
+		"uid":   {uid},
+		"email": {email},
+		"token": {ik.MakeOTTWithTTL(emailVerificationID(uid, email), ttl)},
+	}.Encode()
+	return mail.SendEmailVerification(email, uid, link, ttl)
+}
+
+func VerifyEmail(g *gin.Context) {
+	uid, email := g.Query("uid"), g.Query("email")
+	if !ik.ValidateOTT(emailVerificationID(uid, email), g.Query("token")) {
+		g.Set("error", "链接无效或已过期")
+		NotFound(g)
+		return
+	}
+	if err := dal.VerifyUserEmail(uid, email); err != nil {
+		g.Set("error", "链接无效或已过期")
+		NotFound(g)
+		return
+	}
+	g.Redirect(302, "/user_security")
+}
+
+func passwordResetID(u *model.User) string {
+	// Bound to the current password hash, so the token becomes invalid once used
+	h := sha1.Sum(u.PasswordHash)
+	return "reset/" + u.ID + "/" + hex.EncodeToString(h[:4])
+}
+
+func APIRequestPasswordReset(g *gin.Context) {
+	throw(checkIP(g), "")
+	site, err := siteURL()
+	throw(err, "")
+
+	id := common.SoftTrunc(g.PostForm("id"), 256)
+	u, _ := dal.GetUser(sanUsername(id))
+	if u == nil {
+		u, _ = dal.GetUserByEmail(id)
+	}
+
+	// Always succeed and send the mail in background, so nobody can tell whether the user or email exists,
+	// neither by the response nor by its time
+	if u != nil && u.VerifiedEmail() != "" {
+		go sendPasswordReset(u, site)
+	}
 	okok(g)
 }
 
+func sendPasswordReset(u *model.User, site string) {
+	key := "u/" + u.ID + "/reset-mail"
+	if v, ok := dal.CacheGet(key); ok {
+		last, _ := strconv.ParseInt(v, 10, 64)
+		if time.Since(time.Unix(last, 0)) < time.Duration(common.Cfg.ResetMailCooldown)*time.Minute {
+			log.Println("[PasswordReset] Too frequent:", u.ID)
+			return
+		}
+	}
+	dal.CacheSet(key, strconv.FormatInt(time.Now().Unix(), 10))
+
+	link := site + "/reset_password?" + url.Values{
+		"uid":   {u.ID},
+		"token": {ik.MakeOTT(passwordResetID(u))},
+	}.Encode()
+	if err := mail.SendPasswordReset(u.VerifiedEmail(), u.ID, link, time.Duration(common.Cfg.IDTokenTTL)*time.Second); err != nil {
+		log.Println("[PasswordReset] Failed to send:", u.ID, err)
+	}
+}
+
 func APIResetUserPassword(g *gin.Context) {
+	throw(checkIP(g), "")
+
+	u, _ := dal.GetUser(g.PostForm("uid"))
+	throw(u, "")
+	throw(!ik.ValidateOTT(passwordResetID(u), g.PostForm("token")), "expired_session")
+
+	newPassword := common.SoftTrunc(g.PostForm("new-password"), 32)
+	throw(len(newPassword) < 3, "new_password_too_short")
+	throw(common.Err2(dal.DoUpdateUser(u.ID, "PasswordHash", passwd.Hash(newPassword))), "")
+	throw(dal.RevokeSession(u, ""), "")
+
+	ip := hashIP(g)
+	go func() {
+		if err := mail.SendPasswordChanged(u.VerifiedEmail(), u.ID, ip); err != nil {
+			log.Println("[PasswordReset] notify:", u.ID, err)
+		}
+	}()
+	okok(g)
 }
 
 func APIClearInbox(g *gin.Context) {
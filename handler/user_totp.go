@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/common/passwd"
+	"github.com/coyove/iis/common/qrcode"
+	"github.com/coyove/iis/dal"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+const numRecoveryCodes = 8
+
+// totpPendingID binds the pending login to the IP which has passed checkLoginGuard in the first step
+func totpPendingID(uid, ip string) string { return "totp/" + uid + "/" + ip }
+
+func totpEnrollID(uid, secret string) string { return "totp-enroll/" + uid + "/" + secret }
+
+// checkTOTP validates the 2FA code, which is either a TOTP code or a recovery code,
+// a matched recovery code will be consumed.
+func checkTOTP(u *model.User, code string) bool {
+	if ik.ValidateTOTP(u.TOTPSecret, code, dal.UseTOTPCode) {
+		return true
+	}
+
+	// Only write the user when the code matches, so wrong codes won't turn into writes
+	h, found := ik.HashRecoveryCode(code), false
+	if !strings.Contains(","+u.TOTPRecovery+",", ","+h+",") {
+		return false
+	}
+	if _, err := dal.DoUpdateUser(u.ID, func(u *model.User) {
+		codes := strings.Split(u.TOTPRecovery, ",")
+		for i, c := range codes {
+			if c != "" && c == h {
+				u.TOTPRecovery = strings.Join(append(codes[:i], codes[i+1:]...), ",")
+				found = true
+				return
+			}
+		}
+	}); err != nil {
+		return false
+	}
+	return found
+}
+
+func APILoginTOTP(g *gin.Context) {
+	throw(checkIP(g), "")
+
+	u, _ := dal.GetUser(sanUsername(g.PostForm("username")))
+	throw(u, "invalid_id_password")
+	throw(u.TOTPSecret == "", "invalid_id_password")
+	// The captcha (if any) has been solved in the first step, only lockouts caused by wrong codes are checked here
+	checkLoginCooldown(g, u.ID)
+	throw(!ik.ValidateOTT(totpPendingID(u.ID, clientIP(g)), g.PostForm("pending")), "expired_session")
+	if !checkTOTP(u, g.PostForm("code")) {
+		dal.LoginFailed(u.ID, clientIP(g), true)
+		throw(true, "invalid_totp")
+	}
+	doLogin(g, u)
+}
+
+func APIBeginTOTP(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	throw(u.TOTPSecret != "", "totp_enabled")
+
+	issuer := "iis"
+	if len(common.Cfg.Domains) > 0 {
+		issuer = common.Cfg.Domains[0]
+	}
+
+	var p struct {
+		Secret string
+		QRCode string
+		Token  string
+	}
+	p.Secret = ik.MakeTOTPSecret()
+	qr, err := qrcode.Encode(ik.TOTPURI(issuer, u.ID, p.Secret))
+	throw(err, "")
+	p.QRCode = qr.PNGBase64(4)
+	p.Token = ik.MakeOTT(totpEnrollID(u.ID, p.Secret))
+	g.JSON(200, p)
+}
+
+func APIEnableTOTP(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	throw(checkIP(g), "")
+	secret := g.PostForm("secret")
+
+	throw(u.TOTPSecret != "", "totp_enabled")
+	ok, _ := passwd.Verify(g.PostForm("password"), u.PasswordHash)
+	throw(!ok, "old_password_invalid")
+	throw(!ik.ValidateOTT(totpEnrollID(u.ID, secret), g.PostForm("token")), "expired_session")
+	throw(!ik.ValidateTOTP(secret, g.PostForm("code"), dal.UseTOTPCode), "invalid_totp")
+
+	codes, hashes := ik.MakeRecoveryCodes(numRecoveryCodes)
+	throw(common.Err2(dal.DoUpdateUser(u.ID, func(u *model.User) {
+		u.TOTPSecret = secret
+		u.TOTPRecovery = strings.Join(hashes, ",")
+	})), "")
+	okok(g, strings.Join(codes, " "))
+}
+
+func APIDisableTOTP(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	throw(checkIP(g), "")
+	throw(u.TOTPSecret == "", "")
+
+	ok, _ := passwd.Verify(g.PostForm("password"), u.PasswordHash)
+	throw(!ok, "old_password_invalid")
+	throw(!checkTOTP(u, g.PostForm("code")), "invalid_totp")
+	throw(common.Err2(dal.DoUpdateUser(u.ID, func(u *model.User) {
+		u.TOTPSecret, u.TOTPRecovery = "", ""
+	})), "")
+	okok(g)
+}
+
+func APIRegenRecoveryCodes(g *gin.Context) {
+	u := throw(dal.GetUserByContext(g), "").(*model.User)
+	throw(checkIP(g), "")
+	throw(u.TOTPSecret == "", "")
+	checkLoginCooldown(g, u.ID)
+	if !ik.ValidateTOTP(u.TOTPSecret, g.PostForm("code"), dal.UseTOTPCode) {
+		dal.LoginFailed(u.ID, clientIP(g), true)
+		throw(true, "invalid_totp")
+	}
+
+	codes, hashes := ik.MakeRecoveryCodes(numRecoveryCodes)
+	throw(common.Err2(dal.DoUpdateUser(u.ID, "TOTPRecovery", strings.Join(hashes, ","))), "")
+	okok(g, strings.Join(codes, " "))
+}
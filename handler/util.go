@@ -2,9 +2,6 @@ package handler
 
 import (
 	"bytes"
-	"crypto/hmac"
-	"crypto/rand"
-	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
@@ -57,6 +54,7 @@ type ReplyView struct {
 	UUID            string
 	ReplyTo         string
 	DefaultNoMaster bool
+	Draft           *model.Draft
 }
 
 func makeReplyView(g *gin.Context, reply string, u *model.User) ReplyView {
@@ -69,6 +67,12 @@ func makeReplyView(g *gin.Context, reply string, u *model.User) ReplyView {
 	r.ReplyTo = reply
 	if u != nil {
 		r.DefaultNoMaster = u.FollowApply != 0
+		if id := g.Query("draft"); id != "" {
+			// Only drafts written in the same post box can be continued here
+			if d, _ := dal.GetDraft(u.ID, id); d != nil && d.ReplyTo == reply {
+				r.Draft = d
+			}
+		}
 	}
 	return r
 }
@@ -113,6 +117,14 @@ func lastMonth(v string) string {
 	return fmt.Sprintf("%04d-%02d", t.Year(), t.Month()-1)
 }
 
+// siteURL returns the URL used in mails, the Host header can't be trusted so a configured domain is required
+func siteURL() (string, error) {
+	if len(common.Cfg.Domains) == 0 {
+		return "", fmt.Errorf("e:site_domain_not_set")
+	}
+	return "https://" + common.Cfg.Domains[0], nil
+}
+
 func redirectVisitor(g *gin.Context) {
 	g.Redirect(302, "/?redirect="+url.QueryEscape(g.Request.URL.String()))
 }
@@ -126,7 +138,7 @@ func checkIP(g *gin.Context) string {
 		}
 	}
 	if !g.GetBool("ip-ok") {
-		return fmt.Sprintf("cooldown`%.1fs", float64(common.Cfg.Cooldown)-g.GetFloat64("ip-ok-remain"))
+		return fmt.Sprintf("cooldown`%.1fs", g.GetFloat64("ip-ok-retry"))
 	}
 	return ""
 }
@@ -135,12 +147,6 @@ func sanUsername(id string) string {
 	return compress.SafeStringForCompressString(id)
 }
 
-func hashPassword(password string) []byte {
-	pwdHash := hmac.New(sha256.New, common.Cfg.KeyBytes)
-	pwdHash.Write([]byte(password))
-	return pwdHash.Sum(nil)
-}
-
 func checkCaptcha(g *gin.Context) string {
 	var (
 		answer            = common.SoftTrunc(g.PostForm("answer"), 6)
@@ -201,17 +207,6 @@ func checkCaptcha(g *gin.Context) string {
 	return ""
 }
 
-func genSession() string {
-	p := [12]byte{}
-	rand.Read(p[:])
-	for i := range p {
-		if p[i] == 0 {
-			p[i] = 1
-		}
-	}
-	return base64.URLEncoding.EncodeToString(p[:])
-}
-
 func writeImageReader(u *model.User, fn string, buf []byte) (string, error) {
 	if dal.S3 != nil {
 		return "LOCAL:" + fn, dal.S3.Put(fn, http.DetectContentType(buf), bytes.NewReader(buf))
@@ -0,0 +1,2073 @@
+// Code generated by voice/main.go. DO NOT EDIT.
+
+package captcha
+
+var beepSound = []byte{
+	0x80, 0x80, 0x82, 0x82, 0x80, 0x7c, 0x79, 0x7a, 0x80, 0x87, 0x8c, 0x89, 0x80, 0x75, 0x6f, 0x73,
+	0x80, 0x8f, 0x96, 0x90, 0x80, 0x6e, 0x65, 0x6c, 0x80, 0x96, 0xa0, 0x97, 0x80, 0x67, 0x5b, 0x65,
+	0x80, 0x9d, 0xaa, 0x9e, 0x80, 0x60, 0x51, 0x5e, 0x80, 0xa4, 0xb4, 0xa6, 0x80, 0x59, 0x47, 0x57,
+	0x80, 0xab, 0xbe, 0xad, 0x80, 0x52, 0x3d, 0x50, 0x80, 0xb2, 0xc8, 0xb4, 0x80, 0x4b, 0x33, 0x49,
+	0x80, 0xb9, 0xd2, 0xbb, 0x80, 0x44, 0x29, 0x42, 0x80, 0xc0, 0xdc, 0xc2, 0x80, 0x3c, 0x1f, 0x3b,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a, 0x80, 0xc6, 0xe4, 0xc6, 0x80, 0x3a, 0x1c, 0x3a,
+	0x80, 0xc5, 0xe1, 0xc4, 0x80, 0x3e, 0x24, 0x40, 0x80, 0xbe, 0xd7, 0xbc, 0x80, 0x45, 0x2e, 0x47,
+	0x80, 0xb7, 0xcd, 0xb5, 0x80, 0x4c, 0x38, 0x4e, 0x80, 0xb0, 0xc3, 0xae, 0x80, 0x53, 0x42, 0x55,
+	0x80, 0xa9, 0xb9, 0xa7, 0x80, 0x5a, 0x4c, 0x5c, 0x80, 0xa2, 0xaf, 0xa0, 0x80, 0x62, 0x56, 0x63,
+	0x80, 0x9b, 0xa5, 0x99, 0x80, 0x69, 0x60, 0x6a, 0x80, 0x94, 0x9b, 0x92, 0x80, 0x70, 0x6a, 0x71,
+	0x80, 0x8d, 0x91, 0x8b, 0x80, 0x77, 0x74, 0x79, 0x80, 0x86, 0x87, 0x84, 0x80, 0x7e, 0x7e, 0x80,
+}
+
+var digitSounds = [][]byte{
+	{ // 0
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x7f, 0x81, 0x7f, 0x81,
+		0x7f, 0x81, 0x80, 0x80, 0x81, 0x7f, 0x81, 0x80, 0x7f, 0x82, 0x7f, 0x7f, 0x84, 0x7b, 0x84, 0x7b,
+		0x85, 0x7e, 0x82, 0x7b, 0x87, 0x77, 0x8b, 0x74, 0x8a, 0x7a, 0x83, 0x7e, 0x82, 0x7f, 0x80, 0x81,
+		0x80, 0x7d, 0x87, 0x75, 0x8e, 0x6e, 0x96, 0x66, 0x98, 0x6f, 0x88, 0x7e, 0x82, 0x80, 0x87, 0x7b,
+		0x7e, 0x85, 0x80, 0x80, 0x84, 0x78, 0x7d, 0x87, 0x7d, 0x82, 0x77, 0x88, 0x75, 0x88, 0x7d, 0x7f,
+		0x80, 0x7a, 0x86, 0x84, 0x7a, 0x82, 0x81, 0x7d, 0x83, 0x86, 0x78, 0x86, 0x7b, 0x88, 0x79, 0x88,
+		0x7b, 0x7e, 0x87, 0x7a, 0x85, 0x7d, 0x80, 0x81, 0x80, 0x81, 0x77, 0x8f, 0x6b, 0x94, 0x71, 0x88,
+		0x78, 0x85, 0x7d, 0x89, 0x73, 0x8c, 0x77, 0x86, 0x7a, 0x87, 0x75, 0x97, 0x6e, 0x97, 0x64, 0x96,
+		0x76, 0x89, 0x7c, 0x7b, 0x86, 0x79, 0x85, 0x79, 0x86, 0x70, 0x8e, 0x77, 0x82, 0x84, 0x74, 0x87,
+		0x7c, 0x87, 0x7d, 0x84, 0x78, 0x89, 0x7a, 0x88, 0x79, 0x89, 0x76, 0x85, 0x87, 0x74, 0x8b, 0x78,
+		0x8b, 0x76, 0x84, 0x81, 0x76, 0x8b, 0x75, 0x8b, 0x75, 0x87, 0x7b, 0x84, 0x7e, 0x80, 0x80, 0x7e,
+		0x81, 0x80, 0x80, 0x80, 0x7c, 0x86, 0x7b, 0x86, 0x7e, 0x81, 0x8b, 0x77, 0x83, 0x80, 0x80, 0x85,
+		0x7a, 0x85, 0x79, 0x81, 0x7e, 0x8a, 0x6b, 0x8f, 0x76, 0x7e, 0x88, 0x7a, 0x7b, 0x8a, 0x76, 0x84,
+		0x80, 0x86, 0x70, 0x97, 0x6a, 0x97, 0x6e, 0x89, 0x81, 0x7a, 0x8f, 0x71, 0x87, 0x7f, 0x7f, 0x81,
+		0x84, 0x77, 0x87, 0x7e, 0x7b, 0x8d, 0x6b, 0x9a, 0x65, 0x95, 0x6e, 0x93, 0x70, 0x8c, 0x77, 0x86,
+		0x78, 0x8d, 0x6b, 0x9b, 0x65, 0x99, 0x6c, 0x8a, 0x80, 0x8b, 0x75, 0x8e, 0x6c, 0x98, 0x6d, 0x8d,
+		0x79, 0x7e, 0x7c, 0x86, 0x80, 0x78, 0x80, 0x80, 0x79, 0x8d, 0x6f, 0x8f, 0x70, 0x85, 0x88, 0x71,
+		0x8c, 0x78, 0x88, 0x7d, 0x7f, 0x8a, 0x71, 0x91, 0x76, 0x82, 0x84, 0x7a, 0x8b, 0x72, 0x8d, 0x7e,
+		0x78, 0x88, 0x7d, 0x82, 0x80, 0x7b, 0x86, 0x78, 0x8c, 0x70, 0x91, 0x70, 0x8a, 0x77, 0x8d, 0x6c,
+		0x95, 0x6e, 0x8e, 0x77, 0x85, 0x82, 0x78, 0x8c, 0x87, 0x73, 0x8e, 0x6a, 0x9f, 0x64, 0x9d, 0x65,
+		0x89, 0x81, 0x7a, 0x86, 0x7b, 0x7e, 0x7d, 0x84, 0x81, 0x7c, 0x7d, 0x80, 0x85, 0x76, 0x89, 0x7b,
+		0x85, 0x7a, 0x88, 0x7e, 0x82, 0x7b, 0x87, 0x7b, 0x88, 0x7e, 0x7c, 0x88, 0x76, 0x87, 0x7e, 0x84,
+		0x75, 0x8b, 0x77, 0x84, 0x83, 0x70, 0x94, 0x6b, 0x98, 0x65, 0x9d, 0x65, 0x8f, 0x7b, 0x82, 0x7d,
+		0x87, 0x77, 0x86, 0x7e, 0x81, 0x82, 0x7c, 0x98, 0x65, 0x94, 0x77, 0x83, 0x84, 0x75, 0x90, 0x62,
+		0x9d, 0x6a, 0x8f, 0x70, 0x8b, 0x70, 0x92, 0x72, 0x89, 0x70, 0x90, 0x72, 0x8a, 0x7b, 0x83, 0x80,
+		0x79, 0x8c, 0x76, 0x8f, 0x71, 0x8d, 0x76, 0x8f, 0x71, 0x90, 0x6b, 0x98, 0x6e, 0x89, 0x7e, 0x7f,
+		0x7d, 0x88, 0x75, 0x8d, 0x6e, 0x90, 0x73, 0x90, 0x6e, 0x8b, 0x7e, 0x7a, 0x88, 0x7b, 0x83, 0x7c,
+		0x81, 0x80, 0x80, 0x83, 0x7a, 0x8a, 0x80, 0x85, 0x73, 0x8e, 0x76, 0x8c, 0x79, 0x80, 0x7e, 0x80,
+		0x7c, 0x87, 0x77, 0x80, 0x84, 0x75, 0x8f, 0x6e, 0x8c, 0x71, 0x90, 0x76, 0x83, 0x84, 0x72, 0x95,
+		0x6e, 0x8f, 0x73, 0x8f, 0x76, 0x85, 0x83, 0x7d, 0x85, 0x7b, 0x80, 0x85, 0x7d, 0x82, 0x7e, 0x81,
+		0x7e, 0x86, 0x72, 0x8f, 0x74, 0x85, 0x7c, 0x84, 0x7a, 0x88, 0x75, 0x8c, 0x75, 0x8e, 0x71, 0x8c,
+		0x7d, 0x7e, 0x83, 0x7a, 0x8d, 0x82, 0x7e, 0x7f, 0x7b, 0x8c, 0x76, 0x89, 0x78, 0x7e, 0x80, 0x83,
+		0x7c, 0x88, 0x6c, 0x91, 0x74, 0x86, 0x7e, 0x79, 0x8c, 0x71, 0x8c, 0x7f, 0x77, 0x8f, 0x6e, 0x96,
+		0x75, 0x85, 0x79, 0x88, 0x7c, 0x87, 0x79, 0x88, 0x7b, 0x84, 0x79, 0x8a, 0x78, 0x85, 0x7b, 0x84,
+		0x83, 0x74, 0x8a, 0x75, 0x89, 0x7a, 0x81, 0x83, 0x78, 0x86, 0x7f, 0x7d, 0x85, 0x7c, 0x82, 0x7e,
+		0x84, 0x7c, 0x87, 0x7a, 0x87, 0x85, 0x80, 0x79, 0x85, 0x7f, 0x82, 0x86, 0x76, 0x7e, 0x89, 0x75,
+		0x89, 0x74, 0x88, 0x70, 0x8f, 0x79, 0x80, 0x7f, 0x7f, 0x86, 0x75, 0x92, 0x6c, 0x94, 0x69, 0x9f,
+		0x60, 0x9d, 0x70, 0x80, 0x8d, 0x75, 0x89, 0x78, 0x84, 0x80, 0x84, 0x7a, 0x86, 0x72, 0x8f, 0x76,
+		0x83, 0x82, 0x76, 0x91, 0x69, 0x9b, 0x64, 0x97, 0x6f, 0x85, 0x86, 0x74, 0x87, 0x80, 0x7a, 0x8a,
+		0x75, 0x89, 0x7e, 0x7f, 0x88, 0x83, 0x81, 0x76, 0x88, 0x7f, 0x81, 0x85, 0x75, 0x85, 0x77, 0x8e,
+		0x73, 0x86, 0x78, 0x82, 0x7a, 0x86, 0x7b, 0x80, 0x80, 0x83, 0x7b, 0x89, 0x77, 0x83, 0x80, 0x82,
+		0x80, 0x80, 0x84, 0x77, 0x8c, 0x7c, 0x80, 0x80, 0x84, 0x77, 0x8e, 0x74, 0x87, 0x78, 0x84, 0x83,
+		0x78, 0x86, 0x7f, 0x76, 0x93, 0x6d, 0x8a, 0x7a, 0x85, 0x7d, 0x81, 0x82, 0x76, 0x8e, 0x75, 0x84,
+		0x80, 0x7f, 0x83, 0x7a, 0x8e, 0x76, 0x93, 0x6e, 0x8e, 0x71, 0x94, 0x6f, 0x8f, 0x75, 0x82, 0x7b,
+		0x8b, 0x74, 0x86, 0x77, 0x80, 0x80, 0x80, 0x86, 0x6f, 0x8e, 0x76, 0x85, 0x80, 0x7d, 0x85, 0x79,
+		0x8e, 0x70, 0x91, 0x71, 0x92, 0x73, 0x8a, 0x78, 0x83, 0x82, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x7d,
+		0x83, 0x7d, 0x82, 0x7e, 0x80, 0x80, 0x80, 0x7d, 0x86, 0x73, 0x91, 0x6d, 0x94, 0x70, 0x8a, 0x7c,
+		0x7e, 0x8c, 0x6f, 0x90, 0x75, 0x82, 0x91, 0x77, 0x8a, 0x75, 0x84, 0x80, 0x87, 0x73, 0x84, 0x78,
+		0x83, 0x83, 0x79, 0x80, 0x79, 0x86, 0x7a, 0x8b, 0x6f, 0x87, 0x7c, 0x87, 0x80, 0x77, 0x90, 0x6e,
+		0x98, 0x6f, 0x8b, 0x77, 0x8a, 0x80, 0x7f, 0x80, 0x81, 0x7d, 0x8a, 0x72, 0x8b, 0x75, 0x84, 0x80,
+		0x7e, 0x80, 0x7b, 0x81, 0x80, 0x80, 0x7c, 0x85, 0x78, 0x8b, 0x75, 0x88, 0x7f, 0x7c, 0x8b, 0x73,
+		0x8b, 0x77, 0x87, 0x7f, 0x80, 0x82, 0x7c, 0x96, 0x7f, 0x81, 0x6b, 0x9a, 0x75, 0x88, 0x71, 0x7c,
+		0x80, 0x83, 0x7e, 0x71, 0x82, 0x7e, 0x89, 0x70, 0x8d, 0x6b, 0x9a, 0x75, 0x89, 0x76, 0x86, 0x89,
+		0x7f, 0x81, 0x7c, 0x85, 0x84, 0x80, 0x7d, 0x7d, 0x88, 0x78, 0x88, 0x73, 0x85, 0x7b, 0x86, 0x7c,
+		0x7d, 0x80, 0x81, 0x82, 0x7c, 0x82, 0x7e, 0x87, 0x78, 0x8a, 0x75, 0x91, 0x74, 0x8a, 0x78, 0x84,
+		0x7f, 0x82, 0x7f, 0x7d, 0x85, 0x7c, 0x81, 0x7e, 0x99, 0x8c, 0x65, 0x7e, 0x8d, 0x8a, 0x6d, 0x7c,
+		0x78, 0x85, 0x82, 0x73, 0x73, 0x85, 0x8c, 0x74, 0x7d, 0x7e, 0x8c, 0x82, 0x81, 0x78, 0x8a, 0x89,
+		0x80, 0x7b, 0x82, 0x87, 0x82, 0x7c, 0x7b, 0x80, 0x85, 0x7c, 0x7d, 0x77, 0x8b, 0x7a, 0x7e, 0x80,
+		0x7e, 0x86, 0x80, 0x7c, 0x83, 0x80, 0x89, 0x75, 0x8b, 0x76, 0x8f, 0x76, 0x84, 0x7e, 0x82, 0x80,
+		0x7f, 0x7e, 0x80, 0x80, 0x7d, 0x7f, 0x80, 0x82, 0x7e, 0x81, 0xa4, 0x90, 0x59, 0x79, 0xaf, 0x72,
+		0x66, 0x7a, 0x87, 0x7d, 0x7c, 0x6a, 0x7a, 0x95, 0x80, 0x65, 0x86, 0x98, 0x7c, 0x7f, 0x80, 0x8a,
+		0x8a, 0x84, 0x72, 0x87, 0x8f, 0x7a, 0x72, 0x83, 0x85, 0x79, 0x7a, 0x79, 0x81, 0x86, 0x76, 0x7c,
+		0x84, 0x86, 0x7f, 0x7c, 0x86, 0x87, 0x80, 0x7f, 0x80, 0x85, 0x81, 0x7d, 0x7b, 0x87, 0x7d, 0x7e,
+		0x7c, 0x81, 0x80, 0x7d, 0x7e, 0x81, 0x81, 0x81, 0x7d, 0x83, 0x82, 0x81, 0xb7, 0x8d, 0x3f, 0x8d,
+		0xbe, 0x57, 0x4a, 0x98, 0x89, 0x64, 0x70, 0x75, 0x88, 0x92, 0x69, 0x6d, 0xac, 0x91, 0x64, 0x85,
+		0xa2, 0x82, 0x75, 0x82, 0x85, 0x89, 0x78, 0x6c, 0x83, 0x8d, 0x6c, 0x6e, 0x8b, 0x87, 0x72, 0x7c,
+		0x8a, 0x86, 0x82, 0x7b, 0x86, 0x8d, 0x81, 0x76, 0x86, 0x8a, 0x79, 0x77, 0x83, 0x82, 0x7a, 0x7a,
+		0x7f, 0x83, 0x7f, 0x7a, 0x81, 0x88, 0x80, 0x7c, 0x84, 0x86, 0x80, 0x7e, 0x82, 0x83, 0xcb, 0x81,
+		0x21, 0xa6, 0xd0, 0x2a, 0x3e, 0xbf, 0x84, 0x41, 0x77, 0x8e, 0x89, 0x82, 0x65, 0x88, 0xba, 0x7a,
+		0x58, 0xa8, 0xaa, 0x5f, 0x70, 0x9b, 0x84, 0x6d, 0x72, 0x7c, 0x8a, 0x7a, 0x62, 0x82, 0x9a, 0x73,
+		0x6c, 0x94, 0x92, 0x77, 0x7e, 0x8d, 0x8a, 0x80, 0x78, 0x80, 0x8c, 0x7c, 0x6e, 0x81, 0x89, 0x75,
+		0x74, 0x84, 0x84, 0x7c, 0x7c, 0x83, 0x87, 0x82, 0x7c, 0x82, 0x8a, 0x80, 0x7a, 0x83, 0x85, 0x7c,
+		0x7b, 0xcd, 0x86, 0x1f, 0x9f, 0xd0, 0x33, 0x3e, 0xb8, 0x86, 0x4a, 0x76, 0x89, 0x8c, 0x87, 0x64,
+		0x83, 0xba, 0x7d, 0x57, 0xa3, 0xa9, 0x63, 0x6f, 0x97, 0x84, 0x71, 0x71, 0x79, 0x8b, 0x7d, 0x62,
+		0x80, 0x9a, 0x76, 0x6c, 0x92, 0x92, 0x79, 0x7d, 0x8a, 0x8a, 0x81, 0x78, 0x7f, 0x8c, 0x7e, 0x6f,
+		0x80, 0x89, 0x77, 0x74, 0x83, 0x84, 0x7d, 0x7c, 0x81, 0x87, 0x83, 0x7c, 0x81, 0x8a, 0x80, 0x7a,
+		0x82, 0x85, 0x7d, 0x7b, 0xcc, 0x86, 0x20, 0x9f, 0xd0, 0x33, 0x3f, 0xb8, 0x86, 0x4a, 0x77, 0x89,
+		0x8b, 0x87, 0x64, 0x83, 0xba, 0x7d, 0x57, 0xa3, 0xa9, 0x63, 0x6f, 0x97, 0x84, 0x71, 0x72, 0x79,
+		0x8b, 0x7d, 0x63, 0x80, 0x9a, 0x76, 0x6c, 0x92, 0x92, 0x79, 0x7d, 0x8a, 0x8a, 0x81, 0x78, 0x7f,
+		0x8c, 0x7e, 0x6f, 0x80, 0x89, 0x77, 0x74, 0x83, 0x84, 0x7d, 0x7d, 0x81, 0x87, 0x83, 0x7c, 0x81,
+		0x8a, 0x80, 0x7a, 0x82, 0x85, 0x7d, 0x7b, 0xcc, 0x86, 0x20, 0x9f, 0xd0, 0x33, 0x3f, 0xb8, 0x86,
+		0x4a, 0x76, 0x89, 0x8b, 0x87, 0x64, 0x83, 0xba, 0x7d, 0x57, 0xa3, 0xa9, 0x63, 0x6f, 0x97, 0x84,
+		0x71, 0x72, 0x79, 0x8b, 0x7d, 0x63, 0x80, 0x9a, 0x76, 0x6c, 0x92, 0x92, 0x79, 0x7d, 0x8a, 0x8a,
+		0x81, 0x78, 0x7f, 0x8c, 0x7e, 0x6f, 0x80, 0x89, 0x77, 0x74, 0x83, 0x84, 0x7d, 0x7d, 0x81, 0x87,
+		0x83, 0x7c, 0x81, 0x8a, 0x80, 0x7a, 0x82, 0x85, 0x7d, 0x7b, 0x80, 0xce, 0x83, 0x1d, 0xa2, 0xd4,
+		0x30, 0x3a, 0xbd, 0x8b, 0x45, 0x74, 0x8e, 0x8d, 0x84, 0x63, 0x85, 0xbb, 0x7b, 0x55, 0xa5, 0xac,
+		0x61, 0x6d, 0x99, 0x86, 0x6f, 0x70, 0x7c, 0x8c, 0x7c, 0x61, 0x81, 0x9b, 0x75, 0x6b, 0x93, 0x93,
+		0x77, 0x7c, 0x8b, 0x8a, 0x80, 0x77, 0x80, 0x8d, 0x7e, 0x6e, 0x81, 0x8a, 0x77, 0x74, 0x84, 0x85,
+		0x7c, 0x7c, 0x82, 0x87, 0x82, 0x7b, 0x82, 0x8a, 0x80, 0x79, 0x82, 0x86, 0x7d, 0x7b, 0x80, 0xce,
+		0x83, 0x1d, 0xa3, 0xd5, 0x30, 0x3a, 0xbd, 0x8b, 0x45, 0x73, 0x8e, 0x8d, 0x83, 0x62, 0x85, 0xbb,
+		0x7b, 0x55, 0xa5, 0xac, 0x61, 0x6d, 0x99, 0x86, 0x6f, 0x70, 0x7c, 0x8c, 0x7c, 0x61, 0x81, 0x9b,
+		0x75, 0x6b, 0x93, 0x93, 0x77, 0x7c, 0x8b, 0x8b, 0x80, 0x77, 0x80, 0x8d, 0x7e, 0x6e, 0x81, 0x8a,
+		0x77, 0x74, 0x84, 0x85, 0x7c, 0x7c, 0x82, 0x87, 0x82, 0x7b, 0x82, 0x8a, 0x80, 0x79, 0x82, 0x86,
+		0x7d, 0x7b, 0x80, 0xce, 0x83, 0x1c, 0xa3, 0xd5, 0x30, 0x3a, 0xbd, 0x8b, 0x45, 0x73, 0x8e, 0x8d,
+		0x83, 0x62, 0x85, 0xbb, 0x7b, 0x54, 0xa5, 0xac, 0x61, 0x6d, 0x99, 0x86, 0x6f, 0x70, 0x7c, 0x8c,
+		0x7c, 0x61, 0x81, 0x9b, 0x75, 0x6b, 0x93, 0x93, 0x77, 0x7c, 0x8b, 0x8b, 0x80, 0x77, 0x80, 0x8d,
+		0x7e, 0x6e, 0x81, 0x8a, 0x77, 0x74, 0x84, 0x85, 0x7c, 0x7c, 0x82, 0x87, 0x82, 0x7b, 0x82, 0x8a,
+		0x80, 0x79, 0x82, 0x86, 0x7d, 0x7b, 0x80, 0x81, 0xca, 0x80, 0x20, 0xa8, 0xd2, 0x2b, 0x3f, 0xc2,
+		0x86, 0x42, 0x78, 0x90, 0x89, 0x82, 0x64, 0x87, 0xb9, 0x78, 0x56, 0xa8, 0xa9, 0x5e, 0x6f, 0x9c,
+		0x84, 0x6e, 0x72, 0x7d, 0x8b, 0x7b, 0x62, 0x83, 0x9a, 0x73, 0x6b, 0x94, 0x92, 0x76, 0x7d, 0x8c,
+		0x89, 0x80, 0x78, 0x80, 0x8c, 0x7e, 0x6f, 0x80, 0x8a, 0x78, 0x73, 0x82, 0x86, 0x7e, 0x7c, 0x80,
+		0x86, 0x85, 0x7d, 0x7e, 0x88, 0x86, 0x7b, 0x7d, 0x85, 0x82, 0x7b, 0x7c, 0x80, 0xbb, 0x99, 0x34,
+		0x6c, 0xcf, 0x80, 0x27, 0x75, 0xb2, 0x77, 0x54, 0x77, 0x8b, 0x8d, 0x89, 0x70, 0x73, 0xa3, 0xa3,
+		0x6a, 0x69, 0x9e, 0x9e, 0x6e, 0x69, 0x87, 0x8c, 0x7c, 0x73, 0x73, 0x7c, 0x89, 0x82, 0x6f, 0x75,
+		0x8f, 0x90, 0x78, 0x75, 0x89, 0x90, 0x82, 0x7b, 0x7f, 0x84, 0x85, 0x81, 0x7a, 0x78, 0x80, 0x86,
+		0x7e, 0x75, 0x7b, 0x85, 0x84, 0x7c, 0x7b, 0x81, 0x85, 0x83, 0x80, 0x7f, 0x80, 0x84, 0x84, 0x80,
+		0x7d, 0x7f, 0x82, 0x9d, 0xa4, 0x74, 0x4f, 0x75, 0xa9, 0x92, 0x56, 0x51, 0x83, 0x9a, 0x81, 0x69,
+		0x71, 0x82, 0x8b, 0x8e, 0x89, 0x7c, 0x77, 0x86, 0x99, 0x90, 0x76, 0x6e, 0x81, 0x91, 0x87, 0x74,
+		0x70, 0x7b, 0x83, 0x82, 0x7d, 0x79, 0x79, 0x7d, 0x84, 0x88, 0x83, 0x7c, 0x7c, 0x84, 0x8b, 0x87,
+		0x7f, 0x7b, 0x80, 0x84, 0x84, 0x80, 0x7c, 0x7c, 0x7e, 0x80, 0x80, 0x80, 0x7e, 0x7c, 0x7e, 0x81,
+		0x83, 0x82, 0x7f, 0x7e, 0x80, 0x83, 0x84, 0x82, 0x8a, 0x98, 0x94, 0x7a, 0x63, 0x6b, 0x87, 0x96,
+		0x85, 0x69, 0x60, 0x72, 0x87, 0x8c, 0x82, 0x78, 0x78, 0x7e, 0x84, 0x87, 0x8a, 0x8a, 0x86, 0x7f,
+		0x7c, 0x80, 0x88, 0x8b, 0x84, 0x7a, 0x75, 0x78, 0x80, 0x84, 0x81, 0x7c, 0x78, 0x7a, 0x7e, 0x80,
+		0x82, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x84, 0x85, 0x84, 0x81, 0x7f, 0x7e, 0x7f, 0x81, 0x82,
+		0x81, 0x7f, 0x7d, 0x7d, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x86,
+		0x8e, 0x93, 0x8c, 0x7c, 0x6d, 0x6b, 0x78, 0x88, 0x8e, 0x85, 0x75, 0x6a, 0x6e, 0x7c, 0x87, 0x8a,
+		0x85, 0x7f, 0x7c, 0x7d, 0x80, 0x84, 0x87, 0x88, 0x87, 0x83, 0x7f, 0x7c, 0x7c, 0x80, 0x84, 0x85,
+		0x80, 0x7b, 0x77, 0x79, 0x7e, 0x82, 0x83, 0x80, 0x7e, 0x7c, 0x7e, 0x80, 0x82, 0x83, 0x83, 0x82,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x80, 0x7e, 0x7d, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f,
+		0x7e, 0x7f, 0x80, 0x80, 0x81, 0x85, 0x8d, 0x92, 0x8c, 0x7d, 0x6d, 0x6b, 0x78, 0x89, 0x8f, 0x85,
+		0x75, 0x6b, 0x6f, 0x7c, 0x88, 0x8b, 0x85, 0x7f, 0x7c, 0x7d, 0x80, 0x84, 0x86, 0x87, 0x87, 0x83,
+		0x7f, 0x7c, 0x7c, 0x80, 0x84, 0x85, 0x81, 0x7b, 0x78, 0x79, 0x7e, 0x82, 0x83, 0x80, 0x7e, 0x7d,
+		0x7e, 0x80, 0x82, 0x83, 0x83, 0x82, 0x80, 0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x80, 0x7e, 0x7d,
+		0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x81, 0x80, 0x84, 0x8c, 0x92, 0x8d,
+		0x7d, 0x6e, 0x6b, 0x78, 0x88, 0x8e, 0x85, 0x76, 0x6b, 0x6f, 0x7c, 0x87, 0x8a, 0x85, 0x7f, 0x7c,
+		0x7e, 0x80, 0x84, 0x86, 0x87, 0x87, 0x83, 0x7f, 0x7c, 0x7c, 0x80, 0x84, 0x85, 0x80, 0x7b, 0x78,
+		0x79, 0x7e, 0x82, 0x83, 0x80, 0x7e, 0x7d, 0x7e, 0x80, 0x82, 0x83, 0x83, 0x82, 0x80, 0x80, 0x80,
+		0x80, 0x81, 0x82, 0x82, 0x80, 0x7f, 0x7d, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x80,
+		0x80, 0x81, 0x80, 0x80, 0x84, 0x8c, 0x92, 0x8d, 0x7e, 0x6e, 0x6b, 0x77, 0x87, 0x8e, 0x85, 0x76,
+		0x6c, 0x6f, 0x7c, 0x86, 0x8a, 0x85, 0x80, 0x7d, 0x7e, 0x80, 0x83, 0x86, 0x87, 0x87, 0x84, 0x80,
+		0x7c, 0x7c, 0x80, 0x84, 0x85, 0x81, 0x7b, 0x78, 0x79, 0x7e, 0x81, 0x83, 0x80, 0x7e, 0x7d, 0x7e,
+		0x80, 0x82, 0x83, 0x83, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x80, 0x7f, 0x7d, 0x7e,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x80, 0x84, 0x8c, 0x92, 0x8d,
+		0x7e, 0x6e, 0x6b, 0x77, 0x87, 0x8e, 0x85, 0x76, 0x6c, 0x6f, 0x7c, 0x86, 0x8a, 0x85, 0x80, 0x7d,
+		0x7e, 0x80, 0x83, 0x86, 0x87, 0x87, 0x84, 0x80, 0x7c, 0x7c, 0x80, 0x84, 0x85, 0x81, 0x7b, 0x78,
+		0x79, 0x7e, 0x81, 0x83, 0x80, 0x7e, 0x7d, 0x7e, 0x80, 0x82, 0x83, 0x83, 0x82, 0x81, 0x80, 0x80,
+		0x80, 0x81, 0x82, 0x82, 0x80, 0x7f, 0x7e, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80,
+		0x80, 0x80, 0x81, 0x81, 0x85, 0x8f, 0x94, 0x8c, 0x7a, 0x6b, 0x6c, 0x7a, 0x86, 0x87, 0x7f, 0x76,
+		0x74, 0x77, 0x7b, 0x7e, 0x82, 0x88, 0x8a, 0x85, 0x7f, 0x7c, 0x80, 0x87, 0x8a, 0x88, 0x82, 0x7f,
+		0x7d, 0x7d, 0x7c, 0x7d, 0x80, 0x81, 0x80, 0x7c, 0x7a, 0x7b, 0x7f, 0x81, 0x82, 0x82, 0x81, 0x81,
+		0x80, 0x80, 0x80, 0x82, 0x84, 0x83, 0x81, 0x80, 0x7f, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x7f,
+		0x7e, 0x7e, 0x80, 0x80, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x88, 0x94, 0x96,
+		0x87, 0x73, 0x6a, 0x6e, 0x76, 0x7a, 0x7e, 0x82, 0x82, 0x7b, 0x73, 0x74, 0x7e, 0x87, 0x8b, 0x8b,
+		0x8b, 0x88, 0x81, 0x7d, 0x7e, 0x82, 0x86, 0x84, 0x80, 0x7d, 0x7a, 0x77, 0x77, 0x7a, 0x80, 0x82,
+		0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x84, 0x86, 0x86, 0x83, 0x81, 0x80, 0x7f, 0x7e, 0x7f, 0x80,
+		0x80, 0x80, 0x7e, 0x7d, 0x7d, 0x7e, 0x7f, 0x80, 0x82, 0x82, 0x81, 0x81, 0x80, 0x80, 0x80, 0x81,
+		0x81, 0x81, 0x80, 0x80, 0x7f, 0x7f, 0x88, 0x97, 0x96, 0x85, 0x76, 0x6f, 0x6a, 0x68, 0x70, 0x80,
+		0x87, 0x84, 0x80, 0x7f, 0x7d, 0x7b, 0x7f, 0x89, 0x90, 0x8f, 0x8b, 0x86, 0x80, 0x79, 0x77, 0x7b,
+		0x7f, 0x80, 0x80, 0x7f, 0x7c, 0x78, 0x78, 0x7c, 0x80, 0x83, 0x87, 0x88, 0x86, 0x82, 0x80, 0x80,
+		0x80, 0x80, 0x81, 0x82, 0x80, 0x7e, 0x7d, 0x7b, 0x7b, 0x7c, 0x7f, 0x80, 0x81, 0x82, 0x82, 0x81,
+		0x80, 0x80, 0x81, 0x81, 0x82, 0x82, 0x81, 0x80, 0x7f, 0x7e, 0x7e, 0x7e, 0x7f, 0x80, 0x80, 0x8e,
+		0x9c, 0x97, 0x86, 0x7c, 0x70, 0x61, 0x60, 0x6e, 0x7a, 0x80, 0x89, 0x8c, 0x85, 0x7e, 0x7f, 0x80,
+		0x82, 0x89, 0x91, 0x8f, 0x88, 0x83, 0x7c, 0x73, 0x71, 0x75, 0x79, 0x7d, 0x81, 0x83, 0x80, 0x7f,
+		0x7f, 0x7f, 0x80, 0x85, 0x88, 0x88, 0x87, 0x85, 0x80, 0x7c, 0x7a, 0x7a, 0x7a, 0x7d, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x83, 0x84, 0x85, 0x83, 0x81, 0x80, 0x7e, 0x7d, 0x7d, 0x7e,
+		0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x93, 0xa0, 0x98, 0x8e, 0x81, 0x68, 0x57,
+		0x59, 0x5e, 0x69, 0x80, 0x8f, 0x93, 0x95, 0x92, 0x87, 0x81, 0x82, 0x81, 0x84, 0x8a, 0x89, 0x83,
+		0x7f, 0x76, 0x6d, 0x6c, 0x6f, 0x74, 0x7f, 0x87, 0x8c, 0x8e, 0x8d, 0x88, 0x83, 0x80, 0x7f, 0x7f,
+		0x80, 0x80, 0x80, 0x7f, 0x7c, 0x78, 0x78, 0x79, 0x7b, 0x80, 0x83, 0x86, 0x88, 0x88, 0x86, 0x83,
+		0x80, 0x7e, 0x7d, 0x7d, 0x7e, 0x7e, 0x7f, 0x7f, 0x7e, 0x7e, 0x7e, 0x7f, 0x80, 0x81, 0x83, 0x84,
+		0x84, 0x83, 0x81, 0x80, 0x92, 0x9b, 0x93, 0x8e, 0x7f, 0x64, 0x58, 0x54, 0x54, 0x67, 0x7f, 0x8e,
+		0x9d, 0xa5, 0x9c, 0x93, 0x8a, 0x7d, 0x78, 0x7b, 0x7c, 0x7f, 0x81, 0x7e, 0x78, 0x75, 0x70, 0x6f,
+		0x76, 0x7e, 0x86, 0x90, 0x94, 0x92, 0x8f, 0x87, 0x7f, 0x7a, 0x77, 0x76, 0x79, 0x7c, 0x7e, 0x7f,
+		0x7f, 0x7d, 0x7d, 0x7e, 0x80, 0x83, 0x86, 0x88, 0x89, 0x87, 0x84, 0x80, 0x7d, 0x7a, 0x79, 0x7a,
+		0x7c, 0x7e, 0x80, 0x81, 0x81, 0x81, 0x81, 0x81, 0x81, 0x82, 0x82, 0x83, 0x82, 0x81, 0x80, 0x92,
+		0x9a, 0x92, 0x8d, 0x7e, 0x64, 0x59, 0x56, 0x56, 0x69, 0x80, 0x8e, 0x9d, 0xa4, 0x9b, 0x91, 0x88,
+		0x7d, 0x78, 0x7b, 0x7c, 0x7f, 0x81, 0x7e, 0x78, 0x75, 0x70, 0x70, 0x77, 0x7e, 0x86, 0x90, 0x93,
+		0x92, 0x8e, 0x86, 0x7f, 0x7a, 0x77, 0x76, 0x79, 0x7c, 0x7e, 0x7f, 0x7f, 0x7e, 0x7e, 0x7f, 0x80,
+		0x83, 0x86, 0x88, 0x88, 0x87, 0x83, 0x80, 0x7d, 0x7a, 0x79, 0x7a, 0x7c, 0x7e, 0x80, 0x81, 0x81,
+		0x81, 0x81, 0x81, 0x81, 0x82, 0x82, 0x83, 0x82, 0x81, 0x80, 0x91, 0x9a, 0x92, 0x8d, 0x7f, 0x64,
+		0x59, 0x56, 0x56, 0x69, 0x80, 0x8e, 0x9d, 0xa4, 0x9b, 0x91, 0x88, 0x7d, 0x78, 0x7b, 0x7c, 0x7f,
+		0x81, 0x7e, 0x78, 0x75, 0x70, 0x70, 0x77, 0x7e, 0x86, 0x90, 0x93, 0x92, 0x8e, 0x86, 0x7f, 0x7a,
+		0x77, 0x76, 0x79, 0x7c, 0x7e, 0x7f, 0x7f, 0x7e, 0x7e, 0x7f, 0x80, 0x83, 0x86, 0x88, 0x88, 0x87,
+		0x83, 0x80, 0x7d, 0x7a, 0x79, 0x7a, 0x7c, 0x7e, 0x80, 0x81, 0x81, 0x81, 0x81, 0x81, 0x81, 0x82,
+		0x82, 0x83, 0x82, 0x81, 0x80, 0x7e, 0x90, 0x9a, 0x92, 0x8e, 0x80, 0x65, 0x5a, 0x57, 0x56, 0x68,
+		0x80, 0x8e, 0x9d, 0xa4, 0x9b, 0x91, 0x88, 0x7c, 0x78, 0x7b, 0x7c, 0x7f, 0x82, 0x7f, 0x79, 0x76,
+		0x71, 0x70, 0x76, 0x7e, 0x85, 0x8f, 0x93, 0x91, 0x8e, 0x86, 0x7f, 0x7a, 0x77, 0x76, 0x79, 0x7d,
+		0x7e, 0x80, 0x80, 0x7e, 0x7e, 0x7e, 0x80, 0x82, 0x86, 0x88, 0x88, 0x87, 0x83, 0x80, 0x7d, 0x7a,
+		0x79, 0x7a, 0x7c, 0x7f, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x81, 0x81, 0x82, 0x82, 0x82, 0x81,
+		0x80, 0x7e, 0x90, 0x9a, 0x92, 0x8e, 0x80, 0x65, 0x5a, 0x57, 0x56, 0x68, 0x80, 0x8e, 0x9c, 0xa4,
+		0x9b, 0x91, 0x88, 0x7c, 0x78, 0x7b, 0x7c, 0x7f, 0x82, 0x7f, 0x79, 0x76, 0x71, 0x70, 0x76, 0x7e,
+		0x85, 0x8f, 0x93, 0x91, 0x8e, 0x86, 0x7f, 0x7a, 0x77, 0x76, 0x79, 0x7d, 0x7e, 0x80, 0x80, 0x7e,
+		0x7e, 0x7e, 0x80, 0x82, 0x86, 0x88, 0x88, 0x87, 0x83, 0x80, 0x7d, 0x7a, 0x79, 0x7a, 0x7c, 0x7f,
+		0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x81, 0x81, 0x82, 0x82, 0x82, 0x81, 0x80, 0x7e, 0x7d, 0x90,
+		0x9a, 0x93, 0x90, 0x81, 0x67, 0x5a, 0x57, 0x56, 0x68, 0x80, 0x8d, 0x9c, 0xa4, 0x9b, 0x91, 0x88,
+		0x7c, 0x77, 0x7b, 0x7c, 0x7f, 0x83, 0x80, 0x7a, 0x76, 0x71, 0x70, 0x76, 0x7d, 0x85, 0x8f, 0x93,
+		0x91, 0x8e, 0x86, 0x7f, 0x7a, 0x77, 0x76, 0x7a, 0x7d, 0x7e, 0x80, 0x80, 0x7e, 0x7d, 0x7e, 0x7f,
+		0x82, 0x86, 0x87, 0x88, 0x87, 0x83, 0x80, 0x7d, 0x7a, 0x79, 0x7a, 0x7c, 0x7f, 0x80, 0x81, 0x81,
+		0x81, 0x81, 0x80, 0x81, 0x81, 0x82, 0x82, 0x82, 0x81, 0x80, 0x7f, 0x7d, 0x90, 0x9a, 0x93, 0x90,
+		0x81, 0x67, 0x5a, 0x57, 0x56, 0x68, 0x7f, 0x8d, 0x9c, 0xa4, 0x9b, 0x91, 0x88, 0x7c, 0x77, 0x7b,
+		0x7c, 0x7f, 0x83, 0x80, 0x7a, 0x76, 0x71, 0x70, 0x76, 0x7d, 0x85, 0x8f, 0x93, 0x91, 0x8e, 0x87,
+		0x7f, 0x7a, 0x77, 0x76, 0x7a, 0x7d, 0x7e, 0x80, 0x80, 0x7e, 0x7d, 0x7e, 0x7f, 0x82, 0x86, 0x87,
+		0x88, 0x87, 0x83, 0x80, 0x7d, 0x7a, 0x79, 0x7a, 0x7c, 0x7f, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80,
+		0x81, 0x81, 0x82, 0x82, 0x82, 0x81, 0x80, 0x7f, 0x7d, 0x7c, 0x90, 0x9b, 0x95, 0x92, 0x82, 0x67,
+		0x5b, 0x56, 0x55, 0x67, 0x7f, 0x8d, 0x9c, 0xa4, 0x9b, 0x91, 0x87, 0x7b, 0x77, 0x7b, 0x7c, 0x80,
+		0x83, 0x80, 0x7a, 0x76, 0x70, 0x6f, 0x76, 0x7d, 0x85, 0x8f, 0x93, 0x91, 0x8e, 0x86, 0x7e, 0x7a,
+		0x77, 0x77, 0x7b, 0x7e, 0x7f, 0x80, 0x7f, 0x7d, 0x7c, 0x7d, 0x7f, 0x82, 0x86, 0x88, 0x88, 0x87,
+		0x83, 0x7f, 0x7c, 0x7a, 0x7a, 0x7c, 0x7e, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x83,
+		0x83, 0x83, 0x82, 0x80, 0x7f, 0x7e, 0x7c, 0x7d, 0x7e, 0x93, 0xa1, 0x97, 0x8f, 0x7f, 0x61, 0x54,
+		0x56, 0x5b, 0x6e, 0x89, 0x96, 0x9a, 0x9c, 0x90, 0x82, 0x7f, 0x7d, 0x7e, 0x86, 0x8b, 0x87, 0x81,
+		0x7a, 0x6e, 0x69, 0x6d, 0x73, 0x7d, 0x89, 0x8f, 0x90, 0x8e, 0x87, 0x80, 0x7e, 0x7e, 0x7f, 0x81,
+		0x83, 0x81, 0x7e, 0x7a, 0x76, 0x75, 0x79, 0x7e, 0x82, 0x87, 0x89, 0x88, 0x85, 0x82, 0x80, 0x7f,
+		0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7d, 0x7c, 0x7b, 0x7c, 0x7f, 0x81, 0x83, 0x85, 0x84, 0x83, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x93, 0xa2, 0x95, 0x87, 0x7a, 0x5e, 0x51, 0x5c,
+		0x68, 0x77, 0x8e, 0x98, 0x91, 0x8c, 0x87, 0x7e, 0x7f, 0x88, 0x8c, 0x8d, 0x8d, 0x83, 0x75, 0x6d,
+		0x6b, 0x6b, 0x75, 0x80, 0x87, 0x89, 0x89, 0x85, 0x80, 0x80, 0x83, 0x85, 0x88, 0x89, 0x84, 0x7e,
+		0x79, 0x75, 0x74, 0x78, 0x7d, 0x80, 0x83, 0x84, 0x82, 0x80, 0x80, 0x81, 0x83, 0x85, 0x86, 0x84,
+		0x80, 0x7e, 0x7b, 0x7a, 0x7b, 0x7d, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x83, 0x83,
+		0x83, 0x81, 0x80, 0x7e, 0x7d, 0x7e, 0x7f, 0x96, 0xa8, 0x97, 0x81, 0x72, 0x5a, 0x4d, 0x5f, 0x76,
+		0x82, 0x90, 0x96, 0x89, 0x7c, 0x7e, 0x81, 0x86, 0x93, 0x9a, 0x90, 0x82, 0x78, 0x6c, 0x67, 0x6f,
+		0x7b, 0x80, 0x84, 0x85, 0x80, 0x7a, 0x7d, 0x82, 0x88, 0x8d, 0x90, 0x8a, 0x81, 0x7b, 0x77, 0x76,
+		0x7a, 0x7f, 0x80, 0x80, 0x7e, 0x7c, 0x7b, 0x7e, 0x82, 0x86, 0x88, 0x88, 0x85, 0x80, 0x7e, 0x7d,
+		0x7e, 0x7f, 0x80, 0x80, 0x7e, 0x7c, 0x7c, 0x7d, 0x7f, 0x82, 0x84, 0x84, 0x83, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x7d, 0x95, 0xaa, 0x98, 0x7c, 0x6c, 0x5b, 0x51, 0x63, 0x80,
+		0x8b, 0x8a, 0x8a, 0x81, 0x75, 0x7a, 0x8c, 0x95, 0x97, 0x96, 0x8a, 0x77, 0x6c, 0x70, 0x75, 0x7b,
+		0x82, 0x83, 0x7b, 0x75, 0x77, 0x7d, 0x83, 0x8c, 0x92, 0x8d, 0x85, 0x80, 0x7c, 0x7b, 0x7e, 0x82,
+		0x81, 0x7e, 0x7a, 0x78, 0x77, 0x7b, 0x81, 0x85, 0x86, 0x86, 0x83, 0x80, 0x80, 0x81, 0x83, 0x83,
+		0x82, 0x80, 0x7c, 0x7a, 0x7b, 0x7d, 0x7f, 0x81, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x83, 0x83,
+		0x82, 0x80, 0x7f, 0x7e, 0x7e, 0x7e, 0x80, 0x80, 0x98, 0xac, 0x97, 0x77, 0x68, 0x5c, 0x54, 0x67,
+		0x84, 0x8d, 0x86, 0x84, 0x7f, 0x75, 0x7c, 0x90, 0x9a, 0x97, 0x93, 0x87, 0x75, 0x6c, 0x72, 0x7a,
+		0x7d, 0x81, 0x80, 0x79, 0x73, 0x77, 0x7f, 0x85, 0x8d, 0x91, 0x8c, 0x83, 0x7f, 0x7d, 0x7d, 0x80,
+		0x82, 0x81, 0x7c, 0x78, 0x77, 0x77, 0x7c, 0x82, 0x86, 0x86, 0x84, 0x82, 0x80, 0x80, 0x82, 0x84,
+		0x83, 0x81, 0x7f, 0x7c, 0x7a, 0x7b, 0x7e, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81, 0x82, 0x83,
+		0x83, 0x82, 0x80, 0x7f, 0x7e, 0x7e, 0x7f, 0x80, 0x80, 0x98, 0xab, 0x97, 0x77, 0x68, 0x5c, 0x54,
+		0x67, 0x84, 0x8c, 0x86, 0x84, 0x7f, 0x75, 0x7c, 0x90, 0x9a, 0x97, 0x92, 0x87, 0x75, 0x6c, 0x72,
+		0x7a, 0x7d, 0x80, 0x80, 0x79, 0x73, 0x77, 0x7f, 0x85, 0x8d, 0x91, 0x8c, 0x83, 0x7f, 0x7d, 0x7d,
+		0x80, 0x82, 0x81, 0x7c, 0x79, 0x77, 0x77, 0x7c, 0x82, 0x86, 0x86, 0x84, 0x82, 0x80, 0x80, 0x82,
+		0x84, 0x83, 0x81, 0x7f, 0x7c, 0x7a, 0x7b, 0x7e, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81, 0x82,
+		0x83, 0x83, 0x82, 0x80, 0x7f, 0x7e, 0x7e, 0x7f, 0x80, 0x80, 0x80, 0x97, 0xab, 0x97, 0x78, 0x69,
+		0x5d, 0x54, 0x66, 0x83, 0x8c, 0x86, 0x84, 0x7f, 0x75, 0x7c, 0x8f, 0x99, 0x97, 0x93, 0x88, 0x76,
+		0x6c, 0x72, 0x79, 0x7d, 0x80, 0x80, 0x79, 0x73, 0x77, 0x7f, 0x85, 0x8d, 0x91, 0x8c, 0x83, 0x7f,
+		0x7e, 0x7d, 0x80, 0x82, 0x81, 0x7c, 0x79, 0x77, 0x77, 0x7c, 0x81, 0x86, 0x86, 0x84, 0x83, 0x80,
+		0x80, 0x82, 0x84, 0x83, 0x81, 0x80, 0x7c, 0x7a, 0x7b, 0x7d, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80,
+		0x81, 0x82, 0x83, 0x83, 0x82, 0x80, 0x7f, 0x7e, 0x7e, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x97, 0xac,
+		0x98, 0x7a, 0x6a, 0x5d, 0x53, 0x65, 0x82, 0x8b, 0x86, 0x84, 0x7f, 0x75, 0x7b, 0x8f, 0x99, 0x97,
+		0x94, 0x88, 0x76, 0x6d, 0x72, 0x79, 0x7d, 0x80, 0x80, 0x79, 0x73, 0x77, 0x7e, 0x84, 0x8d, 0x91,
+		0x8c, 0x83, 0x80, 0x7e, 0x7d, 0x80, 0x82, 0x81, 0x7d, 0x79, 0x77, 0x77, 0x7b, 0x81, 0x85, 0x86,
+		0x85, 0x83, 0x80, 0x80, 0x82, 0x84, 0x83, 0x81, 0x80, 0x7c, 0x7a, 0x7b, 0x7d, 0x7f, 0x80, 0x81,
+		0x80, 0x80, 0x80, 0x81, 0x82, 0x83, 0x83, 0x82, 0x80, 0x7f, 0x7e, 0x7e, 0x7f, 0x80, 0x80, 0x80,
+		0x7f, 0x97, 0xac, 0x98, 0x7a, 0x6a, 0x5d, 0x53, 0x65, 0x82, 0x8b, 0x86, 0x84, 0x7f, 0x75, 0x7b,
+		0x8f, 0x99, 0x97, 0x93, 0x88, 0x77, 0x6e, 0x73, 0x7a, 0x7d, 0x80, 0x80, 0x7a, 0x75, 0x78, 0x7f,
+		0x83, 0x8a, 0x8d, 0x89, 0x82, 0x80, 0x7e, 0x7e, 0x80, 0x81, 0x80, 0x7e, 0x7b, 0x7b, 0x7b, 0x7d,
+		0x80, 0x83, 0x83, 0x82, 0x81, 0x80, 0x80, 0x81, 0x82, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7e, 0x7f,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x85, 0x82, 0x80, 0x7f, 0x7e, 0x7e, 0x7f, 0x80, 0x80, 0x80,
+	},
+	{ // 1
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x84, 0x84,
+		0x84, 0x84, 0x83, 0x80, 0x7e, 0x7c, 0x7a, 0x78, 0x79, 0x7a, 0x7c, 0x7e, 0x80, 0x82, 0x83, 0x83,
+		0x83, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x82, 0x82, 0x81, 0x80, 0x80, 0x7f,
+		0x7d, 0x7d, 0x7d, 0x7d, 0x7e, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x83, 0x84, 0x84, 0x84,
+		0x83, 0x80, 0x7d, 0x7b, 0x79, 0x77, 0x78, 0x7a, 0x7c, 0x7e, 0x80, 0x82, 0x83, 0x84, 0x84, 0x83,
+		0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x82, 0x82, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7d,
+		0x7d, 0x7e, 0x7e, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x85, 0x83,
+		0x80, 0x7e, 0x7c, 0x79, 0x78, 0x78, 0x7a, 0x7c, 0x7e, 0x80, 0x82, 0x83, 0x84, 0x84, 0x83, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x82, 0x82, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7d, 0x7d,
+		0x7e, 0x7e, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x85, 0x83, 0x80,
+		0x7e, 0x7c, 0x79, 0x77, 0x78, 0x7a, 0x7c, 0x7e, 0x80, 0x82, 0x83, 0x84, 0x84, 0x83, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x82, 0x82, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7d, 0x7d, 0x7e,
+		0x7e, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x83, 0x84, 0x85, 0x85, 0x83, 0x80, 0x7e,
+		0x7c, 0x79, 0x77, 0x78, 0x7a, 0x7c, 0x7e, 0x80, 0x82, 0x83, 0x84, 0x84, 0x83, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x81, 0x82, 0x82, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7d, 0x7d, 0x7e, 0x7e,
+		0x7f, 0x80, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x84, 0x85, 0x85, 0x85, 0x84, 0x80, 0x7e,
+		0x7b, 0x79, 0x77, 0x78, 0x7a, 0x7c, 0x7e, 0x80, 0x82, 0x83, 0x84, 0x84, 0x82, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x82, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7d, 0x7d, 0x7e, 0x7e,
+		0x7f, 0x80, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x84, 0x85, 0x85, 0x85, 0x84, 0x80, 0x7e,
+		0x7b, 0x79, 0x77, 0x78, 0x7a, 0x7c, 0x7e, 0x80, 0x82, 0x83, 0x84, 0x84, 0x82, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x82, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7d, 0x7d, 0x7e, 0x7e,
+		0x7f, 0x80, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x7f, 0x81, 0x84, 0x85, 0x85, 0x85, 0x84, 0x80,
+		0x7e, 0x7b, 0x79, 0x77, 0x78, 0x7a, 0x7c, 0x7e, 0x80, 0x82, 0x83, 0x83, 0x83, 0x82, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x82, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7d, 0x7d, 0x7e,
+		0x7e, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x7f, 0x81, 0x85, 0x86, 0x86, 0x86, 0x84,
+		0x80, 0x7c, 0x7a, 0x78, 0x76, 0x77, 0x7a, 0x7d, 0x80, 0x82, 0x84, 0x84, 0x84, 0x83, 0x82, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x82, 0x82, 0x82, 0x82, 0x81, 0x80, 0x7e, 0x7d, 0x7c, 0x7c, 0x7d, 0x7e,
+		0x80, 0x80, 0x81, 0x82, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x80,
+		0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x85, 0x8a, 0x8a, 0x88,
+		0x86, 0x80, 0x79, 0x74, 0x73, 0x72, 0x73, 0x79, 0x7f, 0x82, 0x85, 0x87, 0x86, 0x83, 0x82, 0x81,
+		0x80, 0x80, 0x82, 0x84, 0x84, 0x83, 0x82, 0x80, 0x7d, 0x7b, 0x7a, 0x79, 0x7b, 0x7d, 0x80, 0x81,
+		0x83, 0x83, 0x83, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x7f, 0x7e, 0x7d,
+		0x7d, 0x7e, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x88, 0x90,
+		0x8e, 0x88, 0x82, 0x78, 0x6c, 0x68, 0x6b, 0x70, 0x78, 0x83, 0x8b, 0x8c, 0x8b, 0x89, 0x84, 0x80,
+		0x80, 0x81, 0x83, 0x85, 0x86, 0x83, 0x7f, 0x7a, 0x77, 0x74, 0x75, 0x7a, 0x7f, 0x82, 0x86, 0x88,
+		0x86, 0x84, 0x82, 0x80, 0x80, 0x80, 0x81, 0x81, 0x81, 0x80, 0x7e, 0x7b, 0x7a, 0x7b, 0x7d, 0x7f,
+		0x81, 0x83, 0x84, 0x84, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x7e, 0x7e,
+		0x7e, 0x8c, 0x99, 0x94, 0x88, 0x7f, 0x6e, 0x5f, 0x60, 0x6d, 0x78, 0x84, 0x92, 0x94, 0x8c, 0x86,
+		0x82, 0x7d, 0x7e, 0x84, 0x88, 0x87, 0x84, 0x7f, 0x75, 0x6f, 0x71, 0x76, 0x7c, 0x84, 0x8b, 0x8b,
+		0x88, 0x84, 0x80, 0x7e, 0x7f, 0x81, 0x82, 0x82, 0x80, 0x7d, 0x79, 0x78, 0x7a, 0x7d, 0x80, 0x85,
+		0x87, 0x86, 0x84, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7d, 0x7c, 0x7d, 0x7f, 0x80,
+		0x83, 0x84, 0x83, 0x82, 0x95, 0xa4, 0x93, 0x7c, 0x6d, 0x59, 0x4d, 0x5e, 0x79, 0x88, 0x95, 0x9e,
+		0x95, 0x84, 0x81, 0x82, 0x82, 0x87, 0x8c, 0x84, 0x76, 0x6e, 0x69, 0x69, 0x74, 0x84, 0x8d, 0x91,
+		0x91, 0x8a, 0x81, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7d, 0x76, 0x73, 0x76, 0x7a, 0x81, 0x89, 0x8c,
+		0x8a, 0x86, 0x81, 0x7e, 0x7d, 0x7f, 0x80, 0x7f, 0x7e, 0x7b, 0x7a, 0x7b, 0x80, 0x83, 0x86, 0x87,
+		0x86, 0x82, 0x80, 0x7e, 0x7d, 0x7e, 0x7f, 0x7f, 0x9d, 0xb0, 0x90, 0x6b, 0x59, 0x48, 0x49, 0x71,
+		0x98, 0xa1, 0xa0, 0x9a, 0x82, 0x74, 0x7f, 0x8a, 0x8a, 0x88, 0x80, 0x69, 0x5c, 0x67, 0x78, 0x87,
+		0x98, 0x9d, 0x8f, 0x81, 0x7d, 0x7a, 0x7c, 0x83, 0x84, 0x7b, 0x73, 0x71, 0x73, 0x7c, 0x8a, 0x91,
+		0x8f, 0x89, 0x81, 0x7a, 0x79, 0x7d, 0x80, 0x7f, 0x7e, 0x7a, 0x78, 0x7c, 0x83, 0x88, 0x8a, 0x89,
+		0x83, 0x7d, 0x7b, 0x7b, 0x7d, 0x7f, 0x80, 0x7f, 0x7d, 0x7f, 0x80, 0x84, 0xb5, 0xca, 0x8b, 0x4d,
+		0x3a, 0x2e, 0x46, 0x8e, 0xbb, 0xae, 0x9b, 0x8c, 0x74, 0x78, 0x96, 0x9a, 0x80, 0x6b, 0x58, 0x4b,
+		0x63, 0x8e, 0xa4, 0xa4, 0x9c, 0x87, 0x73, 0x77, 0x84, 0x84, 0x7e, 0x75, 0x67, 0x65, 0x78, 0x8e,
+		0x99, 0x9a, 0x91, 0x7f, 0x74, 0x78, 0x7d, 0x7e, 0x7e, 0x79, 0x73, 0x77, 0x83, 0x8d, 0x91, 0x8f,
+		0x84, 0x79, 0x76, 0x79, 0x7c, 0x7f, 0x80, 0x7d, 0x7c, 0x80, 0x86, 0x8a, 0x8a, 0x84, 0x7d, 0x78,
+		0x79, 0xbe, 0xd4, 0x75, 0x27, 0x24, 0x2e, 0x65, 0xc5, 0xdd, 0xa6, 0x83, 0x79, 0x6c, 0x83, 0xa4,
+		0x88, 0x56, 0x49, 0x51, 0x6a, 0x9e, 0xc2, 0xad, 0x8a, 0x79, 0x6c, 0x70, 0x86, 0x89, 0x72, 0x64,
+		0x68, 0x73, 0x8c, 0xa7, 0xa5, 0x8d, 0x7b, 0x70, 0x6d, 0x79, 0x83, 0x7f, 0x76, 0x75, 0x7a, 0x84,
+		0x94, 0x98, 0x8c, 0x7e, 0x74, 0x70, 0x75, 0x80, 0x82, 0x7f, 0x7e, 0x7f, 0x82, 0x89, 0x8e, 0x89,
+		0x80, 0x78, 0x75, 0x77, 0x7e, 0x82, 0x82, 0xc4, 0xd6, 0x75, 0x2a, 0x2b, 0x33, 0x62, 0xba, 0xcf,
+		0x9c, 0x82, 0x80, 0x76, 0x8a, 0xa7, 0x88, 0x55, 0x4a, 0x53, 0x68, 0x99, 0xba, 0xa6, 0x89, 0x7e,
+		0x74, 0x76, 0x88, 0x88, 0x70, 0x63, 0x67, 0x72, 0x8a, 0xa3, 0xa1, 0x8c, 0x7e, 0x75, 0x71, 0x7b,
+		0x83, 0x7d, 0x73, 0x74, 0x7a, 0x84, 0x93, 0x97, 0x8c, 0x7f, 0x77, 0x73, 0x77, 0x80, 0x80, 0x7d,
+		0x7c, 0x7e, 0x82, 0x89, 0x8d, 0x89, 0x80, 0x7a, 0x76, 0x78, 0x7e, 0x81, 0xc4, 0xd5, 0x74, 0x28,
+		0x28, 0x35, 0x67, 0xc0, 0xd3, 0x9c, 0x7f, 0x7b, 0x74, 0x8a, 0xa7, 0x88, 0x55, 0x49, 0x53, 0x6b,
+		0x9c, 0xbc, 0xa7, 0x87, 0x7a, 0x71, 0x75, 0x89, 0x89, 0x71, 0x63, 0x67, 0x73, 0x8b, 0xa5, 0xa2,
+		0x8b, 0x7c, 0x73, 0x71, 0x7c, 0x84, 0x7e, 0x74, 0x74, 0x7a, 0x84, 0x93, 0x97, 0x8b, 0x7e, 0x76,
+		0x73, 0x77, 0x80, 0x81, 0x7e, 0x7c, 0x7e, 0x82, 0x89, 0x8d, 0x88, 0x80, 0x79, 0x76, 0x78, 0x7e,
+		0x82, 0x81, 0x80, 0xc3, 0xd6, 0x77, 0x2d, 0x2a, 0x2e, 0x5d, 0xb8, 0xd1, 0xa0, 0x87, 0x80, 0x75,
+		0x88, 0xa6, 0x88, 0x57, 0x4a, 0x51, 0x65, 0x98, 0xbb, 0xa9, 0x8c, 0x80, 0x73, 0x74, 0x87, 0x88,
+		0x70, 0x63, 0x67, 0x71, 0x89, 0xa3, 0xa3, 0x8e, 0x7f, 0x75, 0x70, 0x7a, 0x82, 0x7d, 0x74, 0x74,
+		0x79, 0x83, 0x93, 0x98, 0x8d, 0x80, 0x77, 0x72, 0x76, 0x7f, 0x80, 0x7e, 0x7c, 0x7e, 0x81, 0x89,
+		0x8e, 0x89, 0x80, 0x7a, 0x76, 0x77, 0x7d, 0x81, 0x81, 0x80, 0xc3, 0xd7, 0x78, 0x2d, 0x2a, 0x2f,
+		0x5d, 0xb8, 0xd0, 0x9f, 0x86, 0x80, 0x75, 0x89, 0xa6, 0x88, 0x57, 0x4a, 0x51, 0x65, 0x98, 0xba,
+		0xa8, 0x8c, 0x7f, 0x73, 0x75, 0x87, 0x88, 0x70, 0x63, 0x67, 0x71, 0x89, 0xa3, 0xa2, 0x8e, 0x7f,
+		0x75, 0x71, 0x7a, 0x82, 0x7d, 0x74, 0x74, 0x79, 0x83, 0x93, 0x98, 0x8d, 0x80, 0x77, 0x73, 0x76,
+		0x7f, 0x80, 0x7e, 0x7c, 0x7e, 0x81, 0x89, 0x8e, 0x89, 0x80, 0x7a, 0x76, 0x77, 0x7d, 0x81, 0x81,
+		0x80, 0x80, 0xc4, 0xd9, 0x7a, 0x2c, 0x25, 0x2a, 0x5a, 0xb8, 0xd4, 0xa4, 0x88, 0x80, 0x74, 0x88,
+		0xa7, 0x8a, 0x57, 0x48, 0x4e, 0x64, 0x97, 0xbc, 0xac, 0x8e, 0x7f, 0x73, 0x74, 0x87, 0x88, 0x71,
+		0x62, 0x65, 0x70, 0x88, 0xa4, 0xa4, 0x90, 0x80, 0x74, 0x70, 0x7a, 0x82, 0x7d, 0x74, 0x74, 0x79,
+		0x83, 0x93, 0x99, 0x8e, 0x80, 0x77, 0x72, 0x76, 0x7f, 0x80, 0x7e, 0x7c, 0x7e, 0x81, 0x89, 0x8e,
+		0x8a, 0x80, 0x7a, 0x76, 0x77, 0x7d, 0x81, 0x81, 0x80, 0x80, 0xc4, 0xd9, 0x7b, 0x2c, 0x25, 0x2a,
+		0x5a, 0xb8, 0xd3, 0xa4, 0x88, 0x80, 0x74, 0x89, 0xa7, 0x8a, 0x57, 0x48, 0x4e, 0x63, 0x97, 0xbc,
+		0xac, 0x8e, 0x80, 0x73, 0x74, 0x87, 0x88, 0x71, 0x62, 0x65, 0x70, 0x88, 0xa4, 0xa4, 0x90, 0x80,
+		0x74, 0x70, 0x7a, 0x82, 0x7d, 0x74, 0x74, 0x79, 0x83, 0x93, 0x99, 0x8e, 0x80, 0x77, 0x72, 0x76,
+		0x7f, 0x80, 0x7e, 0x7c, 0x7e, 0x81, 0x89, 0x8e, 0x8a, 0x80, 0x7a, 0x76, 0x77, 0x7d, 0x81, 0x81,
+		0x80, 0x80, 0x81, 0x84, 0xca, 0xdb, 0x74, 0x22, 0x1d, 0x27, 0x5e, 0xc0, 0xda, 0xa5, 0x87, 0x80,
+		0x74, 0x8a, 0xa8, 0x87, 0x51, 0x43, 0x4c, 0x65, 0x9c, 0xc2, 0xae, 0x8d, 0x7e, 0x71, 0x74, 0x87,
+		0x87, 0x6e, 0x60, 0x64, 0x70, 0x8b, 0xa8, 0xa7, 0x90, 0x7e, 0x73, 0x6f, 0x79, 0x82, 0x7c, 0x73,
+		0x73, 0x79, 0x85, 0x95, 0x9a, 0x8e, 0x80, 0x75, 0x71, 0x75, 0x7f, 0x80, 0x7d, 0x7c, 0x7e, 0x82,
+		0x8a, 0x8f, 0x8a, 0x80, 0x79, 0x75, 0x76, 0x7d, 0x81, 0x81, 0x80, 0x80, 0x81, 0xc8, 0xdd, 0x7a,
+		0x27, 0x1f, 0x26, 0x5a, 0xbc, 0xd8, 0xa6, 0x88, 0x80, 0x74, 0x89, 0xa8, 0x8a, 0x54, 0x44, 0x4b,
+		0x63, 0x99, 0xc0, 0xae, 0x8e, 0x7f, 0x72, 0x73, 0x87, 0x88, 0x70, 0x60, 0x64, 0x6f, 0x89, 0xa6,
+		0xa6, 0x90, 0x7f, 0x73, 0x6f, 0x79, 0x82, 0x7d, 0x73, 0x73, 0x78, 0x83, 0x94, 0x9a, 0x8e, 0x80,
+		0x76, 0x71, 0x75, 0x7e, 0x80, 0x7e, 0x7c, 0x7e, 0x82, 0x8a, 0x8f, 0x8a, 0x80, 0x79, 0x75, 0x76,
+		0x7d, 0x81, 0x81, 0x80, 0x80, 0x81, 0x84, 0x87, 0xc9, 0xd6, 0x6f, 0x1e, 0x1c, 0x2a, 0x63, 0xc2,
+		0xda, 0xa5, 0x86, 0x80, 0x76, 0x8a, 0xa5, 0x84, 0x4f, 0x42, 0x4e, 0x69, 0x9f, 0xc2, 0xad, 0x8c,
+		0x7d, 0x71, 0x74, 0x86, 0x86, 0x6d, 0x5f, 0x65, 0x72, 0x8d, 0xa9, 0xa6, 0x8f, 0x7d, 0x72, 0x6f,
+		0x79, 0x81, 0x7c, 0x72, 0x73, 0x7a, 0x86, 0x96, 0x9a, 0x8d, 0x7f, 0x75, 0x71, 0x75, 0x7f, 0x80,
+		0x7d, 0x7c, 0x7f, 0x83, 0x8b, 0x8f, 0x89, 0x80, 0x78, 0x74, 0x76, 0x7d, 0x81, 0x81, 0x80, 0x80,
+		0x82, 0x85, 0x87, 0xc8, 0xd4, 0x6e, 0x20, 0x1f, 0x2d, 0x64, 0xc0, 0xd3, 0x9e, 0x82, 0x80, 0x7c,
+		0x93, 0xac, 0x86, 0x4e, 0x41, 0x4c, 0x67, 0x9a, 0xb8, 0xa1, 0x85, 0x80, 0x7e, 0x85, 0x96, 0x8b,
+		0x69, 0x58, 0x5d, 0x6c, 0x87, 0x9f, 0x99, 0x86, 0x80, 0x80, 0x84, 0x8d, 0x8a, 0x77, 0x68, 0x68,
+		0x71, 0x80, 0x8e, 0x8e, 0x84, 0x80, 0x82, 0x86, 0x8a, 0x89, 0x7f, 0x73, 0x71, 0x75, 0x7d, 0x84,
+		0x86, 0x80, 0x7f, 0x81, 0x85, 0x89, 0x88, 0x82, 0x7a, 0x78, 0x7a, 0x7e, 0x80, 0x80, 0x7e, 0xa9,
+		0xb7, 0x7d, 0x55, 0x59, 0x59, 0x6f, 0x98, 0x93, 0x72, 0x72, 0x81, 0x89, 0x9f, 0xa9, 0x8a, 0x6d,
+		0x6f, 0x74, 0x7a, 0x87, 0x81, 0x6c, 0x6a, 0x7a, 0x85, 0x91, 0x97, 0x88, 0x7a, 0x7e, 0x84, 0x86,
+		0x87, 0x80, 0x71, 0x6e, 0x79, 0x80, 0x84, 0x85, 0x80, 0x7b, 0x80, 0x88, 0x8a, 0x88, 0x82, 0x7b,
+		0x79, 0x7f, 0x81, 0x80, 0x7e, 0x7a, 0x79, 0x7e, 0x84, 0x85, 0x83, 0x81, 0x80, 0x80, 0x84, 0x84,
+		0x81, 0x7f, 0x7c, 0x7c, 0x7f, 0x80, 0x80, 0x7e, 0x7e, 0x7f, 0x80, 0x83, 0x9f, 0xa2, 0x7c, 0x67,
+		0x6f, 0x71, 0x7d, 0x8b, 0x7d, 0x67, 0x6f, 0x7f, 0x87, 0x93, 0x91, 0x7e, 0x7a, 0x86, 0x8b, 0x8b,
+		0x89, 0x7c, 0x70, 0x77, 0x80, 0x80, 0x7f, 0x7b, 0x74, 0x78, 0x84, 0x88, 0x85, 0x82, 0x7f, 0x7f,
+		0x85, 0x89, 0x85, 0x80, 0x7d, 0x7b, 0x7f, 0x82, 0x80, 0x7c, 0x7a, 0x7b, 0x7e, 0x82, 0x83, 0x80,
+		0x7f, 0x80, 0x82, 0x84, 0x84, 0x81, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x80, 0x7d, 0x7d, 0x7f, 0x80,
+		0x80, 0x80, 0x7e, 0x7f, 0x80, 0x81, 0x81, 0x80, 0x80, 0x8e, 0x91, 0x80, 0x77, 0x7c, 0x7c, 0x80,
+		0x85, 0x7c, 0x72, 0x78, 0x7e, 0x80, 0x83, 0x80, 0x79, 0x7d, 0x84, 0x86, 0x85, 0x84, 0x7f, 0x7f,
+		0x84, 0x86, 0x82, 0x80, 0x7e, 0x7d, 0x80, 0x82, 0x80, 0x7c, 0x7c, 0x7d, 0x7f, 0x81, 0x80, 0x7e,
+		0x7e, 0x80, 0x81, 0x82, 0x82, 0x80, 0x80, 0x81, 0x82, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x80,
+		0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x86, 0x86, 0x7f, 0x7d, 0x80, 0x80, 0x81, 0x81, 0x7d,
+		0x7b, 0x7f, 0x80, 0x80, 0x80, 0x7e, 0x7c, 0x7f, 0x81, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x82, 0x81,
+		0x80, 0x80, 0x80, 0x81, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x81, 0x80, 0x7f, 0x7f, 0x80, 0x80,
+		0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x85, 0x85, 0x7f, 0x7d, 0x80, 0x80, 0x81, 0x82, 0x7e, 0x7c,
+		0x7f, 0x80, 0x80, 0x80, 0x7e, 0x7d, 0x80, 0x81, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x82, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80,
+		0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x85, 0x85, 0x7f, 0x7d, 0x80, 0x80, 0x81, 0x82, 0x7e, 0x7c,
+		0x7f, 0x80, 0x80, 0x80, 0x7e, 0x7d, 0x80, 0x81, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x82, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80,
+		0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x85, 0x85, 0x7f, 0x7d, 0x80, 0x80, 0x81, 0x82, 0x7e,
+		0x7c, 0x7f, 0x80, 0x80, 0x80, 0x7e, 0x7d, 0x80, 0x81, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x82, 0x81,
+		0x80, 0x80, 0x80, 0x81, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x85, 0x85, 0x7f, 0x7e, 0x80, 0x80, 0x81,
+		0x82, 0x7e, 0x7c, 0x7f, 0x80, 0x80, 0x80, 0x7e, 0x7d, 0x80, 0x81, 0x80, 0x80, 0x7f, 0x7f, 0x80,
+		0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x85, 0x85, 0x7f, 0x7e,
+		0x80, 0x80, 0x81, 0x82, 0x7e, 0x7c, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7d, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	},
+	{ // 2
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x80,
+		0x7e, 0x82, 0x7f, 0x80, 0x81, 0x7a, 0x88, 0x77, 0x88, 0x78, 0x89, 0x79, 0x80, 0x88, 0x70, 0x98,
+		0x64, 0x9a, 0x71, 0x86, 0x7f, 0x80, 0x80, 0x83, 0x75, 0x97, 0x60, 0xa7, 0x5a, 0x9b, 0x72, 0x7d,
+		0x92, 0x67, 0x97, 0x6d, 0x93, 0x67, 0x9e, 0x6e, 0x83, 0x81, 0x80, 0x79, 0x95, 0x65, 0x9b, 0x69,
+		0x93, 0x67, 0xa1, 0x68, 0x84, 0x89, 0x6f, 0x9d, 0x62, 0x87, 0x95, 0x64, 0x8f, 0x85, 0x5f, 0xad,
+		0x5e, 0x8a, 0x87, 0x7f, 0x6f, 0xa2, 0x69, 0x7d, 0x98, 0x57, 0xb7, 0x37, 0xd7, 0x26, 0xd4, 0x3f,
+		0xa3, 0x6c, 0x9b, 0x68, 0x8d, 0x7f, 0x82, 0x74, 0x89, 0x78, 0x88, 0x84, 0x7e, 0x75, 0x84, 0x8e,
+		0x5b, 0xac, 0x57, 0xac, 0x4d, 0xb5, 0x60, 0x80, 0x9d, 0x5b, 0x98, 0x7a, 0x6e, 0x9f, 0x76, 0x62,
+		0xb0, 0x50, 0xa8, 0x6f, 0x90, 0x5d, 0xa2, 0x75, 0x7b, 0x8f, 0x6d, 0x87, 0x77, 0x88, 0x84, 0x70,
+		0x9a, 0x69, 0x99, 0x6b, 0x79, 0x92, 0x6e, 0xa3, 0x62, 0x94, 0x70, 0x95, 0x6e, 0x70, 0xb7, 0x3f,
+		0xa6, 0x85, 0x4d, 0xd6, 0x21, 0xc1, 0x6f, 0x79, 0x98, 0x49, 0xd2, 0x31, 0xa7, 0x7a, 0x6e, 0xb2,
+		0x35, 0xd1, 0x41, 0xa8, 0x70, 0x74, 0xa3, 0x52, 0xb1, 0x4b, 0xba, 0x4f, 0x9b, 0x6e, 0x8e, 0x8b,
+		0x56, 0xc1, 0x2d, 0xd7, 0x3b, 0xad, 0x5f, 0x8c, 0x92, 0x59, 0xb7, 0x44, 0xac, 0x70, 0x85, 0x81,
+		0x6d, 0x97, 0x7f, 0x6a, 0x9b, 0x5d, 0xb7, 0x4a, 0xab, 0x66, 0x84, 0x7a, 0x9e, 0x43, 0xc4, 0x45,
+		0xb5, 0x58, 0x91, 0x7b, 0x83, 0x8f, 0x54, 0xcb, 0x21, 0xe4, 0x23, 0xc1, 0x6f, 0x6f, 0x9d, 0x74,
+		0x75, 0x9c, 0x48, 0xd2, 0x37, 0x9d, 0x85, 0x6a, 0xa7, 0x4e, 0xbf, 0x47, 0x99, 0x8e, 0x5d, 0xa0,
+		0x65, 0x80, 0x91, 0x6e, 0x85, 0xa0, 0x3d, 0xc4, 0x48, 0xaa, 0x62, 0x9e, 0x6d, 0x7b, 0x97, 0x6d,
+		0x88, 0x77, 0x95, 0x6e, 0x79, 0xab, 0x3e, 0xbe, 0x51, 0x9e, 0x7d, 0x77, 0x86, 0x6e, 0xa3, 0x54,
+		0xb6, 0x66, 0x8e, 0x6e, 0x76, 0x9f, 0x56, 0xa6, 0x76, 0x6d, 0x9c, 0x57, 0xb7, 0x40, 0xaa, 0x71,
+		0x8f, 0x6e, 0xa2, 0x57, 0xad, 0x5b, 0x96, 0x75, 0x89, 0x75, 0x8c, 0x75, 0x8f, 0x58, 0xb3, 0x45,
+		0xc2, 0x36, 0xca, 0x4b, 0x98, 0x70, 0x9b, 0x68, 0x82, 0xa1, 0x3e, 0xdd, 0x24, 0xb3, 0x7f, 0x69,
+		0x8e, 0x8a, 0x57, 0xab, 0x71, 0x74, 0x8f, 0x7b, 0x87, 0x71, 0x93, 0x74, 0x82, 0x8c, 0x62, 0xb3,
+		0x49, 0x9a, 0x88, 0x7f, 0x94, 0x74, 0x6d, 0x9c, 0x53, 0xa8, 0x62, 0x9c, 0x3b, 0xbe, 0x60, 0x8c,
+		0x7d, 0x84, 0x6b, 0x94, 0x94, 0x60, 0x9f, 0x66, 0x96, 0x7d, 0x8d, 0x74, 0x7e, 0x84, 0x6d, 0x9e,
+		0x55, 0xb0, 0x52, 0x86, 0x9f, 0x4d, 0xb1, 0x59, 0xad, 0x4a, 0xb8, 0x69, 0x82, 0x84, 0x81, 0x70,
+		0x9d, 0x68, 0x7d, 0x9c, 0x4f, 0xc0, 0x40, 0xad, 0x5e, 0x9d, 0x5c, 0xa4, 0x5e, 0x9a, 0x7e, 0x70,
+		0x9b, 0x6c, 0x88, 0x89, 0x5d, 0xab, 0x6b, 0x8d, 0xb1, 0x45, 0xa9, 0x51, 0x87, 0x8f, 0x68, 0x9b,
+		0x40, 0x9c, 0x71, 0x8f, 0x7e, 0x8c, 0x64, 0x98, 0x71, 0x9b, 0x86, 0x71, 0x8c, 0x75, 0x95, 0x7a,
+		0x7d, 0x8d, 0x5b, 0x9f, 0x61, 0x9e, 0x58, 0xa5, 0x4a, 0xad, 0x65, 0x9b, 0x72, 0x7d, 0x8d, 0x7b,
+		0x92, 0x73, 0x8a, 0x7f, 0x80, 0x79, 0x8e, 0x70, 0x93, 0x67, 0x89, 0x7a, 0x81, 0x8d, 0x5e, 0xa9,
+		0x58, 0x9d, 0x72, 0x87, 0x78, 0x8b, 0x80, 0x7d, 0x8c, 0x70, 0x90, 0x82, 0xa9, 0x7b, 0x69, 0x81,
+		0x6d, 0x7c, 0xa0, 0x5b, 0x81, 0x64, 0x81, 0x70, 0xa1, 0x6b, 0x90, 0x75, 0x83, 0x8b, 0x94, 0x70,
+		0xb1, 0x47, 0xa8, 0x6d, 0x92, 0x7c, 0x7a, 0x7b, 0x74, 0x87, 0x6c, 0xa4, 0x53, 0xa3, 0x5c, 0x8d,
+		0x80, 0x88, 0x83, 0x7a, 0x8f, 0x6a, 0xa0, 0x72, 0x89, 0x7c, 0x85, 0x73, 0x8d, 0x70, 0x89, 0x78,
+		0x8b, 0x5f, 0xac, 0x47, 0xbd, 0x50, 0xa0, 0x5e, 0xa5, 0x64, 0x92, 0x7f, 0x80, 0x8d, 0x6a, 0x97,
+		0x8d, 0xa3, 0x88, 0x68, 0x75, 0x73, 0x78, 0x81, 0x89, 0x76, 0x54, 0x89, 0x6b, 0x7e, 0xa6, 0x73,
+		0x7b, 0x8c, 0x71, 0x9b, 0x84, 0x8d, 0x95, 0x60, 0x95, 0x79, 0x76, 0x9d, 0x73, 0x7d, 0x6e, 0x77,
+		0x8b, 0x66, 0x9e, 0x69, 0x87, 0x6d, 0x8d, 0x7b, 0x92, 0x75, 0x99, 0x6e, 0x8b, 0x7e, 0x7f, 0x95,
+		0x6a, 0x96, 0x6e, 0x86, 0x6f, 0x96, 0x6a, 0x8d, 0x75, 0x7e, 0x7b, 0x84, 0x79, 0x93, 0x6a, 0x94,
+		0x6c, 0x92, 0x7a, 0x85, 0x88, 0x78, 0x9e, 0xa0, 0x92, 0x63, 0x86, 0x5b, 0x7d, 0x70, 0x99, 0x66,
+		0x86, 0x4e, 0x8c, 0x62, 0x93, 0x87, 0x86, 0x85, 0x7b, 0x88, 0x7c, 0x9d, 0x85, 0x91, 0x82, 0x6f,
+		0x8c, 0x72, 0x90, 0x75, 0x82, 0x7b, 0x72, 0x7d, 0x6e, 0x8b, 0x76, 0x8f, 0x6b, 0x90, 0x6c, 0x95,
+		0x75, 0x91, 0x87, 0x7d, 0x89, 0x7d, 0x82, 0x80, 0x85, 0x7c, 0x8c, 0x6d, 0x83, 0x78, 0x80, 0x7d,
+		0x83, 0x7e, 0x7a, 0x83, 0x77, 0x8d, 0x6f, 0x96, 0x74, 0x8a, 0x79, 0x87, 0x9d, 0x9e, 0xa3, 0x6b,
+		0x87, 0x5c, 0x6a, 0x78, 0x80, 0x8b, 0x6d, 0x77, 0x68, 0x6b, 0x77, 0x8a, 0x85, 0x96, 0x83, 0x80,
+		0x7f, 0x7e, 0x94, 0x89, 0x8d, 0x92, 0x7e, 0x77, 0x7b, 0x7e, 0x7e, 0x80, 0x87, 0x70, 0x7d, 0x73,
+		0x79, 0x7d, 0x78, 0x91, 0x7a, 0x7f, 0x86, 0x77, 0x87, 0x82, 0x8c, 0x7f, 0x8d, 0x7f, 0x7e, 0x87,
+		0x72, 0x91, 0x73, 0x8d, 0x75, 0x80, 0x7f, 0x72, 0x87, 0x76, 0x85, 0x7e, 0x80, 0x84, 0x74, 0x8d,
+		0x74, 0x8f, 0x7b, 0x9b, 0xad, 0x8f, 0x8f, 0x70, 0x6e, 0x62, 0x6a, 0x85, 0x7f, 0x7c, 0x7b, 0x71,
+		0x67, 0x6a, 0x7c, 0x81, 0x8e, 0x8b, 0x98, 0x73, 0x92, 0x76, 0x88, 0x8a, 0x8b, 0x99, 0x7c, 0x88,
+		0x78, 0x78, 0x75, 0x80, 0x7b, 0x83, 0x7c, 0x7b, 0x78, 0x75, 0x7c, 0x77, 0x88, 0x80, 0x88, 0x84,
+		0x80, 0x84, 0x7d, 0x85, 0x82, 0x88, 0x85, 0x84, 0x80, 0x82, 0x7b, 0x7c, 0x81, 0x7c, 0x80, 0x7f,
+		0x80, 0x7a, 0x7e, 0x7d, 0x7c, 0x81, 0x7f, 0x83, 0x81, 0x82, 0x92, 0xa8, 0x9a, 0x90, 0x80, 0x76,
+		0x62, 0x63, 0x76, 0x77, 0x7f, 0x80, 0x81, 0x6e, 0x6f, 0x6d, 0x78, 0x78, 0x8b, 0x90, 0x8e, 0x8c,
+		0x88, 0x82, 0x80, 0x81, 0x89, 0x87, 0x8c, 0x88, 0x85, 0x7c, 0x78, 0x77, 0x77, 0x78, 0x7f, 0x7f,
+		0x7f, 0x7d, 0x7c, 0x7a, 0x79, 0x7d, 0x80, 0x83, 0x85, 0x87, 0x85, 0x83, 0x82, 0x81, 0x80, 0x81,
+		0x83, 0x83, 0x82, 0x81, 0x80, 0x7e, 0x7c, 0x7c, 0x7d, 0x7e, 0x7f, 0x80, 0x80, 0x7f, 0x7f, 0x7f,
+		0x7f, 0x80, 0x81, 0x93, 0xa6, 0xa1, 0x92, 0x87, 0x77, 0x63, 0x60, 0x6c, 0x74, 0x7a, 0x83, 0x83,
+		0x79, 0x70, 0x6f, 0x6f, 0x74, 0x80, 0x8d, 0x91, 0x90, 0x8f, 0x88, 0x80, 0x7f, 0x82, 0x84, 0x87,
+		0x8b, 0x8a, 0x83, 0x7d, 0x79, 0x75, 0x74, 0x78, 0x7d, 0x7f, 0x80, 0x80, 0x7d, 0x7a, 0x7a, 0x7d,
+		0x7f, 0x82, 0x86, 0x87, 0x86, 0x84, 0x82, 0x80, 0x80, 0x81, 0x82, 0x82, 0x82, 0x82, 0x80, 0x7e,
+		0x7c, 0x7c, 0x7c, 0x7d, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x92, 0xa5, 0xa1, 0x93,
+		0x88, 0x78, 0x63, 0x60, 0x6b, 0x73, 0x7a, 0x83, 0x84, 0x7a, 0x71, 0x70, 0x6f, 0x73, 0x80, 0x8c,
+		0x91, 0x91, 0x8f, 0x89, 0x80, 0x7f, 0x81, 0x84, 0x87, 0x8b, 0x8a, 0x83, 0x7e, 0x79, 0x75, 0x74,
+		0x78, 0x7d, 0x7f, 0x80, 0x80, 0x7e, 0x7b, 0x7a, 0x7c, 0x7f, 0x82, 0x86, 0x87, 0x86, 0x84, 0x82,
+		0x80, 0x80, 0x80, 0x82, 0x82, 0x83, 0x82, 0x80, 0x7e, 0x7c, 0x7c, 0x7c, 0x7d, 0x7f, 0x80, 0x80,
+		0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x93, 0xa6, 0xa1, 0x92, 0x87, 0x77, 0x63, 0x60, 0x6b, 0x74,
+		0x7a, 0x83, 0x83, 0x79, 0x70, 0x6f, 0x6f, 0x73, 0x80, 0x8d, 0x91, 0x91, 0x8f, 0x88, 0x80, 0x7f,
+		0x82, 0x84, 0x87, 0x8b, 0x8a, 0x83, 0x7d, 0x79, 0x75, 0x74, 0x78, 0x7d, 0x7f, 0x80, 0x80, 0x7d,
+		0x7a, 0x7a, 0x7d, 0x7f, 0x82, 0x86, 0x88, 0x86, 0x84, 0x82, 0x80, 0x80, 0x80, 0x82, 0x82, 0x83,
+		0x82, 0x80, 0x7e, 0x7c, 0x7c, 0x7c, 0x7d, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x80,
+		0x81, 0x94, 0xa6, 0xa1, 0x91, 0x86, 0x76, 0x62, 0x60, 0x6c, 0x74, 0x7a, 0x82, 0x83, 0x78, 0x70,
+		0x6f, 0x70, 0x74, 0x80, 0x8d, 0x91, 0x91, 0x8f, 0x88, 0x80, 0x80, 0x82, 0x85, 0x88, 0x8b, 0x8a,
+		0x83, 0x7d, 0x79, 0x75, 0x74, 0x78, 0x7d, 0x7f, 0x80, 0x80, 0x7d, 0x7a, 0x7a, 0x7d, 0x7f, 0x82,
+		0x86, 0x88, 0x86, 0x84, 0x82, 0x80, 0x80, 0x81, 0x82, 0x82, 0x82, 0x82, 0x80, 0x7e, 0x7c, 0x7c,
+		0x7c, 0x7d, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x82, 0x82, 0x94, 0xa6, 0xa0,
+		0x90, 0x86, 0x76, 0x63, 0x61, 0x6c, 0x73, 0x79, 0x81, 0x82, 0x78, 0x70, 0x70, 0x70, 0x74, 0x81,
+		0x8d, 0x91, 0x90, 0x8f, 0x89, 0x81, 0x80, 0x82, 0x85, 0x87, 0x8b, 0x89, 0x82, 0x7d, 0x79, 0x75,
+		0x74, 0x78, 0x7d, 0x7f, 0x80, 0x80, 0x7d, 0x7a, 0x7b, 0x7d, 0x80, 0x82, 0x86, 0x87, 0x86, 0x84,
+		0x83, 0x80, 0x80, 0x81, 0x82, 0x82, 0x82, 0x81, 0x80, 0x7d, 0x7c, 0x7c, 0x7c, 0x7d, 0x7f, 0x80,
+		0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x82, 0x82, 0x94, 0xa6, 0xa0, 0x90, 0x86, 0x76, 0x63,
+		0x61, 0x6c, 0x73, 0x79, 0x81, 0x82, 0x78, 0x70, 0x70, 0x70, 0x74, 0x81, 0x8d, 0x91, 0x90, 0x8f,
+		0x89, 0x81, 0x80, 0x82, 0x85, 0x87, 0x8b, 0x89, 0x82, 0x7d, 0x79, 0x75, 0x74, 0x78, 0x7d, 0x7f,
+		0x7f, 0x80, 0x7d, 0x7a, 0x7b, 0x7d, 0x80, 0x82, 0x86, 0x87, 0x86, 0x84, 0x83, 0x80, 0x80, 0x81,
+		0x82, 0x82, 0x82, 0x81, 0x80, 0x7d, 0x7c, 0x7c, 0x7c, 0x7d, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7f,
+		0x7f, 0x80, 0x80, 0x82, 0x82, 0x83, 0x82, 0x93, 0xa4, 0x9e, 0x90, 0x86, 0x77, 0x63, 0x60, 0x6b,
+		0x72, 0x78, 0x80, 0x81, 0x78, 0x70, 0x71, 0x71, 0x75, 0x80, 0x8d, 0x91, 0x91, 0x90, 0x8a, 0x81,
+		0x80, 0x83, 0x84, 0x87, 0x8a, 0x89, 0x82, 0x7d, 0x79, 0x75, 0x74, 0x78, 0x7c, 0x7e, 0x7f, 0x80,
+		0x7d, 0x7b, 0x7b, 0x7d, 0x80, 0x82, 0x86, 0x88, 0x86, 0x85, 0x83, 0x81, 0x80, 0x81, 0x82, 0x82,
+		0x82, 0x81, 0x80, 0x7d, 0x7c, 0x7c, 0x7c, 0x7d, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80,
+		0x81, 0x82, 0x82, 0x83, 0x82, 0x93, 0xa4, 0x9f, 0x90, 0x86, 0x77, 0x63, 0x60, 0x6b, 0x72, 0x78,
+		0x80, 0x81, 0x78, 0x70, 0x71, 0x71, 0x75, 0x80, 0x8d, 0x91, 0x91, 0x90, 0x8a, 0x81, 0x80, 0x83,
+		0x84, 0x87, 0x8a, 0x89, 0x82, 0x7d, 0x79, 0x75, 0x74, 0x78, 0x7c, 0x7e, 0x7f, 0x80, 0x7d, 0x7b,
+		0x7b, 0x7d, 0x80, 0x82, 0x86, 0x88, 0x86, 0x85, 0x83, 0x81, 0x80, 0x81, 0x82, 0x82, 0x82, 0x81,
+		0x80, 0x7d, 0x7c, 0x7c, 0x7c, 0x7d, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x81, 0x82,
+		0x82, 0x83, 0x82, 0x81, 0x92, 0xa4, 0x9e, 0x90, 0x86, 0x77, 0x63, 0x60, 0x6a, 0x71, 0x77, 0x80,
+		0x82, 0x79, 0x71, 0x71, 0x71, 0x75, 0x80, 0x8d, 0x91, 0x91, 0x90, 0x8a, 0x82, 0x80, 0x82, 0x84,
+		0x87, 0x8a, 0x89, 0x82, 0x7d, 0x79, 0x75, 0x74, 0x77, 0x7c, 0x7e, 0x7f, 0x80, 0x7e, 0x7b, 0x7b,
+		0x7d, 0x80, 0x82, 0x86, 0x88, 0x87, 0x85, 0x83, 0x81, 0x80, 0x81, 0x82, 0x82, 0x82, 0x81, 0x80,
+		0x7d, 0x7c, 0x7c, 0x7c, 0x7d, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x81, 0x82, 0x82,
+		0x83, 0x82, 0x81, 0x81, 0x80, 0x91, 0xa3, 0x9f, 0x90, 0x86, 0x76, 0x62, 0x5e, 0x69, 0x71, 0x78,
+		0x81, 0x83, 0x79, 0x71, 0x71, 0x71, 0x75, 0x81, 0x8e, 0x92, 0x92, 0x91, 0x8a, 0x81, 0x80, 0x82,
+		0x84, 0x86, 0x8a, 0x89, 0x82, 0x7d, 0x78, 0x74, 0x73, 0x77, 0x7c, 0x7e, 0x80, 0x80, 0x7e, 0x7b,
+		0x7b, 0x7d, 0x80, 0x82, 0x86, 0x88, 0x87, 0x85, 0x83, 0x81, 0x80, 0x80, 0x81, 0x82, 0x82, 0x81,
+		0x80, 0x7d, 0x7c, 0x7b, 0x7c, 0x7d, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x81, 0x82,
+		0x83, 0x83, 0x82, 0x81, 0x80, 0x80, 0x91, 0xa3, 0x9f, 0x90, 0x86, 0x76, 0x62, 0x5e, 0x69, 0x71,
+		0x78, 0x81, 0x83, 0x7a, 0x71, 0x71, 0x71, 0x75, 0x81, 0x8e, 0x92, 0x92, 0x91, 0x8a, 0x81, 0x80,
+		0x82, 0x84, 0x86, 0x8a, 0x89, 0x82, 0x7d, 0x78, 0x74, 0x73, 0x77, 0x7c, 0x7e, 0x80, 0x80, 0x7e,
+		0x7b, 0x7b, 0x7d, 0x80, 0x82, 0x86, 0x88, 0x87, 0x85, 0x83, 0x80, 0x80, 0x80, 0x81, 0x82, 0x82,
+		0x81, 0x80, 0x7d, 0x7c, 0x7b, 0x7c, 0x7d, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x81,
+		0x82, 0x83, 0x83, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x91, 0xa3, 0x9d, 0x8f, 0x84, 0x75, 0x63,
+		0x61, 0x6c, 0x74, 0x7a, 0x82, 0x83, 0x7b, 0x74, 0x74, 0x74, 0x78, 0x81, 0x8b, 0x8d, 0x8d, 0x8c,
+		0x86, 0x80, 0x80, 0x81, 0x82, 0x84, 0x86, 0x85, 0x81, 0x7e, 0x7c, 0x79, 0x79, 0x7c, 0x7e, 0x80,
+		0x80, 0x80, 0x7f, 0x7e, 0x7e, 0x7f, 0x80, 0x81, 0x82, 0x83, 0x82, 0x81, 0x81, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	},
+	{ // 3
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x7f, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x81, 0x7f, 0x80,
+		0x82, 0x7c, 0x83, 0x7e, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x82, 0x7e, 0x82, 0x7c, 0x85,
+		0x7b, 0x84, 0x7f, 0x7e, 0x83, 0x7f, 0x80, 0x83, 0x7a, 0x87, 0x7e, 0x7c, 0x88, 0x78, 0x84, 0x7f,
+		0x82, 0x7e, 0x81, 0x80, 0x80, 0x80, 0x81, 0x7d, 0x81, 0x80, 0x80, 0x80, 0x7d, 0x86, 0x7c, 0x80,
+		0x81, 0x82, 0x7b, 0x82, 0x80, 0x81, 0x81, 0x7a, 0x86, 0x80, 0x7f, 0x81, 0x80, 0x7e, 0x82, 0x7e,
+		0x7f, 0x81, 0x82, 0x7c, 0x82, 0x82, 0x7d, 0x81, 0x80, 0x80, 0x80, 0x80, 0x7c, 0x84, 0x7e, 0x81,
+		0x80, 0x80, 0x7c, 0x86, 0x79, 0x83, 0x81, 0x7b, 0x88, 0x76, 0x8b, 0x7a, 0x7d, 0x8a, 0x74, 0x8b,
+		0x7a, 0x80, 0x82, 0x7d, 0x85, 0x7b, 0x81, 0x81, 0x7f, 0x80, 0x80, 0x82, 0x80, 0x7e, 0x82, 0x7e,
+		0x81, 0x81, 0x7c, 0x86, 0x7c, 0x7e, 0x8a, 0x74, 0x89, 0x79, 0x84, 0x83, 0x7b, 0x80, 0x80, 0x80,
+		0x7e, 0x81, 0x80, 0x80, 0x80, 0x7f, 0x86, 0x7a, 0x82, 0x81, 0x80, 0x7e, 0x85, 0x79, 0x86, 0x80,
+		0x7a, 0x89, 0x7d, 0x7a, 0x89, 0x78, 0x87, 0x7a, 0x83, 0x83, 0x7b, 0x84, 0x79, 0x87, 0x7d, 0x7e,
+		0x83, 0x80, 0x79, 0x8b, 0x76, 0x84, 0x80, 0x7d, 0x87, 0x76, 0x86, 0x81, 0x7b, 0x85, 0x7f, 0x80,
+		0x81, 0x7f, 0x83, 0x7a, 0x88, 0x7c, 0x80, 0x80, 0x82, 0x7b, 0x87, 0x78, 0x87, 0x7b, 0x81, 0x82,
+		0x7a, 0x89, 0x78, 0x80, 0x88, 0x74, 0x86, 0x83, 0x77, 0x8a, 0x7b, 0x81, 0x82, 0x7c, 0x82, 0x83,
+		0x77, 0x8c, 0x77, 0x86, 0x7c, 0x84, 0x7c, 0x83, 0x7b, 0x84, 0x81, 0x7c, 0x80, 0x84, 0x7d, 0x7e,
+		0x86, 0x7b, 0x83, 0x82, 0x7c, 0x7f, 0x85, 0x7a, 0x86, 0x7e, 0x7e, 0x84, 0x79, 0x87, 0x7a, 0x82,
+		0x81, 0x7e, 0x86, 0x7b, 0x80, 0x7f, 0x82, 0x7e, 0x83, 0x7b, 0x84, 0x7e, 0x82, 0x80, 0x7d, 0x86,
+		0x7d, 0x80, 0x80, 0x81, 0x82, 0x7c, 0x82, 0x80, 0x80, 0x81, 0x7e, 0x83, 0x7e, 0x7e, 0x87, 0x79,
+		0x88, 0x79, 0x86, 0x7b, 0x80, 0x87, 0x76, 0x86, 0x80, 0x80, 0x80, 0x81, 0x7b, 0x86, 0x7c, 0x82,
+		0x80, 0x7e, 0x87, 0x7a, 0x80, 0x82, 0x83, 0x7b, 0x82, 0x80, 0x7f, 0x83, 0x7a, 0x88, 0x7c, 0x80,
+		0x80, 0x81, 0x80, 0x7d, 0x87, 0x79, 0x82, 0x83, 0x7d, 0x7f, 0x85, 0x77, 0x8a, 0x7a, 0x7e, 0x86,
+		0x7f, 0x7c, 0x87, 0x7b, 0x7f, 0x86, 0x7c, 0x80, 0x80, 0x80, 0x7d, 0x83, 0x7e, 0x82, 0x7d, 0x84,
+		0x7e, 0x80, 0x80, 0x83, 0x7d, 0x83, 0x7e, 0x80, 0x83, 0x77, 0x8c, 0x75, 0x87, 0x7d, 0x82, 0x80,
+		0x7c, 0x87, 0x7a, 0x82, 0x82, 0x79, 0x8a, 0x77, 0x85, 0x7f, 0x7f, 0x81, 0x80, 0x80, 0x84, 0x7b,
+		0x86, 0x7d, 0x7e, 0x83, 0x7d, 0x85, 0x79, 0x82, 0x83, 0x7b, 0x85, 0x7c, 0x87, 0x7b, 0x80, 0x81,
+		0x7f, 0x80, 0x84, 0x78, 0x8b, 0x75, 0x84, 0x86, 0x78, 0x82, 0x80, 0x80, 0x81, 0x7c, 0x88, 0x75,
+		0x8a, 0x79, 0x84, 0x7e, 0x7f, 0x82, 0x7f, 0x80, 0x81, 0x7f, 0x83, 0x7c, 0x81, 0x80, 0x80, 0x82,
+		0x7d, 0x82, 0x83, 0x77, 0x88, 0x7c, 0x82, 0x7e, 0x82, 0x7f, 0x81, 0x7c, 0x84, 0x80, 0x7b, 0x89,
+		0x74, 0x8d, 0x74, 0x87, 0x81, 0x7a, 0x89, 0x79, 0x84, 0x80, 0x7e, 0x7d, 0x88, 0x7a, 0x81, 0x7f,
+		0x86, 0x7a, 0x80, 0x86, 0x75, 0x8b, 0x7b, 0x7f, 0x82, 0x80, 0x7a, 0x8a, 0x75, 0x86, 0x84, 0x7a,
+		0x82, 0x82, 0x7c, 0x7e, 0x84, 0x81, 0x7c, 0x80, 0x85, 0x7a, 0x83, 0x80, 0x7e, 0x7e, 0x87, 0x78,
+		0x82, 0x80, 0x80, 0x85, 0x77, 0x89, 0x7c, 0x7d, 0x85, 0x80, 0x7a, 0x86, 0x81, 0x7b, 0x83, 0x7f,
+		0x82, 0x7e, 0x82, 0x80, 0x7b, 0x84, 0x81, 0x7d, 0x80, 0x83, 0x7e, 0x7e, 0x88, 0x75, 0x88, 0x7d,
+		0x81, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x7f, 0x81, 0x82, 0x7f, 0x80, 0x80, 0x82, 0x79, 0x87, 0x7e,
+		0x7c, 0x86, 0x7e, 0x80, 0x7d, 0x87, 0x7a, 0x82, 0x80, 0x7f, 0x7e, 0x84, 0x7c, 0x85, 0x7c, 0x82,
+		0x7d, 0x84, 0x7d, 0x82, 0x7d, 0x83, 0x7b, 0x85, 0x80, 0x80, 0x7d, 0x82, 0x80, 0x80, 0x81, 0x7b,
+		0x86, 0x7b, 0x82, 0x80, 0x7e, 0x83, 0x7a, 0x84, 0x81, 0x7d, 0x83, 0x7f, 0x7f, 0x84, 0x7d, 0x7f,
+		0x81, 0x80, 0x7e, 0x81, 0x81, 0x7d, 0x87, 0x79, 0x82, 0x80, 0x81, 0x80, 0x7d, 0x85, 0x7e, 0x80,
+		0x80, 0x81, 0x81, 0x7c, 0x85, 0x7e, 0x83, 0x7d, 0x7e, 0x87, 0x7c, 0x7f, 0x81, 0x83, 0x7b, 0x80,
+		0x86, 0x76, 0x89, 0x7c, 0x83, 0x7f, 0x81, 0x80, 0x7a, 0x86, 0x80, 0x7d, 0x82, 0x81, 0x80, 0x80,
+		0x7f, 0x84, 0x7e, 0x80, 0x82, 0x80, 0x80, 0x81, 0x7b, 0x87, 0x7c, 0x7f, 0x85, 0x7d, 0x80, 0x83,
+		0x79, 0x87, 0x7d, 0x7e, 0x83, 0x7e, 0x81, 0x80, 0x7d, 0x80, 0x80, 0x82, 0x78, 0x8b, 0x76, 0x87,
+		0x7b, 0x85, 0x7c, 0x80, 0x86, 0x7c, 0x7f, 0x82, 0x80, 0x80, 0x7d, 0x83, 0x80, 0x81, 0x7b, 0x86,
+		0x7c, 0x82, 0x82, 0x7a, 0x86, 0x7b, 0x86, 0x7d, 0x80, 0x82, 0x79, 0x8b, 0x78, 0x82, 0x82, 0x7d,
+		0x80, 0x82, 0x80, 0x7b, 0x86, 0x7a, 0x86, 0x7d, 0x7f, 0x84, 0x7e, 0x83, 0x7e, 0x7e, 0x84, 0x7f,
+		0x7e, 0x82, 0x7e, 0x84, 0x7d, 0x81, 0x7e, 0x85, 0x7b, 0x84, 0x7d, 0x84, 0x7d, 0x80, 0x81, 0x7f,
+		0x80, 0x84, 0x7e, 0x7d, 0x8a, 0x73, 0x88, 0x80, 0x7c, 0x84, 0x7f, 0x7b, 0x87, 0x7b, 0x82, 0x83,
+		0x7c, 0x82, 0x7d, 0x81, 0x80, 0x85, 0x7a, 0x81, 0x84, 0x7c, 0x80, 0x85, 0x78, 0x86, 0x7b, 0x81,
+		0x81, 0x7f, 0x80, 0x82, 0x80, 0x7e, 0x82, 0x7b, 0x85, 0x7e, 0x84, 0x79, 0x87, 0x7c, 0x83, 0x7e,
+		0x7e, 0x87, 0x78, 0x83, 0x82, 0x7e, 0x80, 0x83, 0x7e, 0x7d, 0x81, 0x84, 0x77, 0x86, 0x80, 0x7f,
+		0x80, 0x7f, 0x83, 0x7d, 0x81, 0x7f, 0x83, 0x7e, 0x7e, 0x84, 0x7b, 0x85, 0x80, 0x7a, 0x88, 0x79,
+		0x83, 0x81, 0x7b, 0x85, 0x81, 0x78, 0x8a, 0x7c, 0x7c, 0x87, 0x7e, 0x7d, 0x89, 0x76, 0x86, 0x7f,
+		0x7f, 0x83, 0x7d, 0x83, 0x7e, 0x82, 0x7c, 0x81, 0x83, 0x7c, 0x83, 0x7d, 0x82, 0x80, 0x7c, 0x82,
+		0x81, 0x7b, 0x86, 0x7d, 0x81, 0x81, 0x7f, 0x80, 0x7c, 0x85, 0x7e, 0x81, 0x7d, 0x84, 0x7f, 0x80,
+		0x7f, 0x80, 0x82, 0x7c, 0x84, 0x80, 0x7b, 0x85, 0x7e, 0x80, 0x7f, 0x80, 0x82, 0x7e, 0x80, 0x82,
+		0x7d, 0x82, 0x80, 0x80, 0x85, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x82, 0x7d, 0x80, 0x7c, 0x84,
+		0x7c, 0x83, 0x7f, 0x7f, 0x7f, 0x81, 0x84, 0x7a, 0x88, 0x7c, 0x7e, 0x88, 0x76, 0x8a, 0x7a, 0x83,
+		0x82, 0x78, 0x87, 0x7b, 0x84, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x7f, 0x7d, 0x83, 0x7f,
+		0x7e, 0x86, 0x7c, 0x80, 0x83, 0x7c, 0x85, 0x7c, 0x81, 0x81, 0x7e, 0x80, 0x82, 0x7b, 0x83, 0x80,
+		0x7f, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x87, 0x8c, 0x81, 0x81, 0x72, 0x81, 0x80, 0x7e, 0x83, 0x80,
+		0x7a, 0x76, 0x7c, 0x82, 0x80, 0x82, 0x80, 0x7e, 0x7f, 0x7f, 0x82, 0x89, 0x80, 0x83, 0x7e, 0x7f,
+		0x84, 0x80, 0x83, 0x81, 0x7e, 0x7e, 0x7d, 0x7f, 0x84, 0x7c, 0x81, 0x7f, 0x7c, 0x81, 0x7e, 0x80,
+		0x84, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x82, 0x80, 0x80, 0x80, 0x7d, 0x83, 0x7f, 0x82, 0x7f,
+		0x7f, 0x80, 0x7d, 0x80, 0x7f, 0x81, 0x7d, 0x81, 0x80, 0x7e, 0x88, 0x93, 0x8f, 0x83, 0x70, 0x6f,
+		0x7d, 0x86, 0x84, 0x80, 0x78, 0x77, 0x76, 0x79, 0x7d, 0x87, 0x88, 0x83, 0x7b, 0x78, 0x80, 0x84,
+		0x8b, 0x87, 0x83, 0x80, 0x7f, 0x7e, 0x80, 0x81, 0x85, 0x82, 0x7d, 0x7b, 0x7a, 0x7c, 0x81, 0x82,
+		0x80, 0x7e, 0x7e, 0x7c, 0x7f, 0x81, 0x82, 0x83, 0x82, 0x80, 0x7f, 0x80, 0x80, 0x83, 0x83, 0x82,
+		0x80, 0x7e, 0x7e, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x81, 0x86, 0x92,
+		0x9a, 0x91, 0x7b, 0x65, 0x62, 0x74, 0x8c, 0x95, 0x88, 0x71, 0x62, 0x68, 0x7b, 0x8b, 0x8f, 0x88,
+		0x7f, 0x7a, 0x7c, 0x80, 0x85, 0x89, 0x8b, 0x89, 0x85, 0x7e, 0x79, 0x7a, 0x80, 0x86, 0x87, 0x81,
+		0x79, 0x74, 0x76, 0x7e, 0x83, 0x84, 0x81, 0x7d, 0x7b, 0x7d, 0x80, 0x83, 0x85, 0x84, 0x83, 0x81,
+		0x80, 0x7f, 0x80, 0x82, 0x83, 0x83, 0x80, 0x7e, 0x7c, 0x7d, 0x80, 0x81, 0x81, 0x80, 0x7e, 0x7d,
+		0x7e, 0x80, 0x81, 0x87, 0x94, 0x9b, 0x92, 0x7b, 0x64, 0x60, 0x74, 0x8d, 0x97, 0x89, 0x71, 0x61,
+		0x66, 0x7a, 0x8b, 0x90, 0x89, 0x7f, 0x79, 0x7b, 0x80, 0x86, 0x89, 0x8b, 0x8a, 0x85, 0x7e, 0x79,
+		0x7a, 0x80, 0x87, 0x88, 0x81, 0x79, 0x73, 0x76, 0x7d, 0x83, 0x85, 0x81, 0x7d, 0x7b, 0x7c, 0x80,
+		0x83, 0x85, 0x85, 0x83, 0x81, 0x80, 0x7f, 0x80, 0x82, 0x84, 0x83, 0x80, 0x7e, 0x7c, 0x7d, 0x80,
+		0x81, 0x81, 0x80, 0x7e, 0x7d, 0x7e, 0x80, 0x81, 0x81, 0x87, 0x93, 0x9a, 0x92, 0x7b, 0x64, 0x61,
+		0x75, 0x8c, 0x96, 0x88, 0x70, 0x61, 0x67, 0x7b, 0x8b, 0x8f, 0x88, 0x7e, 0x7a, 0x7c, 0x80, 0x86,
+		0x89, 0x8b, 0x8a, 0x85, 0x7e, 0x79, 0x7a, 0x80, 0x86, 0x87, 0x81, 0x79, 0x74, 0x76, 0x7e, 0x83,
+		0x84, 0x81, 0x7d, 0x7b, 0x7d, 0x80, 0x83, 0x85, 0x84, 0x83, 0x81, 0x80, 0x7f, 0x80, 0x82, 0x83,
+		0x83, 0x80, 0x7e, 0x7c, 0x7d, 0x80, 0x81, 0x81, 0x80, 0x7e, 0x7d, 0x7e, 0x80, 0x81, 0x81, 0x87,
+		0x93, 0x9a, 0x92, 0x7b, 0x64, 0x61, 0x74, 0x8c, 0x96, 0x88, 0x70, 0x61, 0x67, 0x7b, 0x8b, 0x8f,
+		0x88, 0x7e, 0x7a, 0x7c, 0x80, 0x86, 0x89, 0x8b, 0x8a, 0x85, 0x7f, 0x79, 0x7a, 0x80, 0x86, 0x87,
+		0x81, 0x79, 0x74, 0x76, 0x7e, 0x83, 0x84, 0x81, 0x7d, 0x7b, 0x7d, 0x80, 0x83, 0x85, 0x84, 0x83,
+		0x81, 0x80, 0x7f, 0x80, 0x82, 0x83, 0x83, 0x80, 0x7e, 0x7c, 0x7d, 0x80, 0x81, 0x81, 0x80, 0x7e,
+		0x7d, 0x7e, 0x80, 0x81, 0x81, 0x87, 0x93, 0x9a, 0x92, 0x7b, 0x64, 0x61, 0x74, 0x8c, 0x96, 0x88,
+		0x70, 0x61, 0x67, 0x7b, 0x8b, 0x8f, 0x88, 0x7e, 0x7a, 0x7c, 0x80, 0x86, 0x89, 0x8b, 0x8a, 0x85,
+		0x7f, 0x79, 0x7a, 0x80, 0x86, 0x87, 0x81, 0x79, 0x74, 0x76, 0x7e, 0x83, 0x84, 0x81, 0x7d, 0x7b,
+		0x7d, 0x80, 0x83, 0x85, 0x84, 0x83, 0x81, 0x80, 0x7f, 0x80, 0x82, 0x83, 0x83, 0x80, 0x7e, 0x7c,
+		0x7d, 0x80, 0x81, 0x81, 0x80, 0x7e, 0x7d, 0x7e, 0x80, 0x81, 0x81, 0x81, 0x87, 0x93, 0x9b, 0x90,
+		0x78, 0x62, 0x64, 0x7c, 0x92, 0x93, 0x7f, 0x67, 0x61, 0x70, 0x85, 0x8f, 0x89, 0x7f, 0x78, 0x7a,
+		0x80, 0x85, 0x89, 0x8b, 0x8a, 0x84, 0x7d, 0x7a, 0x7f, 0x87, 0x8b, 0x85, 0x7a, 0x73, 0x77, 0x7f,
+		0x84, 0x83, 0x7d, 0x79, 0x79, 0x7e, 0x81, 0x83, 0x83, 0x81, 0x80, 0x7f, 0x80, 0x82, 0x85, 0x85,
+		0x81, 0x7e, 0x7d, 0x80, 0x83, 0x83, 0x80, 0x7d, 0x7c, 0x7e, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x7e,
+		0x80, 0x80, 0x81, 0x8c, 0x9c, 0x9a, 0x7f, 0x62, 0x65, 0x84, 0x9c, 0x8f, 0x6e, 0x5b, 0x6a, 0x85,
+		0x8f, 0x84, 0x76, 0x73, 0x7a, 0x81, 0x87, 0x8b, 0x8a, 0x81, 0x7a, 0x7c, 0x88, 0x91, 0x8b, 0x7c,
+		0x74, 0x7b, 0x86, 0x89, 0x80, 0x78, 0x76, 0x7c, 0x80, 0x81, 0x80, 0x7d, 0x7b, 0x7b, 0x80, 0x85,
+		0x85, 0x80, 0x7c, 0x7e, 0x84, 0x87, 0x84, 0x80, 0x7e, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x7d, 0x7e,
+		0x80, 0x81, 0x80, 0x7e, 0x7c, 0x7e, 0x81, 0x81, 0x80, 0x7e, 0x7f, 0x91, 0xa4, 0x93, 0x68, 0x5c,
+		0x81, 0xa3, 0x90, 0x65, 0x59, 0x77, 0x8f, 0x88, 0x76, 0x6f, 0x76, 0x7e, 0x86, 0x8b, 0x85, 0x78,
+		0x75, 0x86, 0x96, 0x8e, 0x7a, 0x75, 0x84, 0x90, 0x89, 0x7c, 0x78, 0x7d, 0x81, 0x83, 0x80, 0x7b,
+		0x76, 0x7a, 0x82, 0x85, 0x7e, 0x77, 0x7b, 0x84, 0x87, 0x80, 0x7c, 0x7f, 0x83, 0x85, 0x83, 0x80,
+		0x7f, 0x80, 0x83, 0x84, 0x80, 0x7c, 0x7e, 0x81, 0x82, 0x7f, 0x7c, 0x7e, 0x80, 0x81, 0x80, 0x7e,
+		0x7e, 0x80, 0x81, 0x81, 0x97, 0xa3, 0x7e, 0x5a, 0x77, 0xa6, 0x94, 0x60, 0x5c, 0x84, 0x93, 0x7c,
+		0x6b, 0x72, 0x7c, 0x82, 0x88, 0x81, 0x71, 0x76, 0x90, 0x95, 0x7e, 0x71, 0x84, 0x94, 0x8a, 0x7b,
+		0x7c, 0x83, 0x86, 0x84, 0x7f, 0x78, 0x7a, 0x84, 0x85, 0x79, 0x73, 0x7e, 0x86, 0x80, 0x78, 0x7b,
+		0x81, 0x84, 0x81, 0x7f, 0x7e, 0x81, 0x86, 0x84, 0x7e, 0x7e, 0x84, 0x86, 0x80, 0x7d, 0x80, 0x82,
+		0x81, 0x7f, 0x7d, 0x7f, 0x80, 0x80, 0x7e, 0x7d, 0x80, 0x82, 0x80, 0x7e, 0x7f, 0xa3, 0xa3, 0x64,
+		0x5b, 0x9f, 0xa9, 0x65, 0x55, 0x87, 0x95, 0x76, 0x6b, 0x74, 0x7b, 0x87, 0x86, 0x6d, 0x6c, 0x90,
+		0x97, 0x74, 0x6e, 0x8d, 0x96, 0x80, 0x79, 0x82, 0x89, 0x8a, 0x82, 0x77, 0x7d, 0x8c, 0x86, 0x73,
+		0x77, 0x87, 0x84, 0x77, 0x77, 0x80, 0x82, 0x80, 0x7b, 0x7b, 0x82, 0x86, 0x7e, 0x7a, 0x83, 0x88,
+		0x80, 0x7d, 0x81, 0x85, 0x82, 0x80, 0x7f, 0x81, 0x84, 0x80, 0x7c, 0x80, 0x83, 0x80, 0x7c, 0x7f,
+		0x81, 0x80, 0x7e, 0x7e, 0x80, 0x81, 0x80, 0xaa, 0x94, 0x50, 0x7d, 0xbb, 0x7a, 0x4c, 0x87, 0x9a,
+		0x70, 0x6b, 0x78, 0x7d, 0x8a, 0x7a, 0x61, 0x83, 0x9c, 0x74, 0x67, 0x90, 0x93, 0x79, 0x7b, 0x87,
+		0x8a, 0x89, 0x7c, 0x7a, 0x8f, 0x8c, 0x72, 0x7a, 0x8e, 0x81, 0x74, 0x7d, 0x83, 0x80, 0x7b, 0x77,
+		0x80, 0x86, 0x7b, 0x76, 0x84, 0x86, 0x7b, 0x7d, 0x84, 0x84, 0x80, 0x7f, 0x81, 0x86, 0x81, 0x7c,
+		0x82, 0x86, 0x7f, 0x7d, 0x82, 0x82, 0x7f, 0x7d, 0x80, 0x81, 0x7f, 0x7c, 0x80, 0x82, 0x7f, 0x7d,
+		0x80, 0xba, 0x7c, 0x43, 0xaf, 0xad, 0x43, 0x73, 0xa8, 0x75, 0x69, 0x76, 0x7f, 0x90, 0x6b, 0x5e,
+		0x9d, 0x8c, 0x57, 0x82, 0x9c, 0x77, 0x76, 0x86, 0x8c, 0x8a, 0x75, 0x80, 0x9b, 0x7f, 0x6e, 0x90,
+		0x8d, 0x74, 0x7d, 0x86, 0x83, 0x7b, 0x75, 0x84, 0x86, 0x71, 0x7a, 0x8b, 0x7c, 0x76, 0x82, 0x84,
+		0x7f, 0x7b, 0x81, 0x88, 0x7e, 0x7b, 0x88, 0x85, 0x7b, 0x81, 0x86, 0x80, 0x7e, 0x80, 0x84, 0x80,
+		0x7b, 0x82, 0x83, 0x7c, 0x7e, 0x82, 0x80, 0x7d, 0x7f, 0x81, 0x80, 0x7d, 0x80, 0xc9, 0x56, 0x58,
+		0xde, 0x68, 0x46, 0xb3, 0x80, 0x65, 0x78, 0x7b, 0x97, 0x5e, 0x63, 0xb1, 0x6a, 0x58, 0xa3, 0x80,
+		0x6c, 0x83, 0x88, 0x8d, 0x70, 0x80, 0xa3, 0x71, 0x75, 0x9f, 0x7f, 0x75, 0x88, 0x87, 0x80, 0x73,
+		0x85, 0x8c, 0x6c, 0x7e, 0x8d, 0x73, 0x79, 0x85, 0x80, 0x7a, 0x7a, 0x88, 0x80, 0x75, 0x88, 0x84,
+		0x78, 0x83, 0x86, 0x80, 0x7e, 0x83, 0x86, 0x7c, 0x80, 0x88, 0x7e, 0x7d, 0x84, 0x80, 0x7d, 0x7f,
+		0x82, 0x7f, 0x7b, 0x81, 0x81, 0x7b, 0x80, 0x82, 0xcd, 0x37, 0x7d, 0xe2, 0x31, 0x76, 0xb8, 0x5d,
+		0x77, 0x75, 0x90, 0x7f, 0x46, 0xa8, 0x88, 0x43, 0x9e, 0x8b, 0x65, 0x83, 0x85, 0x8f, 0x6f, 0x7c,
+		0xa7, 0x6c, 0x76, 0xa3, 0x79, 0x78, 0x8a, 0x87, 0x80, 0x73, 0x8d, 0x87, 0x68, 0x88, 0x89, 0x6f,
+		0x7f, 0x84, 0x7e, 0x78, 0x7d, 0x8a, 0x78, 0x78, 0x8c, 0x7e, 0x79, 0x86, 0x83, 0x7f, 0x7f, 0x86,
+		0x84, 0x7a, 0x84, 0x87, 0x7b, 0x80, 0x85, 0x7f, 0x7e, 0x80, 0x83, 0x7d, 0x7c, 0x83, 0x7f, 0x7b,
+		0x81, 0x80, 0x7d, 0x7f, 0x81, 0xd0, 0x36, 0x7c, 0xe3, 0x31, 0x76, 0xb7, 0x5e, 0x77, 0x74, 0x91,
+		0x80, 0x44, 0xa9, 0x88, 0x42, 0x9e, 0x8b, 0x65, 0x83, 0x85, 0x90, 0x6f, 0x7c, 0xa8, 0x6c, 0x76,
+		0xa3, 0x79, 0x79, 0x8a, 0x87, 0x80, 0x72, 0x8d, 0x88, 0x68, 0x88, 0x89, 0x6f, 0x7f, 0x84, 0x7e,
+		0x78, 0x7c, 0x8a, 0x78, 0x77, 0x8c, 0x7e, 0x79, 0x86, 0x83, 0x7f, 0x7f, 0x86, 0x84, 0x7a, 0x84,
+		0x87, 0x7b, 0x80, 0x85, 0x7f, 0x7e, 0x80, 0x83, 0x7d, 0x7c, 0x84, 0x7f, 0x7b, 0x81, 0x80, 0x7d,
+		0x7f, 0x81, 0xd0, 0x36, 0x7c, 0xe3, 0x31, 0x76, 0xb7, 0x5e, 0x77, 0x74, 0x91, 0x80, 0x44, 0xa9,
+		0x88, 0x42, 0x9e, 0x8b, 0x65, 0x83, 0x85, 0x90, 0x6f, 0x7c, 0xa8, 0x6c, 0x76, 0xa3, 0x79, 0x79,
+		0x8a, 0x87, 0x80, 0x72, 0x8d, 0x88, 0x68, 0x88, 0x89, 0x6f, 0x7f, 0x84, 0x7e, 0x78, 0x7c, 0x8a,
+		0x78, 0x77, 0x8c, 0x7e, 0x79, 0x86, 0x83, 0x7f, 0x7f, 0x86, 0x84, 0x7a, 0x84, 0x87, 0x7b, 0x80,
+		0x85, 0x7f, 0x7e, 0x80, 0x83, 0x7d, 0x7c, 0x84, 0x7f, 0x7b, 0x81, 0x80, 0x7d, 0x7f, 0x81, 0xd0,
+		0x36, 0x7c, 0xe3, 0x31, 0x76, 0xb7, 0x5e, 0x77, 0x74, 0x91, 0x80, 0x44, 0xa9, 0x88, 0x42, 0x9e,
+		0x8b, 0x65, 0x83, 0x85, 0x90, 0x6f, 0x7c, 0xa8, 0x6c, 0x76, 0xa3, 0x79, 0x79, 0x8a, 0x87, 0x80,
+		0x72, 0x8d, 0x88, 0x68, 0x88, 0x89, 0x6f, 0x7f, 0x84, 0x7e, 0x78, 0x7c, 0x8a, 0x78, 0x77, 0x8c,
+		0x7e, 0x79, 0x86, 0x83, 0x7f, 0x7f, 0x86, 0x84, 0x7a, 0x84, 0x87, 0x7b, 0x80, 0x85, 0x7f, 0x7e,
+		0x80, 0x83, 0x7d, 0x7c, 0x84, 0x7f, 0x7b, 0x81, 0x80, 0x7d, 0x7f, 0x81, 0x80, 0x7e, 0xd1, 0x3c,
+		0x79, 0xe0, 0x36, 0x76, 0xb3, 0x60, 0x7a, 0x72, 0x8e, 0x82, 0x45, 0xa5, 0x89, 0x45, 0x9b, 0x8a,
+		0x68, 0x83, 0x82, 0x90, 0x71, 0x7a, 0xa7, 0x6f, 0x76, 0xa1, 0x7a, 0x7a, 0x89, 0x87, 0x82, 0x73,
+		0x8c, 0x88, 0x69, 0x87, 0x89, 0x70, 0x7f, 0x83, 0x7f, 0x78, 0x7c, 0x89, 0x79, 0x77, 0x8b, 0x7e,
+		0x79, 0x86, 0x83, 0x7f, 0x7f, 0x85, 0x85, 0x7a, 0x83, 0x87, 0x7b, 0x80, 0x84, 0x80, 0x7e, 0x80,
+		0x82, 0x7e, 0x7c, 0x83, 0x7f, 0x7b, 0x81, 0x80, 0x7e, 0x7f, 0x81, 0x80, 0x7e, 0xd0, 0x3c, 0x79,
+		0xe0, 0x36, 0x76, 0xb3, 0x5f, 0x7a, 0x72, 0x8e, 0x81, 0x45, 0xa5, 0x89, 0x45, 0x9c, 0x8a, 0x68,
+		0x83, 0x82, 0x90, 0x71, 0x7a, 0xa7, 0x6f, 0x76, 0xa1, 0x7a, 0x7a, 0x89, 0x87, 0x81, 0x73, 0x8c,
+		0x88, 0x69, 0x87, 0x89, 0x70, 0x7f, 0x83, 0x7f, 0x78, 0x7c, 0x89, 0x79, 0x77, 0x8b, 0x7e, 0x79,
+		0x86, 0x83, 0x7f, 0x7f, 0x85, 0x85, 0x7a, 0x83, 0x87, 0x7b, 0x80, 0x84, 0x80, 0x7e, 0x80, 0x82,
+		0x7e, 0x7c, 0x83, 0x7f, 0x7b, 0x81, 0x80, 0x7e, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0xd2, 0x37, 0x7b,
+		0xe4, 0x33, 0x76, 0xb6, 0x60, 0x78, 0x71, 0x91, 0x80, 0x43, 0xa8, 0x89, 0x43, 0x9d, 0x8b, 0x67,
+		0x82, 0x84, 0x91, 0x6f, 0x7b, 0xa8, 0x6d, 0x76, 0xa2, 0x7a, 0x79, 0x8a, 0x88, 0x81, 0x72, 0x8d,
+		0x88, 0x68, 0x87, 0x89, 0x6f, 0x7e, 0x83, 0x7f, 0x78, 0x7c, 0x8a, 0x78, 0x77, 0x8c, 0x7e, 0x79,
+		0x86, 0x83, 0x7f, 0x7e, 0x86, 0x85, 0x7a, 0x83, 0x87, 0x7b, 0x80, 0x85, 0x80, 0x7e, 0x80, 0x83,
+		0x7e, 0x7c, 0x83, 0x7f, 0x7b, 0x81, 0x80, 0x7d, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0x82, 0xcd, 0x39,
+		0x7e, 0xe1, 0x32, 0x78, 0xb6, 0x5e, 0x77, 0x74, 0x8f, 0x7f, 0x46, 0xa7, 0x87, 0x43, 0x9e, 0x8a,
+		0x65, 0x83, 0x84, 0x8f, 0x6f, 0x7d, 0xa7, 0x6d, 0x77, 0xa2, 0x79, 0x79, 0x8b, 0x87, 0x80, 0x73,
+		0x8d, 0x87, 0x69, 0x88, 0x89, 0x6f, 0x7f, 0x83, 0x7e, 0x78, 0x7d, 0x89, 0x78, 0x78, 0x8c, 0x7e,
+		0x79, 0x86, 0x83, 0x7f, 0x7f, 0x86, 0x84, 0x7a, 0x84, 0x87, 0x7b, 0x80, 0x85, 0x7f, 0x7e, 0x80,
+		0x82, 0x7d, 0x7c, 0x83, 0x7f, 0x7b, 0x81, 0x80, 0x7d, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0x82, 0x7e,
+		0xcf, 0x3c, 0x7b, 0xe0, 0x35, 0x78, 0xb4, 0x5e, 0x7a, 0x72, 0x8d, 0x81, 0x46, 0xa5, 0x88, 0x45,
+		0x9c, 0x89, 0x67, 0x83, 0x82, 0x90, 0x71, 0x7b, 0xa7, 0x6e, 0x77, 0xa2, 0x7a, 0x7a, 0x8a, 0x86,
+		0x81, 0x73, 0x8c, 0x88, 0x69, 0x87, 0x88, 0x70, 0x7f, 0x83, 0x7e, 0x78, 0x7c, 0x89, 0x79, 0x78,
+		0x8b, 0x7e, 0x79, 0x86, 0x83, 0x7f, 0x7f, 0x85, 0x85, 0x7b, 0x83, 0x87, 0x7b, 0x80, 0x85, 0x80,
+		0x7e, 0x80, 0x82, 0x7e, 0x7c, 0x83, 0x7f, 0x7b, 0x81, 0x80, 0x7d, 0x7f, 0x81, 0x80, 0x7e, 0x80,
+		0x82, 0x7e, 0xcf, 0x3c, 0x7b, 0xe0, 0x35, 0x78, 0xb4, 0x5e, 0x7a, 0x72, 0x8d, 0x81, 0x46, 0xa5,
+		0x88, 0x45, 0x9d, 0x89, 0x67, 0x83, 0x82, 0x90, 0x71, 0x7b, 0xa7, 0x6e, 0x77, 0xa2, 0x7a, 0x7a,
+		0x8a, 0x86, 0x81, 0x73, 0x8c, 0x88, 0x69, 0x87, 0x88, 0x70, 0x7f, 0x83, 0x7e, 0x78, 0x7c, 0x89,
+		0x79, 0x78, 0x8b, 0x7e, 0x7a, 0x85, 0x83, 0x7f, 0x7f, 0x85, 0x84, 0x7b, 0x83, 0x86, 0x7c, 0x80,
+		0x84, 0x80, 0x7f, 0x80, 0x82, 0x7e, 0x7e, 0x82, 0x80, 0x7d, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x80,
+		0x7f, 0x80, 0x81, 0x7f, 0x80, 0xac, 0x5b, 0x7d, 0xb1, 0x5c, 0x7c, 0x98, 0x72, 0x7d, 0x7a, 0x86,
+		0x80, 0x69, 0x8e, 0x83, 0x6b, 0x89, 0x83, 0x79, 0x80, 0x81, 0x84, 0x7c, 0x7f, 0x89, 0x7c, 0x7e,
+		0x86, 0x7f, 0x7f, 0x81, 0x81, 0x80, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	},
+	{ // 4
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80,
+		0x80, 0x82, 0x7d, 0x83, 0x7e, 0x7f, 0x82, 0x7f, 0x7f, 0x81, 0x80, 0x7f, 0x7f, 0x81, 0x80, 0x7f,
+		0x80, 0x81, 0x7d, 0x82, 0x80, 0x7e, 0x82, 0x7f, 0x80, 0x81, 0x80, 0x7e, 0x83, 0x7d, 0x83, 0x7d,
+		0x80, 0x83, 0x7e, 0x80, 0x83, 0x7d, 0x80, 0x80, 0x80, 0x80, 0x83, 0x7c, 0x84, 0x7d, 0x80, 0x86,
+		0x78, 0x82, 0x81, 0x7e, 0x82, 0x80, 0x7c, 0x84, 0x7d, 0x81, 0x81, 0x7f, 0x80, 0x7e, 0x85, 0x7a,
+		0x84, 0x81, 0x7c, 0x82, 0x80, 0x80, 0x7e, 0x85, 0x7b, 0x84, 0x7f, 0x7d, 0x86, 0x79, 0x85, 0x7e,
+		0x7f, 0x85, 0x7c, 0x7f, 0x86, 0x7b, 0x80, 0x84, 0x7c, 0x80, 0x82, 0x80, 0x80, 0x7f, 0x82, 0x7c,
+		0x85, 0x7c, 0x80, 0x84, 0x7e, 0x81, 0x7d, 0x83, 0x7f, 0x80, 0x81, 0x7c, 0x83, 0x7f, 0x83, 0x7c,
+		0x80, 0x84, 0x78, 0x88, 0x7d, 0x7e, 0x83, 0x7f, 0x83, 0x80, 0x7f, 0x80, 0x82, 0x7a, 0x89, 0x7c,
+		0x7f, 0x80, 0x82, 0x7d, 0x82, 0x80, 0x7f, 0x80, 0x82, 0x80, 0x7b, 0x88, 0x7a, 0x80, 0x86, 0x79,
+		0x83, 0x80, 0x81, 0x7d, 0x83, 0x7c, 0x81, 0x82, 0x7e, 0x80, 0x80, 0x82, 0x7f, 0x82, 0x7c, 0x81,
+		0x81, 0x81, 0x7c, 0x84, 0x7e, 0x7f, 0x80, 0x84, 0x7e, 0x7e, 0x82, 0x80, 0x81, 0x7d, 0x83, 0x80,
+		0x7c, 0x84, 0x7f, 0x80, 0x7f, 0x86, 0x79, 0x82, 0x80, 0x80, 0x81, 0x7f, 0x7d, 0x88, 0x76, 0x86,
+		0x80, 0x7f, 0x80, 0x82, 0x7c, 0x85, 0x7c, 0x83, 0x80, 0x7f, 0x7f, 0x84, 0x7e, 0x80, 0x80, 0x81,
+		0x7d, 0x82, 0x7f, 0x80, 0x82, 0x7f, 0x80, 0x81, 0x7d, 0x80, 0x80, 0x82, 0x7a, 0x87, 0x7e, 0x7f,
+		0x82, 0x7d, 0x83, 0x7e, 0x81, 0x80, 0x7f, 0x7f, 0x82, 0x80, 0x7e, 0x82, 0x81, 0x7d, 0x81, 0x80,
+		0x7f, 0x82, 0x7d, 0x83, 0x7c, 0x85, 0x79, 0x87, 0x7a, 0x82, 0x80, 0x81, 0x7d, 0x85, 0x7c, 0x82,
+		0x81, 0x7b, 0x86, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7c, 0x86, 0x7d, 0x82, 0x80, 0x7e, 0x81,
+		0x7f, 0x84, 0x7d, 0x7d, 0x87, 0x7b, 0x83, 0x7f, 0x7e, 0x86, 0x7b, 0x82, 0x80, 0x7b, 0x85, 0x7f,
+		0x7f, 0x80, 0x85, 0x7c, 0x81, 0x80, 0x7f, 0x83, 0x7e, 0x7c, 0x86, 0x80, 0x7b, 0x85, 0x7e, 0x7e,
+		0x82, 0x80, 0x80, 0x7d, 0x82, 0x80, 0x7f, 0x83, 0x80, 0x7d, 0x82, 0x7f, 0x80, 0x7f, 0x83, 0x7c,
+		0x84, 0x7d, 0x82, 0x80, 0x7e, 0x85, 0x7b, 0x82, 0x82, 0x7b, 0x82, 0x82, 0x7e, 0x81, 0x80, 0x81,
+		0x7b, 0x86, 0x7d, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x84, 0x7a, 0x86, 0x7c, 0x80, 0x83, 0x7c, 0x82,
+		0x80, 0x7f, 0x84, 0x7e, 0x7c, 0x85, 0x7e, 0x81, 0x80, 0x83, 0x7c, 0x80, 0x83, 0x7c, 0x82, 0x7e,
+		0x81, 0x7f, 0x81, 0x80, 0x80, 0x80, 0x7b, 0x88, 0x78, 0x84, 0x80, 0x7f, 0x85, 0x7d, 0x7d, 0x88,
+		0x79, 0x83, 0x7e, 0x82, 0x7d, 0x85, 0x79, 0x87, 0x7c, 0x7e, 0x85, 0x7f, 0x7e, 0x84, 0x7a, 0x85,
+		0x80, 0x7a, 0x89, 0x7d, 0x7e, 0x85, 0x7e, 0x7e, 0x84, 0x7e, 0x7e, 0x81, 0x81, 0x7d, 0x82, 0x80,
+		0x81, 0x7c, 0x82, 0x81, 0x7b, 0x84, 0x7e, 0x80, 0x80, 0x7d, 0x83, 0x80, 0x7c, 0x86, 0x7b, 0x80,
+		0x84, 0x7b, 0x86, 0x7a, 0x82, 0x85, 0x78, 0x86, 0x7d, 0x81, 0x7e, 0x86, 0x78, 0x83, 0x84, 0x7a,
+		0x84, 0x7e, 0x83, 0x7c, 0x84, 0x80, 0x7d, 0x82, 0x7f, 0x83, 0x7e, 0x80, 0x82, 0x7e, 0x85, 0x7a,
+		0x81, 0x81, 0x80, 0x80, 0x82, 0x7c, 0x84, 0x7d, 0x81, 0x7f, 0x81, 0x7f, 0x80, 0x81, 0x81, 0x7b,
+		0x86, 0x7e, 0x80, 0x81, 0x7e, 0x81, 0x81, 0x7e, 0x83, 0x80, 0x7e, 0x80, 0x81, 0x80, 0x7f, 0x80,
+		0x84, 0x7a, 0x84, 0x80, 0x7a, 0x86, 0x7e, 0x7f, 0x81, 0x80, 0x80, 0x81, 0x7e, 0x85, 0x7d, 0x7d,
+		0x8a, 0x77, 0x83, 0x81, 0x7f, 0x80, 0x81, 0x7e, 0x83, 0x80, 0x7d, 0x82, 0x83, 0x78, 0x85, 0x81,
+		0x7e, 0x7e, 0x87, 0x7a, 0x84, 0x7d, 0x82, 0x80, 0x7c, 0x83, 0x80, 0x80, 0x82, 0x7f, 0x80, 0x83,
+		0x7f, 0x7d, 0x85, 0x79, 0x85, 0x7f, 0x80, 0x81, 0x7d, 0x80, 0x81, 0x80, 0x80, 0x7c, 0x84, 0x7e,
+		0x83, 0x80, 0x7e, 0x82, 0x7c, 0x84, 0x7d, 0x82, 0x80, 0x7f, 0x80, 0x80, 0x84, 0x7d, 0x80, 0x80,
+		0x81, 0x7f, 0x80, 0x7e, 0x84, 0x7f, 0x7d, 0x85, 0x7d, 0x7f, 0x85, 0x78, 0x85, 0x81, 0x7d, 0x80,
+		0x80, 0x82, 0x7e, 0x80, 0x81, 0x7f, 0x7f, 0x80, 0x84, 0x7a, 0x86, 0x7e, 0x80, 0x80, 0x80, 0x81,
+		0x7e, 0x7e, 0x85, 0x7b, 0x85, 0x7e, 0x80, 0x80, 0x7f, 0x82, 0x80, 0x7a, 0x89, 0x79, 0x82, 0x84,
+		0x78, 0x86, 0x7f, 0x7e, 0x82, 0x81, 0x7e, 0x81, 0x83, 0x79, 0x85, 0x7f, 0x80, 0x81, 0x7c, 0x86,
+		0x7c, 0x81, 0x7f, 0x83, 0x7e, 0x81, 0x80, 0x7c, 0x87, 0x7b, 0x80, 0x83, 0x7c, 0x80, 0x87, 0x79,
+		0x80, 0x85, 0x78, 0x87, 0x7d, 0x80, 0x82, 0x7e, 0x81, 0x80, 0x82, 0x7b, 0x87, 0x7b, 0x80, 0x86,
+		0x7a, 0x83, 0x81, 0x7c, 0x83, 0x80, 0x7e, 0x80, 0x80, 0x80, 0x83, 0x7a, 0x83, 0x7f, 0x80, 0x7f,
+		0x84, 0x7b, 0x82, 0x7f, 0x80, 0x81, 0x7d, 0x85, 0x7d, 0x80, 0x80, 0x7c, 0x83, 0x83, 0x7c, 0x82,
+		0x7e, 0x82, 0x80, 0x7f, 0x83, 0x7b, 0x83, 0x82, 0x7b, 0x82, 0x7f, 0x81, 0x7e, 0x81, 0x82, 0x7a,
+		0x85, 0x80, 0x7e, 0x7f, 0x82, 0x80, 0x80, 0x7e, 0x83, 0x82, 0x7a, 0x85, 0x7f, 0x7f, 0x80, 0x83,
+		0x7b, 0x83, 0x7f, 0x81, 0x80, 0x7d, 0x85, 0x7c, 0x80, 0x83, 0x7a, 0x87, 0x7c, 0x80, 0x82, 0x81,
+		0x7e, 0x80, 0x82, 0x7a, 0x85, 0x7d, 0x82, 0x7d, 0x85, 0x7d, 0x7d, 0x88, 0x77, 0x87, 0x7f, 0x7d,
+		0x80, 0x83, 0x7d, 0x82, 0x7f, 0x7f, 0x81, 0x80, 0x7d, 0x86, 0x7d, 0x7d, 0x88, 0x7c, 0x80, 0x7f,
+		0x82, 0x7f, 0x82, 0x7d, 0x80, 0x84, 0x7a, 0x82, 0x82, 0x7d, 0x83, 0x7e, 0x80, 0x80, 0x82, 0x7d,
+		0x84, 0x7f, 0x7e, 0x84, 0x7f, 0x7b, 0x89, 0x78, 0x82, 0x81, 0x80, 0x80, 0x7c, 0x84, 0x80, 0x80,
+		0x7d, 0x82, 0x82, 0x7c, 0x83, 0x7c, 0x82, 0x80, 0x7f, 0x83, 0x7a, 0x84, 0x7f, 0x80, 0x81, 0x80,
+		0x7c, 0x83, 0x80, 0x7f, 0x82, 0x80, 0x7f, 0x80, 0x84, 0x7d, 0x7d, 0x85, 0x80, 0x7c, 0x83, 0x83,
+		0x7b, 0x82, 0x7e, 0x83, 0x7b, 0x86, 0x7e, 0x7e, 0x85, 0x7c, 0x82, 0x80, 0x7d, 0x82, 0x7e, 0x82,
+		0x7f, 0x81, 0x7d, 0x82, 0x7e, 0x82, 0x82, 0x7f, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x83, 0x7d,
+		0x81, 0x7e, 0x7e, 0x86, 0x79, 0x84, 0x80, 0x82, 0x7d, 0x84, 0x7d, 0x83, 0x7d, 0x82, 0x82, 0x7e,
+		0x7c, 0x84, 0x80, 0x7f, 0x84, 0x7c, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x83, 0x7f, 0x7e, 0x83, 0x80,
+		0x7e, 0x82, 0x81, 0x7c, 0x86, 0x7d, 0x7e, 0x84, 0x7d, 0x7f, 0x85, 0x7e, 0x80, 0x7e, 0x81, 0x81,
+		0x80, 0x80, 0x7f, 0x82, 0x80, 0x80, 0x80, 0x7e, 0x84, 0x81, 0x87, 0x83, 0x80, 0x7a, 0x7d, 0x7b,
+		0x7d, 0x83, 0x7d, 0x81, 0x80, 0x77, 0x81, 0x81, 0x7e, 0x89, 0x81, 0x80, 0x85, 0x7c, 0x80, 0x82,
+		0x7e, 0x84, 0x7e, 0x80, 0x7d, 0x7e, 0x80, 0x7b, 0x84, 0x7f, 0x80, 0x82, 0x7f, 0x80, 0x81, 0x7e,
+		0x84, 0x84, 0x7f, 0x7f, 0x82, 0x80, 0x7b, 0x84, 0x7e, 0x81, 0x80, 0x7d, 0x82, 0x7e, 0x7f, 0x82,
+		0x82, 0x7e, 0x81, 0x83, 0x7e, 0x7e, 0x84, 0x7e, 0x80, 0x82, 0x7e, 0x80, 0x7e, 0x87, 0x8e, 0x87,
+		0x7f, 0x78, 0x78, 0x71, 0x7b, 0x7f, 0x82, 0x81, 0x83, 0x7b, 0x80, 0x7d, 0x83, 0x86, 0x88, 0x88,
+		0x7f, 0x85, 0x77, 0x7d, 0x82, 0x7a, 0x83, 0x82, 0x7a, 0x7e, 0x7d, 0x7c, 0x82, 0x80, 0x86, 0x81,
+		0x85, 0x80, 0x7e, 0x82, 0x80, 0x7f, 0x84, 0x80, 0x7b, 0x80, 0x7f, 0x7c, 0x7e, 0x83, 0x7f, 0x82,
+		0x80, 0x80, 0x81, 0x7f, 0x80, 0x84, 0x7f, 0x83, 0x7f, 0x80, 0x80, 0x7a, 0x82, 0x7e, 0x80, 0x81,
+		0x7e, 0x80, 0x8b, 0x94, 0x8f, 0x7d, 0x78, 0x71, 0x68, 0x70, 0x7d, 0x85, 0x81, 0x88, 0x80, 0x80,
+		0x7a, 0x85, 0x88, 0x8a, 0x8b, 0x87, 0x80, 0x78, 0x76, 0x77, 0x7b, 0x7c, 0x84, 0x80, 0x7f, 0x7f,
+		0x7d, 0x80, 0x83, 0x87, 0x88, 0x83, 0x85, 0x80, 0x7a, 0x7c, 0x7e, 0x7d, 0x80, 0x80, 0x7e, 0x7f,
+		0x7f, 0x7e, 0x80, 0x84, 0x80, 0x87, 0x83, 0x7f, 0x81, 0x7e, 0x7e, 0x7e, 0x7e, 0x82, 0x7c, 0x82,
+		0x7e, 0x7e, 0x80, 0x80, 0x82, 0x81, 0x83, 0x80, 0x91, 0x9d, 0x91, 0x7c, 0x72, 0x65, 0x56, 0x67,
+		0x76, 0x86, 0x8c, 0x92, 0x8f, 0x84, 0x7e, 0x86, 0x83, 0x89, 0x8b, 0x88, 0x80, 0x72, 0x71, 0x6e,
+		0x70, 0x7b, 0x85, 0x87, 0x8b, 0x87, 0x85, 0x81, 0x81, 0x84, 0x80, 0x85, 0x81, 0x7e, 0x79, 0x77,
+		0x77, 0x78, 0x7d, 0x83, 0x85, 0x87, 0x86, 0x84, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x7d,
+		0x7b, 0x7c, 0x7c, 0x80, 0x80, 0x85, 0x86, 0x82, 0x83, 0x81, 0x7f, 0x80, 0x7f, 0x7e, 0x96, 0xa4,
+		0x95, 0x7f, 0x6d, 0x57, 0x48, 0x58, 0x75, 0x87, 0x9b, 0xaa, 0xa1, 0x8c, 0x83, 0x7c, 0x76, 0x7b,
+		0x83, 0x83, 0x7e, 0x79, 0x70, 0x6b, 0x6e, 0x7b, 0x86, 0x90, 0x9a, 0x96, 0x8b, 0x84, 0x7a, 0x73,
+		0x74, 0x77, 0x7d, 0x7e, 0x7f, 0x80, 0x7d, 0x7e, 0x80, 0x83, 0x87, 0x8a, 0x8a, 0x86, 0x80, 0x7c,
+		0x77, 0x74, 0x78, 0x7d, 0x7f, 0x84, 0x85, 0x84, 0x83, 0x83, 0x80, 0x80, 0x81, 0x81, 0x80, 0x80,
+		0x7e, 0x7c, 0x7a, 0x7d, 0x9a, 0xb0, 0xa1, 0x86, 0x70, 0x4e, 0x35, 0x46, 0x65, 0x81, 0xa6, 0xbe,
+		0xb8, 0xa2, 0x8e, 0x76, 0x61, 0x64, 0x6f, 0x75, 0x7e, 0x84, 0x7f, 0x77, 0x78, 0x7c, 0x80, 0x8e,
+		0x9a, 0x98, 0x93, 0x88, 0x78, 0x69, 0x65, 0x68, 0x6f, 0x7e, 0x8a, 0x90, 0x91, 0x8f, 0x87, 0x80,
+		0x7d, 0x7c, 0x7c, 0x7d, 0x7f, 0x7d, 0x7b, 0x7a, 0x7b, 0x7c, 0x81, 0x86, 0x8a, 0x8b, 0x89, 0x84,
+		0x7f, 0x7a, 0x76, 0x76, 0x79, 0x7d, 0x81, 0x84, 0x86, 0x86, 0x84, 0xa4, 0xb9, 0xa0, 0x83, 0x66,
+		0x37, 0x1c, 0x32, 0x56, 0x80, 0xba, 0xe0, 0xda, 0xc3, 0xa0, 0x6d, 0x47, 0x3f, 0x45, 0x56, 0x77,
+		0x90, 0x98, 0x9a, 0x97, 0x8a, 0x82, 0x85, 0x87, 0x87, 0x88, 0x83, 0x75, 0x6a, 0x65, 0x63, 0x6c,
+		0x7f, 0x90, 0x9c, 0xa3, 0x9f, 0x8f, 0x80, 0x71, 0x67, 0x65, 0x6d, 0x77, 0x80, 0x89, 0x8d, 0x8b,
+		0x88, 0x85, 0x82, 0x80, 0x81, 0x80, 0x7f, 0x7d, 0x7a, 0x77, 0x77, 0x7a, 0x7f, 0x84, 0x8a, 0x8c,
+		0x8b, 0x88, 0x81, 0x9e, 0xb1, 0x98, 0x7c, 0x62, 0x36, 0x20, 0x38, 0x5e, 0x88, 0xc0, 0xe3, 0xda,
+		0xc0, 0x9b, 0x68, 0x41, 0x3b, 0x43, 0x56, 0x79, 0x94, 0x9c, 0x9e, 0x9a, 0x8c, 0x83, 0x84, 0x85,
+		0x83, 0x85, 0x80, 0x73, 0x69, 0x66, 0x66, 0x6f, 0x82, 0x93, 0x9e, 0xa3, 0x9d, 0x8d, 0x7d, 0x6f,
+		0x65, 0x64, 0x6d, 0x78, 0x81, 0x8b, 0x8f, 0x8d, 0x89, 0x85, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7c,
+		0x79, 0x77, 0x78, 0x7b, 0x80, 0x85, 0x8a, 0x8c, 0x8b, 0x87, 0x80, 0x9d, 0xb0, 0x97, 0x7c, 0x62,
+		0x37, 0x20, 0x39, 0x5f, 0x88, 0xc0, 0xe2, 0xda, 0xc0, 0x9a, 0x67, 0x41, 0x3b, 0x43, 0x57, 0x79,
+		0x94, 0x9c, 0x9e, 0x9a, 0x8c, 0x82, 0x84, 0x84, 0x83, 0x84, 0x80, 0x73, 0x6a, 0x66, 0x66, 0x70,
+		0x82, 0x93, 0x9e, 0xa3, 0x9d, 0x8d, 0x7d, 0x6f, 0x65, 0x64, 0x6d, 0x78, 0x81, 0x8b, 0x8f, 0x8d,
+		0x89, 0x85, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x7c, 0x7a, 0x77, 0x78, 0x7b, 0x80, 0x85, 0x8a, 0x8c,
+		0x8b, 0x87, 0x80, 0x7b, 0x99, 0xaf, 0x99, 0x80, 0x67, 0x3b, 0x23, 0x3a, 0x5e, 0x86, 0xbe, 0xe1,
+		0xd9, 0xbf, 0x9a, 0x67, 0x40, 0x3b, 0x43, 0x58, 0x7b, 0x96, 0x9e, 0x9f, 0x9a, 0x8b, 0x80, 0x81,
+		0x82, 0x82, 0x85, 0x81, 0x75, 0x6b, 0x67, 0x66, 0x6f, 0x81, 0x92, 0x9d, 0xa2, 0x9d, 0x8d, 0x7d,
+		0x6f, 0x65, 0x65, 0x6d, 0x78, 0x82, 0x8b, 0x8f, 0x8d, 0x89, 0x85, 0x80, 0x7f, 0x80, 0x7f, 0x7e,
+		0x7d, 0x7a, 0x78, 0x78, 0x7b, 0x80, 0x84, 0x8a, 0x8c, 0x8a, 0x86, 0x80, 0x7b, 0x99, 0xaf, 0x99,
+		0x80, 0x67, 0x3b, 0x23, 0x3a, 0x5e, 0x86, 0xbe, 0xe0, 0xd8, 0xbf, 0x9a, 0x67, 0x41, 0x3b, 0x44,
+		0x58, 0x7b, 0x96, 0x9e, 0x9f, 0x9a, 0x8b, 0x80, 0x81, 0x82, 0x82, 0x85, 0x81, 0x75, 0x6c, 0x67,
+		0x66, 0x6f, 0x81, 0x91, 0x9d, 0xa2, 0x9d, 0x8d, 0x7d, 0x6f, 0x65, 0x65, 0x6d, 0x78, 0x82, 0x8b,
+		0x8f, 0x8c, 0x89, 0x85, 0x80, 0x7f, 0x80, 0x7f, 0x7e, 0x7d, 0x7a, 0x78, 0x78, 0x7b, 0x80, 0x84,
+		0x8a, 0x8c, 0x8a, 0x86, 0x80, 0x7b, 0x77, 0x98, 0xb1, 0x9d, 0x84, 0x6b, 0x3d, 0x24, 0x39, 0x5b,
+		0x84, 0xbc, 0xdf, 0xd8, 0xbf, 0x9a, 0x66, 0x41, 0x3b, 0x44, 0x59, 0x7d, 0x98, 0x9f, 0x9f, 0x99,
+		0x89, 0x7f, 0x80, 0x81, 0x82, 0x86, 0x83, 0x77, 0x6d, 0x68, 0x66, 0x6f, 0x80, 0x91, 0x9c, 0xa2,
+		0x9d, 0x8d, 0x7d, 0x6f, 0x65, 0x65, 0x6e, 0x79, 0x83, 0x8c, 0x8f, 0x8c, 0x88, 0x84, 0x80, 0x7f,
+		0x80, 0x80, 0x7f, 0x7d, 0x7b, 0x78, 0x78, 0x7b, 0x7f, 0x84, 0x89, 0x8c, 0x8a, 0x87, 0x80, 0x7b,
+		0x77, 0x76, 0x99, 0xb5, 0xa2, 0x88, 0x6e, 0x3e, 0x22, 0x36, 0x59, 0x82, 0xbb, 0xdf, 0xd8, 0xbf,
+		0x9a, 0x66, 0x41, 0x3c, 0x46, 0x5b, 0x7e, 0x98, 0x9f, 0x9d, 0x97, 0x86, 0x7d, 0x80, 0x82, 0x84,
+		0x88, 0x84, 0x78, 0x6d, 0x68, 0x65, 0x6e, 0x80, 0x90, 0x9c, 0xa2, 0x9d, 0x8d, 0x7d, 0x6f, 0x66,
+		0x65, 0x6e, 0x79, 0x83, 0x8c, 0x8e, 0x8b, 0x87, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x7f, 0x7e, 0x7b,
+		0x78, 0x78, 0x7b, 0x7f, 0x84, 0x89, 0x8b, 0x8a, 0x87, 0x81, 0x7b, 0x77, 0x76, 0x78, 0x9d, 0xba,
+		0xa6, 0x8a, 0x6e, 0x3c, 0x20, 0x34, 0x58, 0x81, 0xbb, 0xe0, 0xd8, 0xbf, 0x9a, 0x66, 0x41, 0x3d,
+		0x47, 0x5c, 0x7f, 0x98, 0x9d, 0x9b, 0x94, 0x85, 0x7c, 0x80, 0x83, 0x85, 0x8a, 0x86, 0x79, 0x6d,
+		0x67, 0x64, 0x6d, 0x7f, 0x90, 0x9c, 0xa2, 0x9d, 0x8d, 0x7d, 0x70, 0x66, 0x66, 0x6f, 0x7a, 0x83,
+		0x8b, 0x8e, 0x8b, 0x87, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x7e, 0x7b, 0x78, 0x78, 0x7a, 0x7f,
+		0x83, 0x89, 0x8c, 0x8a, 0x87, 0x81, 0x7b, 0x77, 0x76, 0x78, 0x9e, 0xba, 0xa6, 0x8a, 0x6e, 0x3c,
+		0x1f, 0x34, 0x58, 0x81, 0xbb, 0xe0, 0xd8, 0xbf, 0x9a, 0x66, 0x41, 0x3d, 0x47, 0x5c, 0x7f, 0x98,
+		0x9d, 0x9b, 0x95, 0x85, 0x7c, 0x80, 0x83, 0x85, 0x8a, 0x86, 0x79, 0x6d, 0x67, 0x64, 0x6d, 0x7f,
+		0x90, 0x9c, 0xa2, 0x9d, 0x8d, 0x7d, 0x70, 0x66, 0x66, 0x6f, 0x7a, 0x83, 0x8b, 0x8e, 0x8b, 0x87,
+		0x83, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x7e, 0x7b, 0x78, 0x78, 0x7a, 0x7f, 0x83, 0x89, 0x8c, 0x8a,
+		0x87, 0x81, 0x7b, 0x77, 0x76, 0x78, 0x7c, 0xa2, 0xbd, 0xa8, 0x8a, 0x6c, 0x3a, 0x1e, 0x33, 0x57,
+		0x81, 0xbb, 0xdf, 0xd8, 0xbe, 0x99, 0x67, 0x43, 0x3f, 0x49, 0x5d, 0x7f, 0x97, 0x9b, 0x99, 0x93,
+		0x84, 0x7d, 0x80, 0x85, 0x87, 0x8b, 0x86, 0x78, 0x6c, 0x66, 0x64, 0x6c, 0x7f, 0x90, 0x9c, 0xa2,
+		0x9d, 0x8d, 0x7e, 0x70, 0x66, 0x66, 0x6f, 0x7a, 0x83, 0x8b, 0x8d, 0x8a, 0x86, 0x83, 0x80, 0x80,
+		0x80, 0x81, 0x80, 0x7e, 0x7b, 0x78, 0x77, 0x7a, 0x7e, 0x83, 0x89, 0x8c, 0x8b, 0x87, 0x81, 0x7b,
+		0x77, 0x76, 0x78, 0x7c, 0xa2, 0xbd, 0xa7, 0x8a, 0x6c, 0x3a, 0x1e, 0x33, 0x58, 0x82, 0xbc, 0xdf,
+		0xd8, 0xbe, 0x99, 0x67, 0x42, 0x3f, 0x49, 0x5e, 0x80, 0x97, 0x9b, 0x99, 0x93, 0x84, 0x7d, 0x80,
+		0x85, 0x87, 0x8b, 0x86, 0x78, 0x6c, 0x66, 0x64, 0x6c, 0x7f, 0x90, 0x9b, 0xa1, 0x9c, 0x8c, 0x7d,
+		0x71, 0x69, 0x6a, 0x73, 0x7d, 0x83, 0x87, 0x88, 0x84, 0x80, 0x80, 0x81, 0x84, 0x87, 0x88, 0x85,
+		0x7f, 0x79, 0x75, 0x73, 0x76, 0x7c, 0x82, 0x88, 0x8a, 0x89, 0x85, 0x81, 0x7e, 0x7d, 0x7d, 0x7f,
+		0x80, 0x80, 0x7f, 0x96, 0xae, 0xa1, 0x80, 0x68, 0x53, 0x3f, 0x45, 0x69, 0x8c, 0x9f, 0xab, 0xad,
+		0x9a, 0x80, 0x76, 0x78, 0x7b, 0x80, 0x88, 0x88, 0x7b, 0x6b, 0x65, 0x67, 0x6f, 0x7e, 0x91, 0x9c,
+		0x9a, 0x91, 0x87, 0x7e, 0x77, 0x77, 0x7e, 0x82, 0x82, 0x7f, 0x7a, 0x74, 0x72, 0x75, 0x7f, 0x87,
+		0x8d, 0x8e, 0x8b, 0x85, 0x7f, 0x7c, 0x7d, 0x80, 0x81, 0x81, 0x80, 0x7d, 0x79, 0x77, 0x79, 0x7e,
+		0x82, 0x86, 0x87, 0x86, 0x82, 0x80, 0x7f, 0x80, 0x81, 0x82, 0x81, 0x80, 0x7e, 0x7c, 0x7b, 0x7c,
+		0x7f, 0x80, 0x8f, 0xa4, 0xa4, 0x88, 0x69, 0x5b, 0x61, 0x6a, 0x75, 0x82, 0x8e, 0x8c, 0x7d, 0x70,
+		0x72, 0x80, 0x8e, 0x95, 0x96, 0x92, 0x87, 0x7a, 0x71, 0x73, 0x7e, 0x85, 0x85, 0x80, 0x79, 0x74,
+		0x73, 0x76, 0x7e, 0x87, 0x8c, 0x8a, 0x83, 0x7e, 0x7d, 0x80, 0x84, 0x87, 0x87, 0x84, 0x80, 0x7a,
+		0x77, 0x79, 0x7e, 0x81, 0x82, 0x80, 0x7d, 0x7b, 0x7c, 0x7f, 0x81, 0x84, 0x85, 0x84, 0x81, 0x7f,
+		0x7f, 0x80, 0x82, 0x83, 0x82, 0x80, 0x7e, 0x7d, 0x7d, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x7e,
+		0x7f, 0x80, 0x87, 0x93, 0x99, 0x8f, 0x79, 0x66, 0x66, 0x77, 0x8b, 0x91, 0x86, 0x74, 0x68, 0x6c,
+		0x79, 0x86, 0x8c, 0x89, 0x82, 0x7d, 0x7b, 0x7d, 0x82, 0x89, 0x8c, 0x8b, 0x84, 0x7c, 0x78, 0x7a,
+		0x80, 0x86, 0x86, 0x80, 0x7a, 0x76, 0x78, 0x7d, 0x82, 0x84, 0x81, 0x7f, 0x7c, 0x7d, 0x7f, 0x82,
+		0x84, 0x85, 0x83, 0x81, 0x7f, 0x7f, 0x80, 0x82, 0x83, 0x82, 0x80, 0x7e, 0x7c, 0x7d, 0x80, 0x81,
+		0x81, 0x80, 0x7f, 0x7e, 0x7e, 0x80, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81,
+		0x81, 0x80, 0x80, 0x83, 0x8d, 0x94, 0x8e, 0x7d, 0x6a, 0x66, 0x74, 0x87, 0x90, 0x87, 0x75, 0x69,
+		0x6d, 0x7b, 0x88, 0x8c, 0x87, 0x80, 0x7d, 0x7e, 0x80, 0x84, 0x87, 0x88, 0x88, 0x84, 0x7f, 0x7a,
+		0x7b, 0x7f, 0x84, 0x85, 0x81, 0x7b, 0x77, 0x78, 0x7e, 0x82, 0x83, 0x81, 0x7f, 0x7d, 0x7e, 0x80,
+		0x82, 0x84, 0x84, 0x83, 0x81, 0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x80, 0x7e, 0x7d, 0x7e, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81,
+		0x81, 0x81, 0x80, 0x80, 0x83, 0x8d, 0x94, 0x8e, 0x7d, 0x6a, 0x66, 0x74, 0x87, 0x90, 0x87, 0x75,
+		0x69, 0x6d, 0x7b, 0x88, 0x8c, 0x87, 0x80, 0x7d, 0x7e, 0x80, 0x84, 0x87, 0x88, 0x88, 0x84, 0x7f,
+		0x7a, 0x7b, 0x7f, 0x84, 0x85, 0x81, 0x7b, 0x77, 0x78, 0x7e, 0x82, 0x83, 0x81, 0x7f, 0x7d, 0x7e,
+		0x80, 0x82, 0x84, 0x84, 0x83, 0x81, 0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x80, 0x7e, 0x7d, 0x7e,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x81, 0x81, 0x81, 0x80, 0x80, 0x7f, 0x80, 0x84, 0x8e, 0x94, 0x8d, 0x7b, 0x69, 0x66, 0x75, 0x88,
+		0x91, 0x87, 0x75, 0x69, 0x6d, 0x7c, 0x89, 0x8d, 0x88, 0x80, 0x7c, 0x7d, 0x80, 0x84, 0x87, 0x89,
+		0x88, 0x83, 0x7e, 0x7a, 0x7b, 0x80, 0x84, 0x85, 0x80, 0x7b, 0x78, 0x79, 0x7e, 0x82, 0x83, 0x81,
+		0x7f, 0x7d, 0x7e, 0x80, 0x82, 0x83, 0x83, 0x82, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x81, 0x80,
+		0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x83, 0x85, 0x83, 0x7f, 0x7b,
+		0x7b, 0x7e, 0x81, 0x82, 0x81, 0x7f, 0x7e, 0x7e, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80,
+	},
+	{ // 5
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x81, 0x7f, 0x81, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80,
+		0x7f, 0x80, 0x81, 0x7e, 0x82, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x82, 0x7f, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x82, 0x7e, 0x82, 0x7d, 0x82, 0x80,
+		0x80, 0x7f, 0x81, 0x80, 0x80, 0x7f, 0x82, 0x7e, 0x82, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x81, 0x7f,
+		0x80, 0x82, 0x7e, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x81, 0x7e, 0x82, 0x80, 0x80, 0x80, 0x81, 0x80,
+		0x7e, 0x82, 0x80, 0x80, 0x81, 0x7f, 0x82, 0x7f, 0x7f, 0x82, 0x80, 0x80, 0x82, 0x7d, 0x82, 0x80,
+		0x7f, 0x81, 0x80, 0x80, 0x80, 0x7f, 0x82, 0x80, 0x7f, 0x82, 0x7e, 0x80, 0x80, 0x80, 0x81, 0x7f,
+		0x7f, 0x80, 0x81, 0x80, 0x80, 0x81, 0x7e, 0x82, 0x7f, 0x80, 0x81, 0x7e, 0x83, 0x7e, 0x82, 0x7f,
+		0x81, 0x7f, 0x82, 0x80, 0x7f, 0x82, 0x7e, 0x81, 0x7f, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x7f,
+		0x7f, 0x84, 0x7c, 0x81, 0x81, 0x7d, 0x83, 0x80, 0x7e, 0x81, 0x7f, 0x81, 0x7e, 0x82, 0x81, 0x7e,
+		0x81, 0x80, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x81, 0x7f, 0x80, 0x80, 0x82, 0x7e, 0x80, 0x7f, 0x81,
+		0x80, 0x80, 0x7f, 0x82, 0x7f, 0x80, 0x82, 0x7d, 0x83, 0x7f, 0x80, 0x80, 0x7e, 0x81, 0x81, 0x7f,
+		0x80, 0x81, 0x7d, 0x82, 0x80, 0x7f, 0x80, 0x80, 0x7e, 0x83, 0x7f, 0x81, 0x80, 0x80, 0x7f, 0x81,
+		0x81, 0x7f, 0x80, 0x82, 0x7f, 0x7f, 0x82, 0x7f, 0x80, 0x81, 0x7e, 0x82, 0x7e, 0x80, 0x82, 0x7d,
+		0x83, 0x7e, 0x82, 0x7f, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x81, 0x80, 0x80, 0x80,
+		0x7f, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x7f,
+		0x81, 0x80, 0x7f, 0x82, 0x7f, 0x80, 0x7f, 0x81, 0x7f, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x81,
+		0x7e, 0x81, 0x80, 0x7e, 0x82, 0x80, 0x7d, 0x82, 0x80, 0x80, 0x81, 0x7f, 0x81, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x81, 0x80, 0x7e, 0x81, 0x80, 0x7e, 0x82, 0x7f, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x82, 0x7d, 0x82, 0x80, 0x80, 0x7f, 0x82,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x7d, 0x81, 0x80, 0x7e, 0x82, 0x80, 0x7d, 0x83, 0x80, 0x7d, 0x82,
+		0x80, 0x80, 0x80, 0x81, 0x7f, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f,
+		0x80, 0x80, 0x82, 0x7e, 0x81, 0x7e, 0x81, 0x7f, 0x80, 0x80, 0x81, 0x7e, 0x81, 0x80, 0x81, 0x80,
+		0x7e, 0x82, 0x7f, 0x7f, 0x81, 0x7f, 0x80, 0x81, 0x7e, 0x81, 0x80, 0x7e, 0x82, 0x80, 0x7e, 0x82,
+		0x80, 0x7d, 0x83, 0x80, 0x7d, 0x83, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x7f, 0x80, 0x83, 0x7d, 0x80,
+		0x80, 0x80, 0x7e, 0x81, 0x81, 0x7d, 0x84, 0x7e, 0x80, 0x80, 0x81, 0x7e, 0x81, 0x7f, 0x80, 0x81,
+		0x80, 0x81, 0x7e, 0x83, 0x7e, 0x81, 0x7f, 0x81, 0x7e, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f,
+		0x81, 0x80, 0x7f, 0x82, 0x80, 0x7e, 0x82, 0x80, 0x7d, 0x84, 0x7f, 0x7f, 0x81, 0x80, 0x7f, 0x82,
+		0x7e, 0x80, 0x81, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x7f, 0x82, 0x7f, 0x7e, 0x81, 0x81, 0x7f, 0x7f,
+		0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x82, 0x7e, 0x81, 0x80, 0x7e, 0x81,
+		0x81, 0x7f, 0x80, 0x82, 0x7d, 0x81, 0x83, 0x7d, 0x81, 0x82, 0x7d, 0x80, 0x82, 0x7d, 0x81, 0x82,
+		0x7d, 0x82, 0x80, 0x7f, 0x81, 0x7f, 0x81, 0x7f, 0x80, 0x82, 0x7e, 0x80, 0x81, 0x7f, 0x80, 0x7f,
+		0x80, 0x80, 0x7f, 0x80, 0x82, 0x7e, 0x80, 0x80, 0x7e, 0x83, 0x7f, 0x80, 0x7f, 0x82, 0x7f, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x83, 0x7d, 0x81, 0x80, 0x7e, 0x81, 0x80, 0x7d, 0x84, 0x7e,
+		0x80, 0x80, 0x82, 0x7d, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x7e, 0x82, 0x80, 0x7d, 0x84, 0x7d, 0x81,
+		0x80, 0x80, 0x81, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x82, 0x7f, 0x7e, 0x83, 0x80, 0x7d, 0x83, 0x7f,
+		0x7f, 0x81, 0x80, 0x7e, 0x83, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81,
+		0x80, 0x7f, 0x80, 0x82, 0x7f, 0x80, 0x7f, 0x80, 0x82, 0x7e, 0x80, 0x80, 0x7f, 0x81, 0x7f, 0x80,
+		0x80, 0x81, 0x7d, 0x82, 0x7e, 0x82, 0x7d, 0x83, 0x7f, 0x80, 0x82, 0x7d, 0x82, 0x80, 0x7f, 0x80,
+		0x81, 0x81, 0x7e, 0x80, 0x82, 0x7d, 0x83, 0x7f, 0x80, 0x82, 0x7e, 0x83, 0x7f, 0x7e, 0x84, 0x7d,
+		0x82, 0x7e, 0x80, 0x81, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x7e, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x82, 0x7d, 0x81, 0x81, 0x7f, 0x80, 0x81, 0x7f,
+		0x80, 0x80, 0x80, 0x82, 0x7e, 0x81, 0x7f, 0x80, 0x81, 0x7f, 0x80, 0x81, 0x7f, 0x81, 0x80, 0x80,
+		0x81, 0x80, 0x7f, 0x82, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x7f, 0x83, 0x7e, 0x7f, 0x81, 0x81,
+		0x7d, 0x84, 0x7d, 0x82, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x83, 0x7d,
+		0x81, 0x81, 0x7f, 0x80, 0x82, 0x7e, 0x80, 0x81, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x7f, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x83, 0x7d, 0x80, 0x81, 0x7e,
+		0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x7e, 0x83, 0x7e, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x7e, 0x83,
+		0x7e, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x7e, 0x82, 0x7e, 0x80, 0x81, 0x80, 0x7e,
+		0x82, 0x80, 0x7e, 0x83, 0x7e, 0x81, 0x80, 0x7e, 0x83, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x7e, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x7d, 0x82,
+		0x7f, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80,
+		0x7f, 0x81, 0x7f, 0x80, 0x81, 0x7e, 0x80, 0x81, 0x7e, 0x81, 0x80, 0x80, 0x80, 0x7f, 0x82, 0x7f,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x82, 0x7f, 0x80, 0x7f, 0x80, 0x81, 0x7f, 0x80, 0x82, 0x7d, 0x80,
+		0x82, 0x7e, 0x80, 0x81, 0x7f, 0x81, 0x7e, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x82, 0x7f,
+		0x7f, 0x84, 0x7c, 0x82, 0x80, 0x7f, 0x81, 0x80, 0x7f, 0x81, 0x7f, 0x81, 0x80, 0x7f, 0x81, 0x7f,
+		0x80, 0x81, 0x7f, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x82, 0x7e, 0x80, 0x80, 0x82,
+		0x83, 0x83, 0x7e, 0x7d, 0x7f, 0x7d, 0x7d, 0x82, 0x80, 0x80, 0x7f, 0x7e, 0x81, 0x80, 0x81, 0x85,
+		0x80, 0x80, 0x80, 0x7e, 0x81, 0x7f, 0x81, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x7f, 0x82, 0x80, 0x80,
+		0x81, 0x80, 0x7e, 0x83, 0x80, 0x80, 0x80, 0x80, 0x7d, 0x81, 0x7f, 0x80, 0x80, 0x7e, 0x81, 0x80,
+		0x7e, 0x82, 0x80, 0x80, 0x82, 0x7f, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x7d,
+		0x82, 0x86, 0x89, 0x87, 0x7b, 0x77, 0x78, 0x78, 0x7f, 0x82, 0x84, 0x81, 0x7e, 0x7f, 0x81, 0x82,
+		0x86, 0x88, 0x82, 0x7d, 0x7e, 0x7b, 0x7c, 0x80, 0x81, 0x80, 0x7d, 0x80, 0x7f, 0x80, 0x82, 0x86,
+		0x82, 0x81, 0x80, 0x7f, 0x7e, 0x7e, 0x81, 0x80, 0x80, 0x7e, 0x7e, 0x80, 0x7f, 0x83, 0x82, 0x82,
+		0x80, 0x80, 0x80, 0x7e, 0x81, 0x80, 0x7e, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x81, 0x80, 0x83, 0x7f,
+		0x80, 0x80, 0x7f, 0x80, 0x8b, 0x93, 0x84, 0x74, 0x6e, 0x6e, 0x6f, 0x7d, 0x8e, 0x8a, 0x85, 0x83,
+		0x80, 0x7f, 0x84, 0x8a, 0x86, 0x7e, 0x78, 0x75, 0x73, 0x7b, 0x83, 0x86, 0x86, 0x83, 0x83, 0x7e,
+		0x81, 0x85, 0x82, 0x81, 0x7e, 0x79, 0x78, 0x7b, 0x80, 0x83, 0x84, 0x83, 0x84, 0x7f, 0x80, 0x82,
+		0x80, 0x80, 0x80, 0x7d, 0x7b, 0x7d, 0x7f, 0x81, 0x83, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x80,
+		0x80, 0x7f, 0x7f, 0x7e, 0x80, 0x80, 0x82, 0x82, 0x96, 0x9f, 0x84, 0x69, 0x60, 0x5d, 0x65, 0x83,
+		0x9c, 0x98, 0x90, 0x89, 0x7d, 0x77, 0x82, 0x87, 0x80, 0x78, 0x73, 0x6d, 0x70, 0x83, 0x90, 0x93,
+		0x91, 0x88, 0x7c, 0x75, 0x79, 0x7b, 0x7e, 0x80, 0x7e, 0x7c, 0x7c, 0x82, 0x88, 0x8a, 0x8b, 0x84,
+		0x7c, 0x76, 0x77, 0x79, 0x7f, 0x83, 0x85, 0x81, 0x82, 0x81, 0x82, 0x82, 0x83, 0x80, 0x7c, 0x7b,
+		0x7a, 0x7c, 0x81, 0x84, 0x85, 0x85, 0x81, 0x80, 0x7e, 0x7e, 0x7f, 0x7f, 0x9e, 0xab, 0x80, 0x5a,
+		0x50, 0x4b, 0x61, 0x98, 0xb9, 0xae, 0x9d, 0x84, 0x65, 0x5f, 0x75, 0x7f, 0x80, 0x82, 0x7c, 0x73,
+		0x7e, 0x91, 0x98, 0x97, 0x8e, 0x77, 0x62, 0x63, 0x6f, 0x7e, 0x8f, 0x97, 0x8f, 0x84, 0x7f, 0x7b,
+		0x7b, 0x80, 0x80, 0x7a, 0x78, 0x79, 0x7d, 0x86, 0x8e, 0x8f, 0x87, 0x7f, 0x76, 0x71, 0x76, 0x7e,
+		0x83, 0x87, 0x87, 0x83, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7d, 0x7b, 0x7c, 0x80, 0x83, 0x86, 0x87,
+		0xb3, 0xba, 0x74, 0x3d, 0x2f, 0x2d, 0x61, 0xbc, 0xe4, 0xd1, 0xad, 0x72, 0x39, 0x3b, 0x60, 0x7b,
+		0x92, 0xa0, 0x8d, 0x7d, 0x86, 0x8f, 0x8f, 0x8f, 0x7f, 0x60, 0x54, 0x62, 0x78, 0x96, 0xaf, 0xaa,
+		0x90, 0x78, 0x65, 0x5e, 0x6c, 0x80, 0x88, 0x8b, 0x8a, 0x83, 0x81, 0x87, 0x88, 0x83, 0x7c, 0x73,
+		0x6c, 0x71, 0x7f, 0x8b, 0x94, 0x94, 0x89, 0x7b, 0x73, 0x72, 0x76, 0x7e, 0x84, 0x85, 0x84, 0x83,
+		0x82, 0x82, 0x83, 0x81, 0x7d, 0xa8, 0xb2, 0x73, 0x44, 0x3b, 0x39, 0x68, 0xbd, 0xde, 0xc7, 0xa5,
+		0x6d, 0x37, 0x3d, 0x65, 0x80, 0x96, 0xa3, 0x8f, 0x7c, 0x83, 0x89, 0x89, 0x8c, 0x7f, 0x63, 0x5a,
+		0x67, 0x7b, 0x96, 0xac, 0xa6, 0x8c, 0x76, 0x64, 0x5f, 0x6e, 0x81, 0x8a, 0x8c, 0x8b, 0x83, 0x80,
+		0x84, 0x85, 0x81, 0x7c, 0x75, 0x6f, 0x74, 0x80, 0x8b, 0x92, 0x92, 0x87, 0x7a, 0x73, 0x72, 0x77,
+		0x80, 0x85, 0x86, 0x84, 0x83, 0x81, 0x81, 0x82, 0x80, 0x7d, 0xa9, 0xb3, 0x74, 0x44, 0x3b, 0x38,
+		0x67, 0xbc, 0xdd, 0xc7, 0xa5, 0x6e, 0x38, 0x3e, 0x65, 0x80, 0x96, 0xa3, 0x8e, 0x7c, 0x82, 0x89,
+		0x89, 0x8c, 0x80, 0x63, 0x5a, 0x67, 0x7b, 0x95, 0xac, 0xa6, 0x8c, 0x76, 0x65, 0x5f, 0x6e, 0x81,
+		0x8a, 0x8c, 0x8a, 0x82, 0x80, 0x84, 0x86, 0x81, 0x7d, 0x75, 0x6f, 0x74, 0x80, 0x8b, 0x92, 0x92,
+		0x87, 0x7a, 0x73, 0x72, 0x77, 0x80, 0x85, 0x86, 0x84, 0x83, 0x81, 0x81, 0x82, 0x80, 0x7d, 0xa9,
+		0xb3, 0x74, 0x44, 0x3b, 0x38, 0x67, 0xbc, 0xdd, 0xc7, 0xa6, 0x6e, 0x38, 0x3e, 0x65, 0x7f, 0x96,
+		0xa3, 0x8e, 0x7c, 0x82, 0x8a, 0x89, 0x8c, 0x80, 0x63, 0x5a, 0x67, 0x7b, 0x95, 0xac, 0xa6, 0x8c,
+		0x76, 0x65, 0x5f, 0x6e, 0x81, 0x8a, 0x8c, 0x8a, 0x82, 0x80, 0x84, 0x86, 0x81, 0x7d, 0x75, 0x6f,
+		0x74, 0x80, 0x8b, 0x92, 0x92, 0x87, 0x7a, 0x73, 0x72, 0x77, 0x80, 0x85, 0x86, 0x84, 0x83, 0x81,
+		0x81, 0x82, 0x80, 0x7d, 0x7a, 0xa8, 0xb5, 0x79, 0x4a, 0x3d, 0x37, 0x63, 0xb6, 0xd9, 0xc7, 0xa8,
+		0x71, 0x3b, 0x3f, 0x64, 0x7e, 0x96, 0xa2, 0x8e, 0x7b, 0x81, 0x88, 0x89, 0x8d, 0x81, 0x66, 0x5b,
+		0x66, 0x79, 0x93, 0xaa, 0xa5, 0x8d, 0x78, 0x66, 0x60, 0x6e, 0x81, 0x89, 0x8c, 0x8a, 0x82, 0x80,
+		0x84, 0x86, 0x82, 0x7e, 0x76, 0x6f, 0x74, 0x80, 0x8a, 0x91, 0x91, 0x87, 0x7b, 0x74, 0x73, 0x77,
+		0x7f, 0x85, 0x86, 0x84, 0x82, 0x81, 0x81, 0x82, 0x80, 0x7e, 0x7b, 0x7a, 0xa9, 0xba, 0x7f, 0x4d,
+		0x3c, 0x33, 0x5d, 0xb2, 0xd9, 0xca, 0xab, 0x75, 0x3c, 0x3e, 0x63, 0x7e, 0x95, 0xa2, 0x8e, 0x7a,
+		0x80, 0x88, 0x8b, 0x90, 0x83, 0x67, 0x5a, 0x64, 0x76, 0x91, 0xaa, 0xa7, 0x8f, 0x79, 0x67, 0x60,
+		0x6e, 0x80, 0x89, 0x8c, 0x8a, 0x82, 0x7f, 0x84, 0x86, 0x83, 0x7f, 0x76, 0x6f, 0x73, 0x7f, 0x89,
+		0x91, 0x92, 0x88, 0x7c, 0x74, 0x73, 0x77, 0x7f, 0x85, 0x85, 0x84, 0x82, 0x80, 0x81, 0x82, 0x81,
+		0x7e, 0x7b, 0x79, 0xa9, 0xba, 0x7e, 0x4d, 0x3c, 0x33, 0x5e, 0xb2, 0xd9, 0xc9, 0xab, 0x74, 0x3c,
+		0x3e, 0x63, 0x7e, 0x95, 0xa2, 0x8e, 0x7a, 0x80, 0x88, 0x8a, 0x8f, 0x83, 0x67, 0x5a, 0x64, 0x77,
+		0x91, 0xaa, 0xa7, 0x8f, 0x79, 0x67, 0x60, 0x6e, 0x80, 0x89, 0x8c, 0x8a, 0x82, 0x7f, 0x84, 0x86,
+		0x83, 0x7f, 0x76, 0x6f, 0x73, 0x7f, 0x89, 0x91, 0x92, 0x88, 0x7c, 0x74, 0x73, 0x77, 0x7f, 0x85,
+		0x86, 0x84, 0x82, 0x80, 0x81, 0x82, 0x81, 0x7e, 0x7b, 0x79, 0xa9, 0xba, 0x7e, 0x4d, 0x3c, 0x33,
+		0x5e, 0xb2, 0xd9, 0xc9, 0xab, 0x74, 0x3c, 0x3e, 0x63, 0x7e, 0x95, 0xa2, 0x8e, 0x7a, 0x80, 0x88,
+		0x8a, 0x8f, 0x83, 0x67, 0x5a, 0x64, 0x77, 0x91, 0xaa, 0xa7, 0x8f, 0x79, 0x67, 0x60, 0x6e, 0x80,
+		0x89, 0x8c, 0x8a, 0x82, 0x7f, 0x84, 0x86, 0x83, 0x7f, 0x76, 0x6f, 0x73, 0x7f, 0x89, 0x91, 0x92,
+		0x88, 0x7c, 0x74, 0x73, 0x77, 0x7f, 0x85, 0x86, 0x84, 0x82, 0x80, 0x81, 0x82, 0x81, 0x7e, 0x7b,
+		0x79, 0x7a, 0xae, 0xc0, 0x81, 0x4c, 0x39, 0x2d, 0x59, 0xb2, 0xdb, 0xcd, 0xae, 0x76, 0x3c, 0x3d,
+		0x63, 0x7d, 0x95, 0xa2, 0x8c, 0x79, 0x80, 0x89, 0x8c, 0x92, 0x85, 0x66, 0x58, 0x62, 0x75, 0x91,
+		0xab, 0xa8, 0x90, 0x7a, 0x67, 0x5f, 0x6d, 0x80, 0x89, 0x8b, 0x89, 0x81, 0x7f, 0x84, 0x87, 0x84,
+		0x7f, 0x76, 0x6e, 0x71, 0x7e, 0x89, 0x92, 0x93, 0x89, 0x7c, 0x74, 0x72, 0x76, 0x7f, 0x85, 0x85,
+		0x84, 0x82, 0x80, 0x81, 0x83, 0x81, 0x7f, 0x7b, 0x79, 0x7a, 0xaf, 0xc0, 0x7f, 0x48, 0x38, 0x32,
+		0x63, 0xba, 0xd9, 0xbd, 0x9a, 0x6a, 0x44, 0x59, 0x80, 0x89, 0x87, 0x80, 0x6c, 0x6e, 0x91, 0xa9,
+		0xa4, 0x93, 0x71, 0x51, 0x56, 0x74, 0x8b, 0x98, 0x97, 0x83, 0x76, 0x7f, 0x89, 0x8b, 0x87, 0x78,
+		0x66, 0x68, 0x7b, 0x8c, 0x96, 0x93, 0x84, 0x78, 0x79, 0x80, 0x83, 0x82, 0x7b, 0x72, 0x75, 0x80,
+		0x8b, 0x8f, 0x8a, 0x80, 0x78, 0x7a, 0x80, 0x81, 0x80, 0x7c, 0x79, 0x7d, 0x84, 0x89, 0x88, 0x82,
+		0x7d, 0x7a, 0x7e, 0xb6, 0xb6, 0x5f, 0x34, 0x49, 0x61, 0x91, 0xbc, 0x99, 0x6c, 0x7a, 0x91, 0x99,
+		0xa0, 0x81, 0x4e, 0x4e, 0x75, 0x8a, 0x93, 0x90, 0x78, 0x74, 0x91, 0xa0, 0x91, 0x7d, 0x68, 0x60,
+		0x77, 0x8c, 0x86, 0x7a, 0x79, 0x7e, 0x8d, 0x9a, 0x8e, 0x78, 0x72, 0x78, 0x7f, 0x83, 0x7e, 0x73,
+		0x76, 0x85, 0x8e, 0x8c, 0x84, 0x7c, 0x7c, 0x83, 0x85, 0x7e, 0x76, 0x76, 0x7c, 0x84, 0x87, 0x81,
+		0x7e, 0x80, 0x85, 0x86, 0x82, 0x7c, 0x79, 0x7e, 0x81, 0x80, 0x7e, 0x7d, 0xb5, 0xaf, 0x55, 0x46,
+		0x77, 0x81, 0x86, 0x85, 0x60, 0x66, 0xa4, 0xae, 0x87, 0x7a, 0x79, 0x7c, 0x93, 0x8b, 0x61, 0x5e,
+		0x7f, 0x8a, 0x86, 0x80, 0x75, 0x7f, 0x9a, 0x96, 0x7b, 0x75, 0x7d, 0x82, 0x85, 0x7b, 0x6b, 0x75,
+		0x89, 0x89, 0x80, 0x7d, 0x80, 0x89, 0x8e, 0x82, 0x76, 0x7c, 0x84, 0x81, 0x7b, 0x77, 0x7a, 0x83,
+		0x87, 0x80, 0x7c, 0x82, 0x87, 0x86, 0x80, 0x7c, 0x7e, 0x83, 0x81, 0x7b, 0x7a, 0x7f, 0x82, 0x81,
+		0x7f, 0x7e, 0x82, 0x85, 0x82, 0x7f, 0xb3, 0x9d, 0x46, 0x5e, 0x9c, 0x80, 0x63, 0x6b, 0x6d, 0x89,
+		0xa3, 0x7b, 0x66, 0x98, 0xa6, 0x82, 0x77, 0x7c, 0x80, 0x8c, 0x7e, 0x5f, 0x72, 0x90, 0x81, 0x70,
+		0x7b, 0x87, 0x8e, 0x8b, 0x7b, 0x7c, 0x91, 0x8c, 0x76, 0x76, 0x81, 0x82, 0x7c, 0x76, 0x77, 0x83,
+		0x88, 0x7b, 0x7a, 0x87, 0x8a, 0x81, 0x7f, 0x80, 0x85, 0x84, 0x7c, 0x79, 0x80, 0x83, 0x7b, 0x79,
+		0x80, 0x82, 0x80, 0x7e, 0x7f, 0x84, 0x85, 0x80, 0x7e, 0x83, 0x84, 0x7f, 0x7d, 0x80, 0x81, 0x80,
+		0xab, 0x89, 0x47, 0x82, 0xaf, 0x66, 0x53, 0x8a, 0x8b, 0x78, 0x77, 0x73, 0x8a, 0x9d, 0x76, 0x6b,
+		0x9c, 0x99, 0x6e, 0x77, 0x90, 0x85, 0x79, 0x75, 0x7a, 0x89, 0x80, 0x6a, 0x7c, 0x92, 0x7e, 0x71,
+		0x85, 0x8d, 0x80, 0x7d, 0x81, 0x88, 0x86, 0x7a, 0x7a, 0x89, 0x83, 0x74, 0x7c, 0x87, 0x7f, 0x78,
+		0x7e, 0x83, 0x81, 0x7d, 0x7e, 0x85, 0x85, 0x7d, 0x7f, 0x86, 0x83, 0x7c, 0x7f, 0x83, 0x80, 0x7d,
+		0x7e, 0x81, 0x80, 0x7d, 0x7d, 0x81, 0x81, 0x7e, 0x7f, 0x83, 0x81, 0xa8, 0x82, 0x4f, 0x95, 0xac,
+		0x53, 0x5d, 0xa2, 0x81, 0x5e, 0x7c, 0x87, 0x83, 0x80, 0x72, 0x83, 0x9e, 0x7d, 0x6b, 0x96, 0x97,
+		0x6f, 0x79, 0x8f, 0x82, 0x77, 0x79, 0x7e, 0x85, 0x7d, 0x70, 0x80, 0x8d, 0x79, 0x75, 0x8a, 0x89,
+		0x7b, 0x7f, 0x87, 0x85, 0x80, 0x7d, 0x80, 0x86, 0x7f, 0x77, 0x81, 0x85, 0x7a, 0x7a, 0x82, 0x82,
+		0x7e, 0x7e, 0x81, 0x83, 0x81, 0x7e, 0x81, 0x85, 0x80, 0x7d, 0x82, 0x83, 0x7e, 0x7e, 0x80, 0x80,
+		0x7f, 0x7e, 0x80, 0x81, 0x80, 0x7e, 0xaa, 0x85, 0x4c, 0x92, 0xae, 0x56, 0x5c, 0xa0, 0x83, 0x61,
+		0x7b, 0x85, 0x84, 0x82, 0x70, 0x81, 0x9f, 0x7e, 0x69, 0x94, 0x97, 0x70, 0x78, 0x8e, 0x83, 0x78,
+		0x79, 0x7d, 0x86, 0x7f, 0x70, 0x80, 0x8e, 0x7a, 0x75, 0x89, 0x89, 0x7c, 0x7f, 0x86, 0x85, 0x81,
+		0x7c, 0x80, 0x87, 0x7f, 0x77, 0x80, 0x85, 0x7b, 0x7a, 0x82, 0x82, 0x7e, 0x7e, 0x80, 0x83, 0x81,
+		0x7e, 0x81, 0x85, 0x80, 0x7d, 0x81, 0x83, 0x7f, 0x7e, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x81, 0x80,
+		0x7e, 0x80, 0xac, 0x83, 0x4b, 0x95, 0xaf, 0x54, 0x5c, 0xa1, 0x84, 0x60, 0x79, 0x86, 0x86, 0x81,
+		0x6f, 0x82, 0xa0, 0x7d, 0x69, 0x95, 0x98, 0x70, 0x77, 0x8e, 0x83, 0x78, 0x78, 0x7e, 0x86, 0x7e,
+		0x6f, 0x80, 0x8e, 0x79, 0x74, 0x8a, 0x8a, 0x7b, 0x7e, 0x86, 0x86, 0x80, 0x7c, 0x80, 0x87, 0x7f,
+		0x77, 0x80, 0x85, 0x7b, 0x79, 0x82, 0x82, 0x7e, 0x7e, 0x81, 0x84, 0x81, 0x7e, 0x81, 0x85, 0x80,
+		0x7d, 0x81, 0x83, 0x7f, 0x7d, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0xad, 0x83,
+		0x4b, 0x95, 0xaf, 0x54, 0x5b, 0xa1, 0x84, 0x60, 0x79, 0x86, 0x86, 0x81, 0x6f, 0x82, 0xa0, 0x7d,
+		0x69, 0x95, 0x98, 0x70, 0x77, 0x8e, 0x83, 0x78, 0x78, 0x7e, 0x86, 0x7e, 0x6f, 0x80, 0x8e, 0x79,
+		0x74, 0x8a, 0x8a, 0x7b, 0x7e, 0x86, 0x86, 0x80, 0x7c, 0x80, 0x87, 0x7f, 0x77, 0x80, 0x85, 0x7b,
+		0x79, 0x82, 0x82, 0x7e, 0x7e, 0x81, 0x84, 0x81, 0x7e, 0x81, 0x85, 0x80, 0x7d, 0x81, 0x83, 0x7f,
+		0x7d, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0x82, 0xaa, 0x81, 0x4e, 0x96, 0xad,
+		0x53, 0x5d, 0xa2, 0x83, 0x5e, 0x7a, 0x87, 0x84, 0x80, 0x70, 0x83, 0x9f, 0x7c, 0x6a, 0x96, 0x97,
+		0x6f, 0x78, 0x8f, 0x83, 0x77, 0x79, 0x7e, 0x85, 0x7d, 0x70, 0x80, 0x8d, 0x79, 0x75, 0x8a, 0x89,
+		0x7b, 0x7f, 0x87, 0x85, 0x80, 0x7c, 0x80, 0x87, 0x7f, 0x77, 0x81, 0x85, 0x7b, 0x79, 0x82, 0x82,
+		0x7e, 0x7e, 0x81, 0x84, 0x81, 0x7e, 0x81, 0x85, 0x80, 0x7d, 0x82, 0x83, 0x7e, 0x7d, 0x80, 0x80,
+		0x7f, 0x7e, 0x80, 0x81, 0x80, 0x7e, 0x80, 0x82, 0x80, 0xa8, 0x84, 0x4f, 0x94, 0xac, 0x55, 0x5e,
+		0xa1, 0x81, 0x5f, 0x7c, 0x86, 0x83, 0x81, 0x72, 0x82, 0x9e, 0x7e, 0x6b, 0x95, 0x97, 0x70, 0x79,
+		0x8f, 0x82, 0x77, 0x79, 0x7e, 0x85, 0x7e, 0x70, 0x80, 0x8d, 0x79, 0x75, 0x8a, 0x89, 0x7b, 0x7f,
+		0x86, 0x85, 0x80, 0x7d, 0x80, 0x86, 0x7f, 0x77, 0x80, 0x85, 0x7b, 0x7a, 0x82, 0x82, 0x7e, 0x7e,
+		0x81, 0x83, 0x81, 0x7e, 0x81, 0x85, 0x80, 0x7d, 0x81, 0x83, 0x7f, 0x7e, 0x80, 0x80, 0x7f, 0x7e,
+		0x80, 0x81, 0x80, 0x7e, 0x80, 0x82, 0x80, 0xa8, 0x84, 0x4e, 0x94, 0xac, 0x55, 0x5d, 0xa1, 0x81,
+		0x5f, 0x7c, 0x86, 0x83, 0x81, 0x72, 0x82, 0x9e, 0x7e, 0x6b, 0x95, 0x97, 0x70, 0x78, 0x8f, 0x82,
+		0x77, 0x79, 0x7e, 0x85, 0x7e, 0x70, 0x80, 0x8d, 0x79, 0x75, 0x8a, 0x89, 0x7b, 0x7f, 0x86, 0x85,
+		0x80, 0x7d, 0x80, 0x86, 0x7f, 0x77, 0x80, 0x85, 0x7b, 0x7a, 0x81, 0x82, 0x7f, 0x7e, 0x80, 0x83,
+		0x82, 0x7e, 0x80, 0x85, 0x82, 0x7e, 0x80, 0x83, 0x80, 0x7e, 0x7f, 0x80, 0x80, 0x7f, 0x7e, 0x80,
+		0x81, 0x80, 0x7e, 0x80, 0x82, 0x80, 0x9c, 0x8b, 0x60, 0x7f, 0xa1, 0x78, 0x61, 0x82, 0x89, 0x79,
+		0x79, 0x77, 0x7a, 0x8c, 0x84, 0x6f, 0x7f, 0x95, 0x84, 0x77, 0x83, 0x89, 0x85, 0x82, 0x7d, 0x7c,
+		0x87, 0x86, 0x76, 0x78, 0x84, 0x82, 0x7a, 0x7b, 0x7e, 0x80, 0x82, 0x7f, 0x7b, 0x81, 0x87, 0x81,
+		0x7d, 0x81, 0x84, 0x82, 0x81, 0x80, 0x7f, 0x81, 0x83, 0x7e, 0x7d, 0x80, 0x81, 0x80, 0x7e, 0x7e,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x82, 0x80, 0x7f, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80,
+		0x81, 0x80, 0x7f, 0x80, 0x80, 0x8b, 0x8a, 0x78, 0x75, 0x84, 0x88, 0x7e, 0x7a, 0x7b, 0x7c, 0x80,
+		0x85, 0x7c, 0x76, 0x7f, 0x86, 0x81, 0x7f, 0x80, 0x7f, 0x82, 0x85, 0x83, 0x7e, 0x7e, 0x83, 0x84,
+		0x80, 0x80, 0x7e, 0x7f, 0x80, 0x82, 0x7f, 0x7c, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x7f, 0x81,
+		0x81, 0x80, 0x7f, 0x80, 0x80, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x81, 0x7f,
+		0x80, 0x81, 0x80, 0x80, 0x80, 0x83, 0x85, 0x81, 0x7f, 0x7e, 0x80, 0x81, 0x80, 0x80, 0x7f, 0x7e,
+		0x7d, 0x80, 0x81, 0x7f, 0x7e, 0x80, 0x7e, 0x7f, 0x81, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x80,
+		0x82, 0x80, 0x80, 0x80, 0x81, 0x81, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80,
+		0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x7f, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x80,
+		0x80, 0x81, 0x80, 0x7f, 0x82, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x7f, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x82, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x81, 0x80,
+		0x7f, 0x80, 0x80, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x81, 0x7f,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x80,
+		0x81, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x81, 0x7f, 0x81, 0x80, 0x7f, 0x81, 0x80, 0x82, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80,
+		0x81, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x81, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x82, 0x81, 0x81, 0x81, 0x7e, 0x80, 0x7f,
+		0x80, 0x80, 0x80, 0x80, 0x7e, 0x80, 0x7f, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x81,
+		0x80, 0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x7f, 0x7f, 0x81, 0x80, 0x80, 0x81, 0x7f, 0x7f, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x7f,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x81, 0x7f, 0x80,
+		0x81, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x7f, 0x80, 0x81,
+		0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	},
+	{ // 6
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x7f, 0x81, 0x80,
+		0x7e, 0x83, 0x7c, 0x85, 0x7a, 0x88, 0x79, 0x86, 0x7a, 0x86, 0x7c, 0x80, 0x7f, 0x82, 0x7f, 0x80,
+		0x81, 0x7c, 0x87, 0x7a, 0x84, 0x7a, 0x8a, 0x70, 0x96, 0x6a, 0x8f, 0x76, 0x89, 0x7c, 0x7f, 0x84,
+		0x7c, 0x81, 0x7d, 0x84, 0x7c, 0x83, 0x80, 0x80, 0x80, 0x83, 0x74, 0x93, 0x6a, 0x93, 0x78, 0x7b,
+		0x95, 0x5f, 0xa4, 0x60, 0x97, 0x78, 0x81, 0x78, 0x90, 0x6e, 0x8e, 0x7a, 0x7f, 0x85, 0x7c, 0x80,
+		0x83, 0x84, 0x6e, 0xa0, 0x59, 0xa6, 0x62, 0x95, 0x6f, 0x90, 0x79, 0x76, 0x94, 0x69, 0x9c, 0x5e,
+		0xa2, 0x6a, 0x87, 0x87, 0x66, 0xa6, 0x51, 0xaf, 0x5e, 0x99, 0x6b, 0x88, 0x82, 0x7a, 0x88, 0x7c,
+		0x7b, 0x8c, 0x78, 0x7e, 0x88, 0x7d, 0x7c, 0x85, 0x80, 0x81, 0x76, 0x90, 0x6d, 0x9b, 0x5b, 0xa6,
+		0x63, 0x96, 0x6a, 0x97, 0x6e, 0x8b, 0x80, 0x75, 0x8e, 0x7d, 0x71, 0x95, 0x77, 0x76, 0x96, 0x70,
+		0x84, 0x81, 0x82, 0x7a, 0x83, 0x80, 0x7c, 0x80, 0x84, 0x82, 0x77, 0x83, 0x83, 0x82, 0x78, 0x86,
+		0x82, 0x6e, 0xa3, 0x53, 0xa9, 0x5f, 0x98, 0x6f, 0x92, 0x70, 0x8a, 0x77, 0x89, 0x80, 0x72, 0x92,
+		0x76, 0x8d, 0x70, 0x92, 0x68, 0x94, 0x79, 0x83, 0x7b, 0x86, 0x7f, 0x78, 0x8c, 0x77, 0x7f, 0x91,
+		0x63, 0xa5, 0x58, 0xa2, 0x6c, 0x80, 0x93, 0x66, 0x8f, 0x76, 0x8d, 0x76, 0x80, 0x83, 0x81, 0x7f,
+		0x7e, 0x86, 0x75, 0x8e, 0x73, 0x8a, 0x77, 0x8e, 0x6c, 0x8f, 0x80, 0x74, 0x8e, 0x72, 0x92, 0x68,
+		0x98, 0x70, 0x80, 0x91, 0x6a, 0x8f, 0x7b, 0x81, 0x7c, 0x89, 0x72, 0x95, 0x65, 0x95, 0x77, 0x7e,
+		0x92, 0x68, 0x95, 0x77, 0x7c, 0x8a, 0x71, 0x9b, 0x63, 0x92, 0x76, 0x8c, 0x6e, 0x94, 0x6d, 0x8e,
+		0x77, 0x8c, 0x70, 0x92, 0x6b, 0x9c, 0x66, 0x98, 0x6a, 0x93, 0x68, 0x9a, 0x6a, 0x92, 0x6b, 0x98,
+		0x71, 0x86, 0x7f, 0x80, 0x82, 0x77, 0x8c, 0x7a, 0x7e, 0x88, 0x7c, 0x7f, 0x80, 0x7d, 0x8e, 0x6c,
+		0x91, 0x74, 0x83, 0x81, 0x81, 0x7d, 0x8b, 0x6c, 0x99, 0x65, 0x97, 0x6b, 0x8f, 0x7b, 0x7c, 0x8d,
+		0x70, 0x95, 0x65, 0x9f, 0x5a, 0xa7, 0x64, 0x91, 0x78, 0x7d, 0x93, 0x68, 0x98, 0x65, 0x9e, 0x64,
+		0x93, 0x7a, 0x7a, 0x86, 0x85, 0x6e, 0x9b, 0x63, 0xa0, 0x5b, 0xa7, 0x5f, 0x9c, 0x60, 0xa0, 0x60,
+		0xa1, 0x65, 0x8f, 0x80, 0x73, 0x96, 0x66, 0x95, 0x76, 0x83, 0x7c, 0x8a, 0x79, 0x83, 0x7e, 0x85,
+		0x78, 0x7f, 0x88, 0x81, 0x78, 0x8a, 0x73, 0x8c, 0x74, 0x86, 0x84, 0x6d, 0xa0, 0x5e, 0x9a, 0x77,
+		0x77, 0x92, 0x72, 0x7f, 0x8c, 0x70, 0x8e, 0x74, 0x89, 0x81, 0x7c, 0x85, 0x75, 0x91, 0x6d, 0x92,
+		0x71, 0x84, 0x82, 0x7c, 0x86, 0x82, 0x77, 0x87, 0x75, 0x92, 0x6b, 0x96, 0x72, 0x83, 0x7e, 0x80,
+		0x89, 0x68, 0x9c, 0x6a, 0x90, 0x77, 0x82, 0x8a, 0x72, 0x86, 0x7b, 0x8e, 0x65, 0xa5, 0x56, 0xad,
+		0x4f, 0xb5, 0x45, 0xc1, 0x40, 0xba, 0x4a, 0xb0, 0x5d, 0x9b, 0x67, 0x93, 0x77, 0x80, 0x8a, 0x6c,
+		0x9c, 0x68, 0x93, 0x6d, 0x98, 0x60, 0x9c, 0x74, 0x7f, 0x8e, 0x64, 0x9c, 0x69, 0x9e, 0x5b, 0xa2,
+		0x63, 0x99, 0x6c, 0x89, 0x7f, 0x81, 0x7b, 0x87, 0x7b, 0x89, 0x7a, 0x7c, 0x8e, 0x65, 0xa5, 0x62,
+		0x8b, 0x89, 0x66, 0x9e, 0x63, 0x99, 0x6b, 0x91, 0x7f, 0x77, 0x8f, 0x6b, 0x8f, 0x77, 0x8e, 0x6e,
+		0x98, 0x65, 0x90, 0x82, 0x77, 0x83, 0x88, 0x68, 0xa3, 0x58, 0xa5, 0x6f, 0x80, 0x8d, 0x69, 0x97,
+		0x73, 0x82, 0x85, 0x7c, 0x81, 0x77, 0x91, 0x66, 0xa5, 0x50, 0xb9, 0x41, 0xb7, 0x63, 0x85, 0x8f,
+		0x6a, 0x8f, 0x75, 0x90, 0x64, 0xa6, 0x53, 0xb2, 0x4a, 0xb2, 0x5f, 0x91, 0x7a, 0x81, 0x7e, 0x88,
+		0x6f, 0x90, 0x7c, 0x76, 0x8e, 0x77, 0x85, 0x7c, 0x83, 0x80, 0x82, 0x74, 0x99, 0x60, 0x9e, 0x69,
+		0x8e, 0x7e, 0x78, 0x89, 0x75, 0x94, 0x6b, 0x8b, 0x7a, 0x80, 0x8d, 0x62, 0xab, 0x51, 0xac, 0x58,
+		0x9d, 0x69, 0x94, 0x71, 0x89, 0x80, 0x76, 0x91, 0x70, 0x8a, 0x80, 0x77, 0x8d, 0x6f, 0x8a, 0x7d,
+		0x7e, 0x8a, 0x76, 0x8f, 0x70, 0x85, 0x81, 0x80, 0x81, 0x77, 0x8f, 0x6f, 0x8d, 0x7c, 0x7c, 0x8b,
+		0x73, 0x89, 0x81, 0x79, 0x8c, 0x68, 0xa1, 0x65, 0x87, 0x89, 0x72, 0x8e, 0x75, 0x8b, 0x6f, 0x94,
+		0x70, 0x83, 0x8c, 0x6b, 0x98, 0x64, 0x9b, 0x67, 0x9d, 0x5d, 0xaa, 0x55, 0x9f, 0x71, 0x86, 0x82,
+		0x72, 0x8e, 0x7d, 0x78, 0x90, 0x6f, 0x92, 0x68, 0x99, 0x6b, 0x94, 0x70, 0x84, 0x80, 0x7d, 0x84,
+		0x80, 0x7a, 0x8d, 0x77, 0x80, 0x82, 0x80, 0x7c, 0x84, 0x82, 0x73, 0x98, 0x6b, 0x84, 0x8a, 0x71,
+		0x8c, 0x7f, 0x76, 0x8e, 0x71, 0x91, 0x6d, 0x8d, 0x78, 0x80, 0x8f, 0x66, 0x9e, 0x66, 0x94, 0x69,
+		0x97, 0x6a, 0x95, 0x6d, 0x9a, 0x5b, 0xa8, 0x61, 0x90, 0x78, 0x80, 0x8c, 0x6d, 0x99, 0x6c, 0x89,
+		0x80, 0x76, 0x94, 0x67, 0x97, 0x68, 0x98, 0x6d, 0x95, 0x67, 0x9d, 0x63, 0x97, 0x6e, 0x90, 0x6d,
+		0x91, 0x74, 0x92, 0x70, 0x80, 0x95, 0x5e, 0xa6, 0x5d, 0x94, 0x75, 0x8b, 0x75, 0x8b, 0x7a, 0x81,
+		0x83, 0x7e, 0x7d, 0x86, 0x7b, 0x80, 0x84, 0x7b, 0x89, 0x6f, 0x8c, 0x79, 0x85, 0x85, 0x6e, 0x92,
+		0x78, 0x7f, 0x86, 0x80, 0x7c, 0x8a, 0x6f, 0x8d, 0x74, 0x88, 0x85, 0x75, 0x89, 0x78, 0x8f, 0x64,
+		0xa6, 0x5a, 0x9a, 0x73, 0x84, 0x80, 0x7e, 0x80, 0x8c, 0x65, 0x9f, 0x68, 0x98, 0x63, 0x9b, 0x6a,
+		0x8f, 0x7a, 0x83, 0x80, 0x76, 0x8c, 0x78, 0x84, 0x84, 0x73, 0x8e, 0x71, 0x96, 0x5e, 0xae, 0x52,
+		0xa7, 0x5f, 0x96, 0x78, 0x78, 0x98, 0x62, 0x9d, 0x62, 0xa2, 0x5d, 0x98, 0x73, 0x8d, 0x74, 0x8e,
+		0x73, 0x7f, 0x92, 0x68, 0x92, 0x7d, 0x75, 0x97, 0x64, 0x94, 0x78, 0x80, 0x8a, 0x76, 0x86, 0x78,
+		0x8a, 0x70, 0x98, 0x64, 0x96, 0x79, 0x82, 0x7c, 0x86, 0x75, 0x93, 0x67, 0x98, 0x6d, 0x90, 0x6a,
+		0x96, 0x70, 0x90, 0x68, 0x9f, 0x65, 0x8e, 0x7f, 0x77, 0x9a, 0x58, 0xa9, 0x62, 0x8b, 0x84, 0x79,
+		0x88, 0x75, 0x89, 0x74, 0x90, 0x78, 0x77, 0x90, 0x7a, 0x81, 0x81, 0x7c, 0x86, 0x76, 0x8e, 0x6f,
+		0x8c, 0x80, 0x78, 0x8d, 0x6d, 0x97, 0x62, 0xa0, 0x6b, 0x85, 0x8c, 0x61, 0xa4, 0x66, 0x8c, 0x7d,
+		0x80, 0x84, 0x81, 0x79, 0x80, 0x86, 0x77, 0x94, 0x69, 0x95, 0x71, 0x81, 0x8b, 0x67, 0x9e, 0x70,
+		0x7d, 0x8e, 0x6b, 0x95, 0x76, 0x80, 0x8c, 0x69, 0x9b, 0x69, 0x8f, 0x7b, 0x78, 0x92, 0x70, 0x8b,
+		0x7f, 0x7a, 0x8b, 0x70, 0x8b, 0x7a, 0x8f, 0x65, 0xa0, 0x5d, 0xa5, 0x59, 0xa2, 0x70, 0x85, 0x83,
+		0x72, 0x97, 0x5c, 0xa9, 0x60, 0x9a, 0x61, 0x9f, 0x67, 0x97, 0x6a, 0x93, 0x6f, 0x84, 0x8b, 0x67,
+		0xa2, 0x5c, 0xa3, 0x60, 0x9c, 0x64, 0x97, 0x78, 0x76, 0x95, 0x6e, 0x8e, 0x6d, 0x9b, 0x61, 0x9e,
+		0x66, 0x98, 0x64, 0x9d, 0x64, 0x93, 0x7c, 0x7e, 0x83, 0x7e, 0x7f, 0x82, 0x79, 0x90, 0x66, 0xa2,
+		0x5a, 0xa8, 0x53, 0xa7, 0x64, 0x93, 0x72, 0x91, 0x6e, 0x91, 0x6a, 0x9b, 0x65, 0x94, 0x75, 0x86,
+		0x81, 0x7d, 0x79, 0x96, 0x62, 0x9c, 0x6e, 0x84, 0x8a, 0x65, 0xaa, 0x48, 0xc0, 0x42, 0xad, 0x63,
+		0x90, 0x80, 0x77, 0x8c, 0x6f, 0x9b, 0x64, 0x93, 0x77, 0x7e, 0x83, 0x7d, 0x8a, 0x78, 0x7e, 0x87,
+		0x77, 0x93, 0x68, 0x98, 0x65, 0x9d, 0x6a, 0x90, 0x75, 0x7e, 0x8a, 0x78, 0x86, 0x7a, 0x83, 0x84,
+		0x6d, 0x96, 0x76, 0x7f, 0x80, 0x84, 0x82, 0x79, 0x80, 0x8e, 0x66, 0x9b, 0x65, 0x98, 0x71, 0x86,
+		0x82, 0x7b, 0x8b, 0x71, 0x88, 0x7d, 0x89, 0x75, 0x84, 0x80, 0x80, 0x81, 0x7c, 0x8c, 0x70, 0x90,
+		0x71, 0x83, 0x83, 0x80, 0x7f, 0x7c, 0x83, 0x80, 0x7f, 0x7f, 0x83, 0x7b, 0x83, 0x7e, 0x80, 0x85,
+		0x7f, 0x7c, 0x8f, 0x74, 0x8f, 0x71, 0x84, 0x7e, 0x86, 0x7d, 0x79, 0x84, 0x78, 0x91, 0x6e, 0x89,
+		0x7a, 0x84, 0x7a, 0x8f, 0x6d, 0x8f, 0x7b, 0x7b, 0x94, 0x71, 0x82, 0x85, 0x78, 0x8a, 0x78, 0x89,
+		0x73, 0x89, 0x7d, 0x80, 0x82, 0x77, 0x8a, 0x71, 0x91, 0x73, 0x87, 0x7d, 0x81, 0x80, 0x82, 0x7b,
+		0x8c, 0x77, 0x87, 0x7a, 0x80, 0x88, 0x6d, 0x9a, 0x6b, 0x8c, 0x77, 0x89, 0x78, 0x87, 0x75, 0x8e,
+		0x6c, 0x9c, 0x65, 0x8f, 0x7d, 0x8e, 0x88, 0x76, 0x7f, 0x7c, 0x90, 0x71, 0x88, 0x69, 0x88, 0x7d,
+		0x82, 0x7b, 0x76, 0x86, 0x84, 0x80, 0x81, 0x77, 0x8d, 0x7d, 0x8b, 0x75, 0x87, 0x7e, 0x89, 0x7d,
+		0x7a, 0x86, 0x78, 0x8a, 0x76, 0x7f, 0x81, 0x7b, 0x85, 0x80, 0x75, 0x8c, 0x77, 0x8b, 0x79, 0x84,
+		0x7f, 0x84, 0x84, 0x77, 0x8c, 0x72, 0x94, 0x69, 0x97, 0x6a, 0x8b, 0x7b, 0x84, 0x7d, 0x7c, 0x85,
+		0x7c, 0x84, 0x80, 0x7b, 0x8c, 0x71, 0x92, 0x6d, 0xac, 0x82, 0x70, 0x71, 0x9a, 0x7a, 0x80, 0x62,
+		0x8f, 0x67, 0x9f, 0x65, 0x70, 0x8d, 0x87, 0x7f, 0x7a, 0x83, 0x87, 0x8d, 0x80, 0x79, 0x82, 0x94,
+		0x7a, 0x7d, 0x7a, 0x85, 0x7f, 0x81, 0x72, 0x80, 0x83, 0x82, 0x74, 0x85, 0x7a, 0x8f, 0x75, 0x87,
+		0x78, 0x8c, 0x81, 0x80, 0x7e, 0x81, 0x8a, 0x73, 0x8a, 0x76, 0x87, 0x7d, 0x7f, 0x7b, 0x83, 0x7f,
+		0x80, 0x7d, 0x82, 0x7f, 0x84, 0x7b, 0x83, 0x7e, 0x89, 0x79, 0x85, 0x7b, 0xb5, 0x86, 0x54, 0x77,
+		0xb4, 0x6e, 0x5a, 0x7c, 0x86, 0x7c, 0x7b, 0x68, 0x77, 0xa5, 0x7a, 0x6b, 0x84, 0xa3, 0x7d, 0x7d,
+		0x80, 0x8b, 0x8d, 0x7e, 0x72, 0x80, 0x90, 0x76, 0x6c, 0x81, 0x88, 0x79, 0x79, 0x7b, 0x86, 0x85,
+		0x7d, 0x7a, 0x8a, 0x8a, 0x7d, 0x7b, 0x8b, 0x83, 0x80, 0x7c, 0x7e, 0x85, 0x7e, 0x7a, 0x7b, 0x84,
+		0x80, 0x78, 0x80, 0x85, 0x80, 0x80, 0x7e, 0x83, 0x86, 0x7e, 0x80, 0x82, 0x86, 0x7c, 0x80, 0x80,
+		0xc3, 0x89, 0x2c, 0x94, 0xc7, 0x4b, 0x3e, 0xa3, 0x8d, 0x5f, 0x6a, 0x7e, 0x90, 0x92, 0x62, 0x76,
+		0xb6, 0x8e, 0x59, 0x8d, 0xaa, 0x78, 0x6c, 0x85, 0x8a, 0x80, 0x6e, 0x6f, 0x8b, 0x88, 0x63, 0x75,
+		0x97, 0x82, 0x6c, 0x84, 0x92, 0x82, 0x7b, 0x81, 0x8b, 0x89, 0x79, 0x79, 0x8b, 0x84, 0x71, 0x7a,
+		0x88, 0x7e, 0x75, 0x7e, 0x84, 0x81, 0x7c, 0x7e, 0x86, 0x86, 0x7d, 0x7e, 0x88, 0x84, 0x7b, 0x7f,
+		0x85, 0x80, 0x7c, 0x7e, 0x81, 0xce, 0x81, 0x1c, 0xa7, 0xd5, 0x2b, 0x3c, 0xc1, 0x88, 0x42, 0x76,
+		0x8f, 0x8b, 0x82, 0x63, 0x86, 0xbb, 0x79, 0x55, 0xa7, 0xab, 0x5f, 0x6e, 0x9b, 0x85, 0x6e, 0x71,
+		0x7c, 0x8c, 0x7b, 0x61, 0x82, 0x9b, 0x73, 0x6b, 0x94, 0x93, 0x76, 0x7c, 0x8c, 0x8a, 0x80, 0x78,
+		0x80, 0x8d, 0x7d, 0x6e, 0x81, 0x8a, 0x76, 0x74, 0x85, 0x85, 0x7c, 0x7c, 0x82, 0x87, 0x82, 0x7b,
+		0x82, 0x8a, 0x80, 0x79, 0x83, 0x86, 0x7d, 0x7b, 0x80, 0x81, 0xcb, 0x80, 0x1f, 0xa8, 0xd2, 0x2a,
+		0x3f, 0xc3, 0x86, 0x41, 0x78, 0x90, 0x89, 0x82, 0x64, 0x87, 0xba, 0x78, 0x56, 0xa8, 0xaa, 0x5e,
+		0x6f, 0x9c, 0x84, 0x6e, 0x72, 0x7d, 0x8b, 0x7b, 0x62, 0x83, 0x9a, 0x73, 0x6b, 0x94, 0x92, 0x76,
+		0x7d, 0x8c, 0x8a, 0x80, 0x78, 0x80, 0x8c, 0x7d, 0x6e, 0x82, 0x8a, 0x76, 0x74, 0x85, 0x85, 0x7c,
+		0x7c, 0x82, 0x87, 0x82, 0x7b, 0x82, 0x8a, 0x80, 0x7a, 0x83, 0x86, 0x7c, 0x7b, 0x80, 0x81, 0xcb,
+		0x80, 0x1f, 0xa8, 0xd2, 0x2a, 0x3f, 0xc3, 0x85, 0x41, 0x79, 0x90, 0x89, 0x82, 0x64, 0x87, 0xba,
+		0x78, 0x56, 0xa8, 0xaa, 0x5e, 0x6f, 0x9c, 0x84, 0x6e, 0x72, 0x7d, 0x8b, 0x7b, 0x62, 0x83, 0x9a,
+		0x73, 0x6b, 0x94, 0x92, 0x76, 0x7d, 0x8c, 0x89, 0x80, 0x78, 0x80, 0x8c, 0x7d, 0x6e, 0x82, 0x8a,
+		0x76, 0x74, 0x85, 0x85, 0x7c, 0x7c, 0x82, 0x87, 0x82, 0x7b, 0x82, 0x8a, 0x80, 0x7a, 0x83, 0x85,
+		0x7c, 0x7b, 0x80, 0x81, 0x7e, 0xc8, 0x84, 0x24, 0xa4, 0xce, 0x30, 0x43, 0xbd, 0x83, 0x46, 0x7a,
+		0x8c, 0x87, 0x84, 0x66, 0x85, 0xb7, 0x7a, 0x59, 0xa5, 0xa7, 0x61, 0x72, 0x9a, 0x83, 0x70, 0x74,
+		0x7b, 0x8a, 0x7c, 0x63, 0x81, 0x99, 0x74, 0x6d, 0x93, 0x90, 0x77, 0x7e, 0x8b, 0x89, 0x80, 0x79,
+		0x80, 0x8b, 0x7d, 0x6f, 0x81, 0x89, 0x76, 0x75, 0x84, 0x84, 0x7c, 0x7d, 0x82, 0x86, 0x82, 0x7c,
+		0x82, 0x89, 0x80, 0x7a, 0x83, 0x85, 0x7d, 0x7b, 0x80, 0x81, 0x7e, 0xc9, 0x84, 0x24, 0xa4, 0xce,
+		0x30, 0x43, 0xbd, 0x83, 0x46, 0x7a, 0x8c, 0x88, 0x84, 0x66, 0x85, 0xb7, 0x7a, 0x58, 0xa6, 0xa7,
+		0x61, 0x71, 0x9a, 0x83, 0x70, 0x73, 0x7b, 0x8a, 0x7c, 0x63, 0x81, 0x99, 0x74, 0x6d, 0x93, 0x90,
+		0x77, 0x7e, 0x8b, 0x89, 0x80, 0x79, 0x80, 0x8c, 0x7d, 0x6f, 0x81, 0x89, 0x76, 0x75, 0x84, 0x84,
+		0x7c, 0x7d, 0x82, 0x86, 0x82, 0x7c, 0x82, 0x89, 0x80, 0x7a, 0x83, 0x85, 0x7d, 0x7b, 0x80, 0x81,
+		0x7e, 0x7c, 0xcc, 0x88, 0x20, 0xa0, 0xd3, 0x34, 0x3e, 0xbb, 0x88, 0x47, 0x76, 0x8b, 0x8a, 0x85,
+		0x64, 0x82, 0xb9, 0x7d, 0x56, 0xa3, 0xaa, 0x63, 0x6f, 0x99, 0x85, 0x71, 0x72, 0x7b, 0x8b, 0x7d,
+		0x62, 0x80, 0x9a, 0x75, 0x6b, 0x91, 0x92, 0x78, 0x7d, 0x8a, 0x8a, 0x81, 0x78, 0x7f, 0x8c, 0x7e,
+		0x6f, 0x80, 0x8a, 0x77, 0x74, 0x84, 0x84, 0x7c, 0x7c, 0x81, 0x87, 0x82, 0x7c, 0x81, 0x8a, 0x80,
+		0x7a, 0x82, 0x85, 0x7d, 0x7b, 0x80, 0x81, 0x7e, 0x7c, 0xcb, 0x88, 0x21, 0xa0, 0xd3, 0x34, 0x3e,
+		0xbb, 0x88, 0x48, 0x76, 0x8a, 0x89, 0x85, 0x64, 0x82, 0xb9, 0x7d, 0x56, 0xa3, 0xaa, 0x63, 0x6f,
+		0x99, 0x85, 0x71, 0x72, 0x7a, 0x8b, 0x7d, 0x62, 0x80, 0x9a, 0x75, 0x6b, 0x91, 0x91, 0x78, 0x7d,
+		0x8a, 0x8a, 0x81, 0x78, 0x7f, 0x8c, 0x7e, 0x6f, 0x80, 0x8a, 0x77, 0x74, 0x84, 0x84, 0x7c, 0x7c,
+		0x81, 0x87, 0x82, 0x7c, 0x81, 0x8a, 0x80, 0x7a, 0x82, 0x85, 0x7d, 0x7b, 0x80, 0x81, 0x7e, 0x7c,
+		0x7f, 0xcf, 0x85, 0x1e, 0xa3, 0xd6, 0x34, 0x3d, 0xba, 0x8a, 0x4b, 0x73, 0x83, 0x8c, 0x8d, 0x5f,
+		0x78, 0xbb, 0x88, 0x52, 0x95, 0xae, 0x73, 0x6e, 0x8a, 0x88, 0x83, 0x75, 0x69, 0x88, 0x91, 0x66,
+		0x6a, 0x93, 0x88, 0x70, 0x7c, 0x88, 0x88, 0x85, 0x7b, 0x7e, 0x91, 0x88, 0x73, 0x7f, 0x8d, 0x80,
+		0x77, 0x7d, 0x80, 0x82, 0x7e, 0x76, 0x7d, 0x87, 0x80, 0x78, 0x80, 0x86, 0x82, 0x80, 0x80, 0x82,
+		0x86, 0x82, 0x7c, 0x80, 0x85, 0x80, 0x7d, 0x7f, 0x99, 0x8b, 0x63, 0x79, 0x97, 0x7f, 0x6d, 0x79,
+		0x7e, 0x82, 0x82, 0x71, 0x74, 0x8e, 0x89, 0x75, 0x7c, 0x88, 0x87, 0x85, 0x80, 0x7c, 0x87, 0x8b,
+		0x7d, 0x79, 0x82, 0x84, 0x80, 0x7d, 0x7a, 0x7e, 0x84, 0x80, 0x78, 0x7d, 0x83, 0x81, 0x7f, 0x7f,
+		0x7f, 0x83, 0x84, 0x80, 0x7e, 0x82, 0x83, 0x81, 0x80, 0x7f, 0x80, 0x82, 0x80, 0x7d, 0x7f, 0x80,
+		0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x83, 0x7f, 0x80, 0x81, 0x7f, 0x80, 0x82, 0x80, 0x7f, 0x80,
+		0x81, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x82, 0x80, 0x7d, 0x82, 0x81, 0x7e, 0x7f, 0x80,
+		0x80, 0x80, 0x82, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x7f, 0x80, 0x7f, 0x82, 0x7f, 0x7e, 0x81, 0x80, 0x81, 0x7e, 0x82, 0x7f, 0x7f, 0x80,
+		0x80, 0x84, 0x7e, 0x80, 0x7d, 0x80, 0x80, 0x80, 0x82, 0x7e, 0x80, 0x83, 0x7e, 0x7d, 0x85, 0x80,
+		0x7b, 0x83, 0x84, 0x7b, 0x80, 0x83, 0x80, 0x7b, 0x82, 0x81, 0x7e, 0x80, 0x80, 0x83, 0x7e, 0x7f,
+		0x84, 0x7f, 0x7e, 0x7e, 0x85, 0x7e, 0x7f, 0x80, 0x82, 0x7e, 0x81, 0x80, 0x82, 0x7e, 0x7d, 0x82,
+		0x81, 0x80, 0x80, 0x80, 0x83, 0x80, 0x80, 0x7d, 0x80, 0x81, 0x7e, 0x83, 0x7e, 0x82, 0x81, 0x7f,
+		0x7a, 0x82, 0x82, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x82, 0x81, 0x80, 0x7f, 0x7f, 0x7e, 0x81,
+		0x83, 0x82, 0x7b, 0x7e, 0x84, 0x84, 0x80, 0x79, 0x80, 0x83, 0x82, 0x7c, 0x80, 0x81, 0x84, 0x7c,
+		0x7f, 0x82, 0x81, 0x80, 0x7c, 0x85, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x82, 0x83, 0x7d, 0x7e,
+		0x83, 0x82, 0x7e, 0x80, 0x83, 0x80, 0x7a, 0x7f, 0x83, 0x85, 0x7b, 0x7e, 0x82, 0x83, 0x80, 0x7b,
+		0x83, 0x7f, 0x80, 0x80, 0x80, 0x84, 0x80, 0x7d, 0x80, 0x83, 0x80, 0x7e, 0x80, 0x82, 0x7e, 0x7f,
+		0x7f, 0x80, 0x81, 0x82, 0x80, 0x7b, 0x84, 0x82, 0x7a, 0x80, 0x86, 0x7c, 0x7e, 0x80, 0x85, 0x7f,
+		0x7b, 0x84, 0x81, 0x7d, 0x81, 0x7e, 0x7f, 0x81, 0x86, 0x7a, 0x7f, 0x80, 0x82, 0x83, 0x7e, 0x80,
+		0x7c, 0x85, 0x7d, 0x81, 0x7f, 0x7e, 0x83, 0x81, 0x7e, 0x7f, 0x84, 0x82, 0x7f, 0x7c, 0x82, 0x81,
+		0x80, 0x80, 0x80, 0x7c, 0x81, 0x85, 0x7b, 0x7d, 0x86, 0x7f, 0x80, 0x80, 0x81, 0x7f, 0x7f, 0x7f,
+		0x84, 0x7f, 0x7f, 0x7f, 0x80, 0x81, 0x80, 0x81, 0x78, 0x8a, 0x7e, 0x7b, 0x87, 0x78, 0x92, 0x67,
+		0x90, 0x79, 0x8c, 0x6a, 0x9f, 0x61, 0x98, 0x6f, 0x8f, 0x69, 0x9b, 0x6c, 0x8f, 0x6b, 0x9c, 0x5c,
+		0xa6, 0x5f, 0x9c, 0x71, 0x7d, 0x8f, 0x78, 0x79, 0x96, 0x5f, 0xa7, 0x59, 0xa8, 0x58, 0xaa, 0x53,
+		0xaa, 0x5b, 0x9a, 0x76, 0x87, 0x76, 0x8c, 0x77, 0x89, 0x70, 0x90, 0x7b, 0x7b, 0x8a, 0x72, 0x88,
+		0x7f, 0x80, 0x80, 0x85, 0x73, 0x92, 0x6e, 0x93, 0x6d, 0x94, 0x6b, 0x97, 0x70, 0x7f, 0x87, 0x80,
+		0x80, 0x7b, 0x8d, 0x70, 0x8e, 0x6d, 0x9b, 0x59, 0xac, 0x56, 0x9c, 0x77, 0x7d, 0x87, 0x74, 0x8e,
+		0x80, 0x72, 0x97, 0x61, 0x9c, 0x74, 0x7e, 0x86, 0x7f, 0x7e, 0x80, 0x83, 0x7e, 0x83, 0x73, 0x93,
+		0x75, 0x85, 0x76, 0x94, 0x69, 0x8b, 0x81, 0x7b, 0x8b, 0x6e, 0x94, 0x69, 0x93, 0x79, 0x84, 0x77,
+		0x87, 0x7e, 0x83, 0x83, 0x7c, 0x7a, 0x89, 0x7f, 0x7a, 0x8f, 0x6c, 0x8d, 0x7c, 0x80, 0x7e, 0x82,
+		0x7a, 0x90, 0x6a, 0x92, 0x77, 0x84, 0x80, 0x81, 0x78, 0x87, 0x82, 0x7c, 0x80, 0x7d, 0x8d, 0x71,
+		0x8a, 0x70, 0x98, 0x68, 0x95, 0x67, 0x95, 0x7d, 0x7c, 0x80, 0x88, 0x73, 0x86, 0x89, 0x64, 0xa1,
+		0x68, 0x86, 0x8f, 0x69, 0x96, 0x66, 0x9c, 0x6a, 0x94, 0x6d, 0x91, 0x71, 0x8f, 0x70, 0x89, 0x7c,
+		0x85, 0x75, 0x88, 0x83, 0x74, 0x96, 0x5d, 0xa6, 0x67, 0x8c, 0x7c, 0x7b, 0x8f, 0x72, 0x83, 0x86,
+		0x70, 0x8f, 0x7a, 0x83, 0x7e, 0x80, 0x86, 0x6e, 0x95, 0x72, 0x80, 0x89, 0x77, 0x8c, 0x6c, 0x99,
+		0x6b, 0x89, 0x7f, 0x83, 0x81, 0x7c, 0x84, 0x79, 0x83, 0x83, 0x7f, 0x7a, 0x88, 0x80, 0x73, 0x95,
+		0x6d, 0x90, 0x6e, 0x99, 0x5b, 0xa7, 0x66, 0x8d, 0x7b, 0x7d, 0x85, 0x7c, 0x89, 0x79, 0x7f, 0x83,
+		0x81, 0x7a, 0x8a, 0x73, 0x90, 0x6b, 0x9a, 0x60, 0x9f, 0x70, 0x7f, 0x92, 0x62, 0xa6, 0x52, 0xb1,
+		0x56, 0xa3, 0x5a, 0xa5, 0x67, 0x89, 0x84, 0x71, 0x95, 0x69, 0x9a, 0x61, 0xa8, 0x58, 0x98, 0x77,
+		0x7b, 0x95, 0x63, 0x99, 0x6a, 0x92, 0x75, 0x8e, 0x6c, 0x93, 0x78, 0x81, 0x79, 0x90, 0x6f, 0x86,
+		0x88, 0x70, 0x86, 0x84, 0x7f, 0x75, 0x98, 0x62, 0x9e, 0x63, 0x9d, 0x68, 0x8f, 0x78, 0x89, 0x79,
+		0x7a, 0x96, 0x63, 0x9c, 0x62, 0x98, 0x79, 0x79, 0x8d, 0x6f, 0x93, 0x6d, 0x8c, 0x79, 0x82, 0x8d,
+		0x65, 0x9c, 0x65, 0x9b, 0x6c, 0x86, 0x86, 0x7c, 0x7c, 0x88, 0x7b, 0x79, 0x8d, 0x77, 0x88, 0x7d,
+		0x7c, 0x88, 0x77, 0x88, 0x7e, 0x77, 0x97, 0x67, 0x8b, 0x87, 0x6c, 0x9f, 0x56, 0xb3, 0x4d, 0xa3,
+		0x6d, 0x8f, 0x76, 0x84, 0x81, 0x79, 0x84, 0x82, 0x7a, 0x88, 0x79, 0x82, 0x79, 0x8a, 0x7b, 0x83,
+		0x82, 0x6f, 0x99, 0x66, 0x9c, 0x6d, 0x80, 0x93, 0x67, 0x96, 0x71, 0x87, 0x7e, 0x7e, 0x89, 0x6b,
+		0x99, 0x6d, 0x89, 0x82, 0x71, 0x91, 0x75, 0x8b, 0x79, 0x86, 0x7a, 0x7f, 0x88, 0x75, 0x87, 0x7f,
+		0x79, 0x88, 0x78, 0x89, 0x76, 0x8c, 0x7c, 0x79, 0x8c, 0x79, 0x82, 0x7b, 0x8f, 0x73, 0x86, 0x80,
+		0x77, 0x8a, 0x76, 0x8d, 0x7b, 0x78, 0x92, 0x6d, 0x97, 0x63, 0x9e, 0x64, 0x9c, 0x5d, 0xa1, 0x67,
+		0x94, 0x6a, 0x98, 0x70, 0x89, 0x7f, 0x7c, 0x8e, 0x62, 0xa2, 0x68, 0x96, 0x69, 0x98, 0x69, 0x90,
+		0x7b, 0x80, 0x80, 0x7f, 0x80, 0x89, 0x6b, 0x96, 0x73, 0x82, 0x83, 0x78, 0x90, 0x6f, 0x86, 0x8a,
+		0x6b, 0x97, 0x68, 0x91, 0x7e, 0x7b, 0x8b, 0x6d, 0x97, 0x6f, 0x8b, 0x72, 0x90, 0x70, 0x97, 0x63,
+		0x9f, 0x5b, 0xac, 0x52, 0xac, 0x56, 0xa9, 0x58, 0xaa, 0x56, 0xa6, 0x5d, 0x9b, 0x75, 0x7b, 0x96,
+		0x5b, 0xa6, 0x61, 0x94, 0x7d, 0x73, 0x95, 0x72, 0x81, 0x8a, 0x6f, 0x91, 0x77, 0x80, 0x80, 0x82,
+		0x7f, 0x7e, 0x8a, 0x77, 0x7e, 0x88, 0x7c, 0x7b, 0x93, 0x5d, 0xac, 0x4f, 0xb6, 0x45, 0xbb, 0x4d,
+		0xae, 0x5c, 0x94, 0x7a, 0x7b, 0x8c, 0x6d, 0x9c, 0x64, 0x91, 0x78, 0x7d, 0x8c, 0x76, 0x82, 0x81,
+		0x7b, 0x8b, 0x6b, 0x99, 0x6a, 0x8d, 0x7a, 0x82, 0x7d, 0x90, 0x66, 0x97, 0x75, 0x84, 0x7e, 0x85,
+		0x71, 0x9c, 0x5e, 0x9d, 0x6d, 0x8c, 0x7b, 0x86, 0x76, 0x86, 0x7b, 0x88, 0x7d, 0x75, 0x9d, 0x5a,
+		0xa8, 0x5f, 0x8b, 0x82, 0x7e, 0x80, 0x7f, 0x87, 0x79, 0x80, 0x8d, 0x68, 0x93, 0x7b, 0x78, 0x94,
+		0x62, 0xa5, 0x57, 0xa2, 0x6d, 0x8d, 0x76, 0x84, 0x79, 0x87, 0x84, 0x6c, 0x9b, 0x63, 0x99, 0x75,
+		0x7a, 0x93, 0x6f, 0x86, 0x7f, 0x80, 0x84, 0x79, 0x8b, 0x6e, 0x93, 0x6b, 0x9d, 0x61, 0x9d, 0x69,
+		0x88, 0x85, 0x74, 0x92, 0x6f, 0x8c, 0x78, 0x7c, 0x96, 0x5b, 0xb2, 0x4a, 0xb5, 0x54, 0x99, 0x7c,
+		0x79, 0x80, 0x8b, 0x6c, 0x9b, 0x5f, 0xa6, 0x54, 0xa9, 0x5f, 0x9e, 0x65, 0x94, 0x71, 0x91, 0x6b,
+		0x9b, 0x67, 0x8f, 0x7d, 0x87, 0x72, 0x92, 0x71, 0x86, 0x7e, 0x86, 0x7b, 0x80, 0x84, 0x75, 0x8c,
+		0x73, 0x8d, 0x73, 0x98, 0x61, 0x97, 0x76, 0x80, 0x88, 0x6f, 0x92, 0x7a, 0x77, 0x94, 0x61, 0xa7,
+		0x55, 0xa4, 0x69, 0x88, 0x80, 0x7f, 0x84, 0x78, 0x97, 0x5b, 0xb0, 0x4a, 0xb1, 0x55, 0xa6, 0x6a,
+		0x7f, 0x95, 0x66, 0x8d, 0x83, 0x78, 0x7f, 0x87, 0x78, 0x89, 0x73, 0x91, 0x70, 0x8c, 0x74, 0x91,
+		0x6e, 0x89, 0x83, 0x76, 0x8d, 0x6f, 0x91, 0x73, 0x81, 0x88, 0x78, 0x7d, 0x95, 0x5b, 0xa7, 0x5f,
+		0x9e, 0x60, 0xa7, 0x5c, 0x99, 0x6b, 0x92, 0x6f, 0x93, 0x6d, 0x96, 0x67, 0x98, 0x6a, 0x90, 0x7b,
+		0x7e, 0x85, 0x80, 0x7b, 0x86, 0x7c, 0x88, 0x70, 0x91, 0x72, 0x90, 0x6d, 0x8b, 0x7d, 0x88, 0x77,
+		0x89, 0x6d, 0xa2, 0x5b, 0x96, 0x74, 0x8e, 0x6c, 0x98, 0x72, 0x7e, 0x8c, 0x6e, 0x93, 0x79, 0x80,
+		0x80, 0x7e, 0x89, 0x6a, 0xa3, 0x55, 0xad, 0x5a, 0xa0, 0x63, 0x93, 0x73, 0x92, 0x63, 0xa6, 0x5e,
+		0x97, 0x72, 0x8a, 0x72, 0x8e, 0x76, 0x8d, 0x6e, 0x91, 0x78, 0x80, 0x7b, 0x92, 0x64, 0x97, 0x7b,
+		0x75, 0x90, 0x6e, 0x8d, 0x79, 0x83, 0x85, 0x74, 0x93, 0x6e, 0x87, 0x86, 0x6b, 0x98, 0x70, 0x8a,
+		0x7f, 0x7b, 0x8c, 0x6f, 0x90, 0x6f, 0x8c, 0x79, 0x84, 0x84, 0x75, 0x92, 0x68, 0x97, 0x6e, 0x90,
+		0x79, 0x79, 0x8b, 0x79, 0x80, 0x82, 0x81, 0x7c, 0x85, 0x74, 0x8e, 0x75, 0x87, 0x7a, 0x87, 0x75,
+		0x95, 0x64, 0x97, 0x6f, 0x92, 0x6d, 0x8f, 0x75, 0x8f, 0x70, 0x86, 0x88, 0x75, 0x85, 0x80, 0x78,
+		0x8f, 0x72, 0x8d, 0x77, 0x7f, 0x87, 0x75, 0x8b, 0x75, 0x93, 0x65, 0xa0, 0x65, 0x91, 0x6c, 0x9d,
+		0x61, 0x95, 0x6f, 0x92, 0x76, 0x7f, 0x81, 0x81, 0x7f, 0x83, 0x80, 0x79, 0x92, 0x6a, 0x98, 0x67,
+		0x9a, 0x67, 0x98, 0x61, 0xa6, 0x60, 0x9b, 0x65, 0x97, 0x72, 0x85, 0x81, 0x78, 0x87, 0x77, 0x94,
+		0x61, 0xa3, 0x66, 0x8f, 0x75, 0x87, 0x7c, 0x7d, 0x88, 0x76, 0x8b, 0x7d, 0x78, 0x8a, 0x7a, 0x86,
+		0x7c, 0x80, 0x83, 0x7e, 0x78, 0x8f, 0x78, 0x7f, 0x80, 0x7f, 0x83, 0x80, 0x85, 0x7c, 0x81, 0x7c,
+		0x86, 0x76, 0x94, 0x65, 0xa0, 0x5e, 0xa2, 0x64, 0x92, 0x76, 0x85, 0x80, 0x79, 0x8d, 0x70, 0x8c,
+		0x76, 0x8c, 0x7a, 0x7e, 0x8b, 0x70, 0x8f, 0x77, 0x85, 0x78, 0x89, 0x7e, 0x80, 0x7d, 0x86, 0x79,
+		0x85, 0x80, 0x76, 0x8c, 0x79, 0x80, 0x84, 0x7d, 0x84, 0x7e, 0x80, 0x7f, 0x82, 0x7c, 0x86, 0x78,
+		0x87, 0x7c, 0x80, 0x83, 0x79, 0x88, 0x79, 0x85, 0x7c, 0x82, 0x7d, 0x83, 0x7e, 0x81, 0x80, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	},
+	{ // 7
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x82, 0x7e, 0x82, 0x7e, 0x80, 0x81,
+		0x7e, 0x84, 0x7b, 0x84, 0x7c, 0x85, 0x7b, 0x84, 0x7d, 0x81, 0x82, 0x7b, 0x89, 0x77, 0x86, 0x7c,
+		0x82, 0x80, 0x80, 0x7e, 0x87, 0x74, 0x8c, 0x77, 0x87, 0x7d, 0x80, 0x81, 0x80, 0x7c, 0x89, 0x75,
+		0x8a, 0x78, 0x85, 0x7d, 0x82, 0x80, 0x79, 0x8e, 0x6b, 0x96, 0x70, 0x8c, 0x74, 0x8e, 0x71, 0x8e,
+		0x77, 0x82, 0x80, 0x81, 0x7f, 0x80, 0x84, 0x77, 0x89, 0x7b, 0x80, 0x82, 0x7f, 0x81, 0x79, 0x8b,
+		0x78, 0x84, 0x7a, 0x87, 0x7a, 0x87, 0x7c, 0x84, 0x7d, 0x81, 0x7d, 0x85, 0x7e, 0x81, 0x7b, 0x87,
+		0x7a, 0x81, 0x81, 0x7c, 0x88, 0x7c, 0x80, 0x80, 0x7f, 0x85, 0x79, 0x8b, 0x76, 0x88, 0x75, 0x8b,
+		0x7d, 0x7d, 0x89, 0x72, 0x8d, 0x73, 0x8f, 0x72, 0x8b, 0x76, 0x8b, 0x70, 0x8f, 0x79, 0x83, 0x7c,
+		0x83, 0x81, 0x78, 0x8a, 0x7b, 0x82, 0x80, 0x7c, 0x8a, 0x71, 0x92, 0x6e, 0x8a, 0x80, 0x7c, 0x88,
+		0x76, 0x89, 0x7d, 0x7d, 0x83, 0x80, 0x7a, 0x8c, 0x72, 0x90, 0x70, 0x8a, 0x7b, 0x85, 0x78, 0x8b,
+		0x76, 0x85, 0x7c, 0x86, 0x78, 0x8b, 0x70, 0x92, 0x73, 0x88, 0x7c, 0x80, 0x83, 0x78, 0x8f, 0x6c,
+		0x91, 0x74, 0x8a, 0x7b, 0x7c, 0x8c, 0x76, 0x84, 0x83, 0x74, 0x90, 0x70, 0x87, 0x83, 0x71, 0x99,
+		0x67, 0x92, 0x71, 0x8a, 0x7a, 0x87, 0x7a, 0x7e, 0x85, 0x7a, 0x85, 0x82, 0x75, 0x92, 0x6d, 0x92,
+		0x6b, 0x98, 0x67, 0x99, 0x6d, 0x8d, 0x77, 0x83, 0x7f, 0x82, 0x7e, 0x81, 0x80, 0x81, 0x80, 0x80,
+		0x7a, 0x8b, 0x74, 0x8a, 0x7d, 0x7c, 0x86, 0x78, 0x8f, 0x6b, 0x94, 0x6d, 0x94, 0x6e, 0x92, 0x6e,
+		0x90, 0x71, 0x90, 0x6e, 0x95, 0x6b, 0x8f, 0x7a, 0x80, 0x82, 0x80, 0x81, 0x79, 0x8f, 0x71, 0x88,
+		0x7b, 0x83, 0x85, 0x76, 0x87, 0x7f, 0x81, 0x7c, 0x88, 0x73, 0x90, 0x69, 0x9e, 0x5c, 0xa1, 0x67,
+		0x92, 0x7a, 0x7d, 0x87, 0x7c, 0x7c, 0x89, 0x7a, 0x80, 0x88, 0x72, 0x90, 0x73, 0x8d, 0x77, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x7e, 0x83, 0x7e, 0x80, 0x7e, 0x87, 0x73, 0x94, 0x6a, 0x91, 0x75, 0x85,
+		0x80, 0x7f, 0x7f, 0x86, 0x75, 0x8c, 0x78, 0x88, 0x77, 0x88, 0x7e, 0x7b, 0x8c, 0x6f, 0x8f, 0x73,
+		0x8f, 0x6e, 0x96, 0x6b, 0x8f, 0x77, 0x84, 0x7f, 0x7e, 0x87, 0x77, 0x84, 0x80, 0x7b, 0x87, 0x7e,
+		0x79, 0x91, 0x66, 0x9c, 0x6b, 0x8b, 0x7a, 0x88, 0x77, 0x87, 0x7e, 0x80, 0x80, 0x7e, 0x87, 0x74,
+		0x8c, 0x7c, 0x80, 0x7d, 0x86, 0x7f, 0x78, 0x8b, 0x76, 0x8b, 0x76, 0x8b, 0x74, 0x88, 0x7d, 0x7f,
+		0x8a, 0x72, 0x89, 0x79, 0x85, 0x7c, 0x88, 0x73, 0x92, 0x6d, 0x8f, 0x75, 0x8d, 0x71, 0x8a, 0x7c,
+		0x83, 0x79, 0x88, 0x7c, 0x7d, 0x8c, 0x6f, 0x91, 0x71, 0x8b, 0x7e, 0x7b, 0x8b, 0x77, 0x81, 0x86,
+		0x74, 0x90, 0x70, 0x8b, 0x78, 0x84, 0x7f, 0x82, 0x80, 0x7d, 0x86, 0x73, 0x93, 0x67, 0x9c, 0x66,
+		0x92, 0x7a, 0x7b, 0x8b, 0x70, 0x96, 0x68, 0x94, 0x6f, 0x92, 0x6e, 0x8c, 0x7e, 0x79, 0x8e, 0x76,
+		0x85, 0x80, 0x7e, 0x81, 0x80, 0x7e, 0x83, 0x7e, 0x81, 0x80, 0x7f, 0x7f, 0x88, 0x77, 0x84, 0x81,
+		0x78, 0x8b, 0x72, 0x91, 0x70, 0x91, 0x72, 0x87, 0x82, 0x77, 0x87, 0x79, 0x87, 0x7c, 0x80, 0x83,
+		0x80, 0x7b, 0x87, 0x78, 0x8d, 0x6c, 0x97, 0x6c, 0x8e, 0x74, 0x8c, 0x79, 0x7f, 0x84, 0x80, 0x7f,
+		0x7e, 0x88, 0x78, 0x81, 0x85, 0x7c, 0x80, 0x83, 0x78, 0x8a, 0x7a, 0x80, 0x84, 0x7c, 0x80, 0x82,
+		0x7f, 0x81, 0x7b, 0x8c, 0x70, 0x91, 0x6c, 0x95, 0x6e, 0x90, 0x6e, 0x96, 0x69, 0x98, 0x67, 0x92,
+		0x79, 0x83, 0x79, 0x87, 0x7d, 0x80, 0x82, 0x7c, 0x86, 0x79, 0x86, 0x80, 0x79, 0x90, 0x68, 0x9f,
+		0x5d, 0x9e, 0x6b, 0x90, 0x75, 0x89, 0x79, 0x81, 0x84, 0x7b, 0x85, 0x7c, 0x82, 0x82, 0x7d, 0x80,
+		0x81, 0x76, 0x93, 0x6d, 0x8b, 0x7e, 0x7e, 0x83, 0x7b, 0x8d, 0x6a, 0x99, 0x69, 0x93, 0x77, 0x83,
+		0x7e, 0x80, 0x80, 0x81, 0x81, 0x7e, 0x82, 0x7e, 0x7f, 0x80, 0x84, 0x7c, 0x85, 0x7b, 0x85, 0x77,
+		0x8b, 0x76, 0x86, 0x83, 0x71, 0x93, 0x73, 0x89, 0x78, 0x89, 0x75, 0x88, 0x80, 0x78, 0x88, 0x80,
+		0x7c, 0x85, 0x78, 0x8d, 0x70, 0x8d, 0x74, 0x8c, 0x74, 0x89, 0x7c, 0x81, 0x7e, 0x85, 0x77, 0x8a,
+		0x76, 0x89, 0x7d, 0x80, 0x80, 0x7e, 0x87, 0x79, 0x84, 0x77, 0x90, 0x6a, 0x95, 0x71, 0x8d, 0x6e,
+		0x94, 0x6e, 0x93, 0x6a, 0x9a, 0x68, 0x90, 0x77, 0x80, 0x83, 0x80, 0x7b, 0x8a, 0x6e, 0x97, 0x6a,
+		0x92, 0x75, 0x87, 0x77, 0x88, 0x7a, 0x86, 0x7a, 0x85, 0x7f, 0x7d, 0x87, 0x79, 0x88, 0x74, 0x91,
+		0x72, 0x84, 0x84, 0x7a, 0x84, 0x79, 0x8c, 0x71, 0x8f, 0x76, 0x85, 0x7d, 0x83, 0x7d, 0x7f, 0x89,
+		0x6c, 0x99, 0x66, 0x9f, 0x61, 0x96, 0x76, 0x83, 0x80, 0x80, 0x7f, 0x86, 0x7c, 0x81, 0x7e, 0x83,
+		0x7c, 0x85, 0x79, 0x8a, 0x79, 0x82, 0x80, 0x7d, 0x8a, 0x6f, 0x91, 0x73, 0x87, 0x7d, 0x84, 0x80,
+		0x78, 0x92, 0x6c, 0x8c, 0x7c, 0x80, 0x80, 0x87, 0x71, 0x96, 0x66, 0x98, 0x69, 0x9b, 0x60, 0x9f,
+		0x66, 0x93, 0x73, 0x8d, 0x76, 0x81, 0x85, 0x79, 0x8b, 0x76, 0x88, 0x76, 0x8d, 0x6e, 0x98, 0x65,
+		0x9d, 0x67, 0x90, 0x74, 0x90, 0x6f, 0x8d, 0x75, 0x8d, 0x6e, 0x97, 0x6a, 0x91, 0x76, 0x86, 0x79,
+		0x87, 0x7a, 0x86, 0x7a, 0x83, 0x85, 0x75, 0x87, 0x80, 0x77, 0x92, 0x68, 0x97, 0x73, 0x82, 0x89,
+		0x72, 0x8e, 0x6f, 0x93, 0x71, 0x8b, 0x74, 0x90, 0x6a, 0x96, 0x6e, 0x90, 0x75, 0x82, 0x83, 0x7d,
+		0x80, 0x80, 0x84, 0x76, 0x8f, 0x6f, 0x93, 0x6c, 0x93, 0x6c, 0x90, 0x76, 0x86, 0x79, 0x8b, 0x6f,
+		0x94, 0x6d, 0x8d, 0x7a, 0x81, 0x82, 0x80, 0x7e, 0x7f, 0x84, 0x7a, 0x88, 0x73, 0x94, 0x69, 0x99,
+		0x65, 0x96, 0x74, 0x84, 0x80, 0x7f, 0x7a, 0x90, 0x6f, 0x8d, 0x76, 0x88, 0x7e, 0x80, 0x82, 0x7d,
+		0x80, 0x87, 0x74, 0x87, 0x81, 0x74, 0x96, 0x68, 0x90, 0x7b, 0x7b, 0x8a, 0x75, 0x8e, 0x6f, 0x92,
+		0x72, 0x87, 0x81, 0x75, 0x93, 0x6a, 0x97, 0x67, 0x96, 0x6e, 0x90, 0x72, 0x8e, 0x72, 0x8b, 0x74,
+		0x92, 0x6d, 0x8e, 0x76, 0x85, 0x80, 0x82, 0x76, 0x93, 0x65, 0x9b, 0x6a, 0x90, 0x72, 0x8f, 0x70,
+		0x8b, 0x7d, 0x80, 0x83, 0x7f, 0x80, 0x82, 0x7e, 0x80, 0x7c, 0x88, 0x76, 0x87, 0x7d, 0x87, 0x72,
+		0x92, 0x72, 0x89, 0x75, 0x8e, 0x72, 0x8b, 0x7b, 0x7f, 0x83, 0x80, 0x79, 0x8d, 0x6f, 0x91, 0x6f,
+		0x8f, 0x79, 0x80, 0x82, 0x80, 0x80, 0x7c, 0x85, 0x81, 0x77, 0x91, 0x6e, 0x8e, 0x76, 0x87, 0x7b,
+		0x83, 0x7d, 0x83, 0x7f, 0x7c, 0x85, 0x7f, 0x79, 0x8b, 0x75, 0x87, 0x7e, 0x85, 0x74, 0x92, 0x6b,
+		0x97, 0x66, 0x9d, 0x67, 0x8f, 0x7b, 0x80, 0x85, 0x79, 0x80, 0x83, 0x80, 0x80, 0x7f, 0x7d, 0x89,
+		0x73, 0x8b, 0x7a, 0x81, 0x81, 0x81, 0x7b, 0x86, 0x7b, 0x80, 0x7f, 0x86, 0x78, 0x88, 0x77, 0x8a,
+		0x7a, 0x80, 0x80, 0x80, 0x81, 0x7e, 0x7e, 0x84, 0x80, 0x80, 0x7e, 0x85, 0x78, 0x8a, 0x79, 0x80,
+		0x88, 0x75, 0x8d, 0x6d, 0x94, 0x6c, 0x93, 0x72, 0x89, 0x7b, 0x7f, 0x86, 0x78, 0x87, 0x81, 0x76,
+		0x94, 0x66, 0x97, 0x72, 0x82, 0x8b, 0x68, 0x9b, 0x6c, 0x8c, 0x76, 0x89, 0x7b, 0x7f, 0x86, 0x76,
+		0x8a, 0x7b, 0x83, 0x7d, 0x80, 0x85, 0x77, 0x8f, 0x73, 0x85, 0x80, 0x80, 0x7e, 0x84, 0x7e, 0x84,
+		0x7b, 0x83, 0x7c, 0x80, 0x83, 0x7e, 0x83, 0x80, 0x7c, 0x86, 0x77, 0x87, 0x7f, 0x7c, 0x84, 0x81,
+		0x78, 0x8c, 0x75, 0x8c, 0x74, 0x85, 0x81, 0x7d, 0x80, 0x88, 0x70, 0x93, 0x73, 0x87, 0x7b, 0x84,
+		0x7c, 0x86, 0x78, 0x83, 0x82, 0x7c, 0x85, 0x77, 0x8f, 0x71, 0x8a, 0x7c, 0x82, 0x81, 0x80, 0x81,
+		0x7b, 0x80, 0x83, 0x7c, 0x87, 0x75, 0x89, 0x72, 0x8d, 0x7b, 0x7d, 0x8c, 0x6a, 0x98, 0x6f, 0x8f,
+		0x74, 0x88, 0x7f, 0x7d, 0x8c, 0x6c, 0x97, 0x68, 0x96, 0x6e, 0x90, 0x74, 0x83, 0x83, 0x7b, 0x86,
+		0x77, 0x8b, 0x73, 0x8b, 0x7a, 0x82, 0x84, 0x77, 0x8d, 0x75, 0x86, 0x82, 0x77, 0x88, 0x7d, 0x82,
+		0x7b, 0x86, 0x7b, 0x81, 0x82, 0x7c, 0x88, 0x75, 0x8c, 0x75, 0x8a, 0x77, 0x84, 0x80, 0x80, 0x7f,
+		0x89, 0x89, 0x75, 0x82, 0x75, 0x8f, 0x72, 0x8f, 0x6a, 0x87, 0x79, 0x8c, 0x76, 0x85, 0x75, 0x8f,
+		0x79, 0x8d, 0x73, 0x8a, 0x7d, 0x84, 0x81, 0x80, 0x7d, 0x7d, 0x86, 0x7f, 0x79, 0x86, 0x77, 0x85,
+		0x82, 0x7a, 0x87, 0x77, 0x8a, 0x7c, 0x85, 0x7c, 0x84, 0x7c, 0x87, 0x7c, 0x7f, 0x83, 0x7b, 0x87,
+		0x78, 0x82, 0x80, 0x7d, 0x85, 0x7c, 0x82, 0x80, 0x7e, 0x88, 0x79, 0x84, 0x80, 0x7b, 0x8a, 0x78,
+		0x83, 0x7e, 0x82, 0x8b, 0x94, 0x63, 0x83, 0x79, 0x8e, 0x78, 0x77, 0x7b, 0x77, 0x92, 0x7b, 0x7c,
+		0x7d, 0x85, 0x8c, 0x7e, 0x84, 0x78, 0x8a, 0x83, 0x82, 0x75, 0x81, 0x7a, 0x8a, 0x72, 0x86, 0x75,
+		0x84, 0x88, 0x76, 0x89, 0x77, 0x8c, 0x82, 0x7e, 0x85, 0x79, 0x87, 0x82, 0x7b, 0x7c, 0x85, 0x79,
+		0x86, 0x79, 0x82, 0x7e, 0x83, 0x83, 0x79, 0x88, 0x79, 0x8a, 0x7a, 0x86, 0x76, 0x8a, 0x77, 0x89,
+		0x73, 0x89, 0x76, 0x8a, 0x78, 0x84, 0x99, 0x94, 0x62, 0x6e, 0x91, 0x87, 0x6c, 0x74, 0x77, 0x80,
+		0x91, 0x7b, 0x71, 0x82, 0x9e, 0x7c, 0x81, 0x79, 0x8c, 0x80, 0x88, 0x6f, 0x78, 0x86, 0x83, 0x78,
+		0x76, 0x82, 0x83, 0x83, 0x83, 0x76, 0x8a, 0x88, 0x82, 0x78, 0x82, 0x81, 0x81, 0x7e, 0x77, 0x7f,
+		0x83, 0x80, 0x80, 0x77, 0x8a, 0x80, 0x84, 0x7c, 0x83, 0x82, 0x82, 0x80, 0x7c, 0x80, 0x80, 0x80,
+		0x7b, 0x81, 0x7f, 0x82, 0x80, 0x7e, 0x83, 0x82, 0x80, 0xaf, 0x93, 0x49, 0x6a, 0xa8, 0x7c, 0x54,
+		0x70, 0x86, 0x84, 0x8e, 0x72, 0x75, 0xa0, 0xa0, 0x6e, 0x74, 0x92, 0x8a, 0x77, 0x70, 0x74, 0x80,
+		0x8b, 0x73, 0x6d, 0x8a, 0x94, 0x7e, 0x7a, 0x89, 0x89, 0x88, 0x7c, 0x76, 0x80, 0x87, 0x7b, 0x6f,
+		0x80, 0x85, 0x82, 0x7a, 0x81, 0x85, 0x88, 0x82, 0x7b, 0x81, 0x87, 0x7f, 0x77, 0x7d, 0x81, 0x80,
+		0x7c, 0x7d, 0x81, 0x85, 0x82, 0x7e, 0x81, 0x85, 0x82, 0x7d, 0x7f, 0x80, 0x80, 0xc3, 0x93, 0x1f,
+		0x6d, 0xcd, 0x68, 0x2c, 0x85, 0xa4, 0x80, 0x7e, 0x78, 0x87, 0xb4, 0x8f, 0x50, 0x80, 0xae, 0x74,
+		0x52, 0x7b, 0x8b, 0x7f, 0x79, 0x72, 0x83, 0xa1, 0x89, 0x69, 0x86, 0x9e, 0x7f, 0x6b, 0x7c, 0x84,
+		0x80, 0x78, 0x71, 0x7f, 0x91, 0x83, 0x73, 0x85, 0x93, 0x83, 0x78, 0x7f, 0x84, 0x82, 0x7b, 0x75,
+		0x7e, 0x87, 0x7f, 0x77, 0x81, 0x8a, 0x83, 0x7e, 0x80, 0x84, 0x83, 0x7f, 0x7a, 0x7f, 0x83, 0x7e,
+		0x79, 0x80, 0xcc, 0x98, 0x1d, 0x6c, 0xd1, 0x6d, 0x2a, 0x7f, 0xa2, 0x84, 0x7d, 0x72, 0x84, 0xb8,
+		0x92, 0x4f, 0x7f, 0xb1, 0x78, 0x52, 0x78, 0x8b, 0x81, 0x78, 0x6e, 0x81, 0xa2, 0x8a, 0x67, 0x84,
+		0xa0, 0x81, 0x6b, 0x7b, 0x84, 0x81, 0x78, 0x6f, 0x7e, 0x91, 0x83, 0x72, 0x83, 0x93, 0x84, 0x79,
+		0x7f, 0x84, 0x83, 0x7c, 0x74, 0x7d, 0x88, 0x80, 0x76, 0x80, 0x8a, 0x83, 0x7e, 0x80, 0x84, 0x84,
+		0x7f, 0x79, 0x7f, 0x84, 0x7f, 0x79, 0xc6, 0x9b, 0x20, 0x6a, 0xce, 0x6c, 0x2f, 0x82, 0xa0, 0x81,
+		0x80, 0x75, 0x81, 0xb5, 0x93, 0x51, 0x7e, 0xae, 0x77, 0x54, 0x7a, 0x89, 0x80, 0x7b, 0x70, 0x80,
+		0xa1, 0x8b, 0x69, 0x84, 0x9e, 0x80, 0x6c, 0x7b, 0x83, 0x80, 0x7a, 0x70, 0x7d, 0x90, 0x83, 0x73,
+		0x83, 0x92, 0x84, 0x79, 0x7f, 0x83, 0x82, 0x7c, 0x75, 0x7d, 0x87, 0x80, 0x77, 0x80, 0x8a, 0x83,
+		0x7e, 0x80, 0x83, 0x84, 0x7f, 0x7a, 0x7e, 0x83, 0x7f, 0x79, 0x7f, 0xcc, 0x98, 0x1d, 0x6b, 0xd1,
+		0x6d, 0x2b, 0x7f, 0xa2, 0x84, 0x7d, 0x72, 0x84, 0xb8, 0x92, 0x4f, 0x7f, 0xb1, 0x78, 0x52, 0x78,
+		0x8b, 0x81, 0x79, 0x6e, 0x81, 0xa2, 0x8a, 0x68, 0x84, 0xa0, 0x81, 0x6b, 0x7b, 0x84, 0x81, 0x78,
+		0x6f, 0x7e, 0x91, 0x83, 0x72, 0x83, 0x93, 0x84, 0x79, 0x7f, 0x84, 0x83, 0x7c, 0x74, 0x7d, 0x88,
+		0x80, 0x76, 0x80, 0x8a, 0x83, 0x7e, 0x80, 0x84, 0x84, 0x7f, 0x79, 0x7f, 0x84, 0x7f, 0x79, 0x7f,
+		0xcc, 0x98, 0x1c, 0x6b, 0xd1, 0x6d, 0x2b, 0x7f, 0xa2, 0x84, 0x7d, 0x72, 0x84, 0xb8, 0x92, 0x4f,
+		0x7e, 0xb1, 0x79, 0x52, 0x78, 0x8b, 0x81, 0x79, 0x6e, 0x80, 0xa2, 0x8a, 0x67, 0x84, 0xa0, 0x81,
+		0x6b, 0x7a, 0x84, 0x81, 0x78, 0x6f, 0x7e, 0x91, 0x83, 0x72, 0x83, 0x93, 0x84, 0x79, 0x7e, 0x84,
+		0x83, 0x7c, 0x74, 0x7d, 0x88, 0x80, 0x76, 0x80, 0x8a, 0x83, 0x7e, 0x80, 0x84, 0x84, 0x7f, 0x79,
+		0x7f, 0x84, 0x7f, 0x79, 0x7f, 0x84, 0xc9, 0x95, 0x1e, 0x6f, 0xd2, 0x6a, 0x27, 0x81, 0xa6, 0x80,
+		0x79, 0x74, 0x88, 0xb7, 0x90, 0x4f, 0x80, 0xb2, 0x76, 0x50, 0x7a, 0x8d, 0x80, 0x76, 0x6f, 0x82,
+		0xa2, 0x89, 0x68, 0x86, 0xa1, 0x80, 0x6a, 0x7c, 0x86, 0x80, 0x77, 0x6f, 0x7f, 0x91, 0x82, 0x72,
+		0x84, 0x94, 0x84, 0x78, 0x7f, 0x85, 0x83, 0x7b, 0x74, 0x7e, 0x87, 0x7f, 0x76, 0x81, 0x8a, 0x83,
+		0x7d, 0x80, 0x85, 0x84, 0x7f, 0x7a, 0x7f, 0x84, 0x7e, 0x79, 0x7f, 0x84, 0xc9, 0x94, 0x1e, 0x6f,
+		0xd2, 0x69, 0x27, 0x82, 0xa6, 0x80, 0x79, 0x75, 0x88, 0xb7, 0x90, 0x4f, 0x80, 0xb2, 0x76, 0x50,
+		0x7a, 0x8d, 0x80, 0x76, 0x6f, 0x82, 0xa1, 0x89, 0x68, 0x86, 0xa1, 0x80, 0x6a, 0x7c, 0x86, 0x80,
+		0x77, 0x70, 0x7f, 0x91, 0x82, 0x72, 0x84, 0x94, 0x84, 0x78, 0x7f, 0x85, 0x83, 0x7b, 0x74, 0x7e,
+		0x87, 0x7f, 0x76, 0x81, 0x8a, 0x83, 0x7d, 0x80, 0x85, 0x84, 0x7f, 0x7a, 0x7f, 0x84, 0x7e, 0x79,
+		0x7f, 0x84, 0xc9, 0x94, 0x1e, 0x6f, 0xd2, 0x69, 0x27, 0x82, 0xa6, 0x80, 0x79, 0x75, 0x88, 0xb7,
+		0x90, 0x4f, 0x80, 0xb2, 0x76, 0x50, 0x7a, 0x8d, 0x80, 0x76, 0x6f, 0x82, 0xa1, 0x89, 0x68, 0x86,
+		0xa1, 0x80, 0x6a, 0x7c, 0x86, 0x80, 0x77, 0x70, 0x7f, 0x91, 0x82, 0x72, 0x84, 0x94, 0x84, 0x78,
+		0x7f, 0x85, 0x83, 0x7b, 0x74, 0x7e, 0x87, 0x7f, 0x76, 0x81, 0x8a, 0x83, 0x7d, 0x80, 0x85, 0x84,
+		0x7f, 0x7a, 0x7f, 0x84, 0x7e, 0x79, 0x7f, 0x84, 0x81, 0xc5, 0x96, 0x22, 0x70, 0xce, 0x66, 0x2a,
+		0x84, 0xa1, 0x7d, 0x7c, 0x78, 0x86, 0xb5, 0x91, 0x52, 0x81, 0xb0, 0x74, 0x52, 0x7c, 0x8a, 0x7d,
+		0x77, 0x71, 0x81, 0x9f, 0x89, 0x6a, 0x86, 0x9f, 0x81, 0x6d, 0x7c, 0x83, 0x80, 0x7a, 0x70, 0x79,
+		0x8e, 0x86, 0x74, 0x7f, 0x90, 0x89, 0x7d, 0x7e, 0x81, 0x84, 0x81, 0x78, 0x76, 0x82, 0x84, 0x7a,
+		0x79, 0x82, 0x85, 0x83, 0x80, 0x80, 0x82, 0x86, 0x81, 0x7b, 0x7e, 0x82, 0x80, 0x7c, 0x7c, 0x7f,
+		0xab, 0x9d, 0x50, 0x5c, 0xa2, 0x92, 0x5e, 0x66, 0x7e, 0x84, 0x8f, 0x83, 0x68, 0x7e, 0xa6, 0x92,
+		0x6e, 0x79, 0x8d, 0x89, 0x81, 0x79, 0x6d, 0x7b, 0x8e, 0x80, 0x6d, 0x77, 0x89, 0x88, 0x81, 0x7f,
+		0x7d, 0x84, 0x8e, 0x85, 0x77, 0x7b, 0x85, 0x84, 0x7e, 0x7a, 0x7a, 0x7f, 0x83, 0x81, 0x7a, 0x7b,
+		0x83, 0x85, 0x82, 0x80, 0x80, 0x80, 0x84, 0x84, 0x7f, 0x7c, 0x80, 0x82, 0x80, 0x7d, 0x7d, 0x7e,
+		0x80, 0x81, 0x80, 0x7e, 0x7f, 0x81, 0x82, 0x81, 0x8f, 0x92, 0x79, 0x6f, 0x80, 0x88, 0x7e, 0x79,
+		0x78, 0x74, 0x7b, 0x88, 0x82, 0x77, 0x79, 0x84, 0x87, 0x84, 0x84, 0x81, 0x7d, 0x84, 0x8a, 0x83,
+		0x7d, 0x7d, 0x80, 0x80, 0x80, 0x80, 0x7b, 0x7a, 0x7f, 0x82, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x83,
+		0x84, 0x80, 0x7f, 0x81, 0x82, 0x82, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x82, 0x7f, 0x7e, 0x7f, 0x7f,
+		0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x82, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x82, 0x80, 0x80,
+		0x84, 0x87, 0x81, 0x7d, 0x7c, 0x80, 0x7f, 0x80, 0x81, 0x7d, 0x7b, 0x7c, 0x80, 0x80, 0x7f, 0x80,
+		0x7f, 0x7e, 0x80, 0x80, 0x83, 0x81, 0x80, 0x80, 0x80, 0x81, 0x82, 0x82, 0x81, 0x80, 0x7f, 0x80,
+		0x81, 0x80, 0x81, 0x7f, 0x7f, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x7f,
+		0x81, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x82, 0x7f, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x80, 0x82, 0x82, 0x80, 0x7f, 0x80, 0x7f, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x82, 0x80, 0x7f,
+		0x82, 0x7f, 0x80, 0x81, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x7f,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x81, 0x80, 0x82, 0x82, 0x7f, 0x7f, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x80, 0x7e, 0x7e, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x81, 0x80, 0x80, 0x81, 0x81, 0x7e, 0x80, 0x81, 0x7f, 0x81,
+		0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80,
+		0x7f, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x82, 0x80, 0x7f, 0x81, 0x80, 0x7e,
+		0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81, 0x81, 0x82, 0x81, 0x80, 0x80, 0x80,
+		0x7e, 0x80, 0x82, 0x7f, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80,
+		0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x7e, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x82, 0x82, 0x80, 0x80, 0x7f, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x80, 0x80,
+		0x7e, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x80, 0x80,
+		0x80, 0x80, 0x81, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x81,
+		0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x84,
+		0x80, 0x80, 0x7f, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81,
+		0x7e, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x81, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x81, 0x7f, 0x80, 0x82, 0x7f, 0x80, 0x80, 0x7f, 0x7f,
+		0x81, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80,
+		0x7f, 0x82, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x84, 0x80, 0x80, 0x80, 0x7e, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x7e, 0x80, 0x80, 0x80,
+		0x81, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81,
+		0x80, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x81, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x84, 0x88, 0x81, 0x7d, 0x7d, 0x7e, 0x80, 0x80, 0x81, 0x7f, 0x79, 0x7d,
+		0x7f, 0x80, 0x80, 0x81, 0x80, 0x7d, 0x80, 0x83, 0x82, 0x81, 0x81, 0x80, 0x7f, 0x80, 0x83, 0x81,
+		0x80, 0x7f, 0x80, 0x7e, 0x80, 0x81, 0x7f, 0x7f, 0x7f, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x82, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x8d,
+		0x91, 0x7e, 0x71, 0x79, 0x7f, 0x80, 0x82, 0x7d, 0x72, 0x76, 0x85, 0x87, 0x83, 0x81, 0x7f, 0x7e,
+		0x87, 0x8b, 0x83, 0x7d, 0x7d, 0x7f, 0x80, 0x83, 0x7f, 0x78, 0x7a, 0x80, 0x81, 0x82, 0x80, 0x7e,
+		0x7f, 0x84, 0x86, 0x82, 0x80, 0x80, 0x80, 0x81, 0x82, 0x7f, 0x7c, 0x7e, 0x7f, 0x80, 0x80, 0x80,
+		0x7f, 0x80, 0x83, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x80, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x80,
+		0x7f, 0x80, 0x81, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0xa1, 0x9b, 0x65, 0x5f, 0x7d,
+		0x80, 0x80, 0x7f, 0x68, 0x6d, 0x93, 0x98, 0x83, 0x80, 0x80, 0x82, 0x91, 0x8d, 0x73, 0x6e, 0x7d,
+		0x80, 0x80, 0x7e, 0x73, 0x77, 0x89, 0x8d, 0x83, 0x80, 0x80, 0x82, 0x89, 0x86, 0x7a, 0x77, 0x7d,
+		0x80, 0x80, 0x7e, 0x79, 0x7c, 0x84, 0x87, 0x82, 0x80, 0x80, 0x81, 0x85, 0x83, 0x7d, 0x7b, 0x7e,
+		0x80, 0x80, 0x7f, 0x7d, 0x7e, 0x82, 0x83, 0x81, 0x80, 0x80, 0x81, 0x82, 0x81, 0x7f, 0x7e, 0x7f,
+		0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x81, 0x82, 0x81, 0xa4, 0x9d, 0x64, 0x60, 0x7f, 0x80, 0x7f, 0x7e,
+		0x65, 0x6c, 0x93, 0x98, 0x82, 0x80, 0x81, 0x83, 0x93, 0x8e, 0x73, 0x6f, 0x7d, 0x80, 0x80, 0x7d,
+		0x72, 0x76, 0x89, 0x8d, 0x83, 0x80, 0x80, 0x83, 0x8a, 0x87, 0x7a, 0x77, 0x7e, 0x80, 0x80, 0x7e,
+		0x78, 0x7b, 0x84, 0x87, 0x82, 0x80, 0x80, 0x82, 0x85, 0x83, 0x7d, 0x7b, 0x7e, 0x80, 0x80, 0x7f,
+		0x7c, 0x7e, 0x82, 0x83, 0x81, 0x80, 0x80, 0x81, 0x83, 0x81, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x7f,
+		0x7e, 0x7f, 0x81, 0x82, 0x81, 0xa4, 0x9d, 0x64, 0x60, 0x7f, 0x80, 0x7f, 0x7e, 0x65, 0x6c, 0x93,
+		0x97, 0x82, 0x80, 0x80, 0x83, 0x93, 0x8e, 0x73, 0x6f, 0x7d, 0x80, 0x80, 0x7d, 0x72, 0x76, 0x89,
+		0x8c, 0x82, 0x80, 0x80, 0x83, 0x8a, 0x87, 0x7a, 0x77, 0x7e, 0x80, 0x80, 0x7e, 0x78, 0x7b, 0x84,
+		0x87, 0x82, 0x80, 0x80, 0x82, 0x85, 0x83, 0x7d, 0x7b, 0x7f, 0x80, 0x80, 0x7f, 0x7c, 0x7e, 0x82,
+		0x83, 0x81, 0x80, 0x80, 0x81, 0x83, 0x81, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x81,
+		0x82, 0x81, 0xa4, 0x9d, 0x64, 0x60, 0x7f, 0x80, 0x7f, 0x7e, 0x65, 0x6c, 0x93, 0x97, 0x82, 0x80,
+		0x80, 0x83, 0x93, 0x8e, 0x73, 0x6f, 0x7d, 0x80, 0x80, 0x7d, 0x72, 0x76, 0x89, 0x8c, 0x82, 0x80,
+		0x80, 0x83, 0x8a, 0x87, 0x7a, 0x77, 0x7e, 0x80, 0x80, 0x7e, 0x78, 0x7b, 0x84, 0x87, 0x82, 0x80,
+		0x80, 0x82, 0x85, 0x83, 0x7d, 0x7b, 0x7f, 0x80, 0x80, 0x7f, 0x7c, 0x7e, 0x82, 0x83, 0x81, 0x80,
+		0x80, 0x81, 0x83, 0x81, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x81, 0x82, 0x81, 0x80,
+		0xa4, 0x9e, 0x65, 0x5f, 0x7d, 0x7f, 0x80, 0x7e, 0x65, 0x6b, 0x93, 0x98, 0x84, 0x81, 0x80, 0x83,
+		0x93, 0x8e, 0x73, 0x6e, 0x7c, 0x80, 0x80, 0x7d, 0x72, 0x76, 0x89, 0x8d, 0x83, 0x80, 0x80, 0x82,
+		0x8a, 0x87, 0x7a, 0x77, 0x7d, 0x80, 0x80, 0x7e, 0x78, 0x7b, 0x84, 0x87, 0x82, 0x80, 0x80, 0x82,
+		0x86, 0x84, 0x7e, 0x7c, 0x7e, 0x80, 0x80, 0x7f, 0x7c, 0x7d, 0x81, 0x83, 0x81, 0x80, 0x80, 0x81,
+		0x83, 0x82, 0x80, 0x7e, 0x7f, 0x80, 0x80, 0x7f, 0x7e, 0x7e, 0x80, 0x81, 0x80, 0x80, 0x80, 0x9d,
+		0x9a, 0x6e, 0x68, 0x7e, 0x80, 0x80, 0x80, 0x6a, 0x6b, 0x89, 0x90, 0x82, 0x80, 0x7f, 0x80, 0x90,
+		0x91, 0x7d, 0x77, 0x7f, 0x80, 0x82, 0x80, 0x74, 0x72, 0x80, 0x85, 0x81, 0x80, 0x7d, 0x7f, 0x88,
+		0x8a, 0x82, 0x7e, 0x80, 0x81, 0x83, 0x82, 0x7c, 0x79, 0x7e, 0x80, 0x80, 0x7f, 0x7d, 0x7d, 0x82,
+		0x85, 0x82, 0x80, 0x80, 0x81, 0x83, 0x83, 0x80, 0x7e, 0x7f, 0x80, 0x80, 0x80, 0x7e, 0x7d, 0x80,
+		0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x8f, 0x8e,
+		0x76, 0x72, 0x7f, 0x7f, 0x80, 0x80, 0x74, 0x72, 0x82, 0x86, 0x80, 0x80, 0x7e, 0x7e, 0x88, 0x8b,
+		0x81, 0x7e, 0x80, 0x80, 0x83, 0x84, 0x7d, 0x78, 0x7e, 0x80, 0x80, 0x7f, 0x7c, 0x7b, 0x80, 0x84,
+		0x81, 0x80, 0x80, 0x80, 0x83, 0x85, 0x81, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x7d, 0x7e, 0x80,
+		0x80, 0x80, 0x7f, 0x7e, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80, 0x81, 0x82, 0x81, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x8a, 0x89, 0x7c,
+		0x7a, 0x80, 0x80, 0x81, 0x81, 0x7a, 0x77, 0x80, 0x82, 0x80, 0x7f, 0x7d, 0x7c, 0x82, 0x85, 0x81,
+		0x7f, 0x80, 0x80, 0x83, 0x85, 0x81, 0x7e, 0x80, 0x81, 0x81, 0x81, 0x7f, 0x7d, 0x7f, 0x80, 0x80,
+		0x80, 0x7f, 0x7e, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81,
+		0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x85, 0x85, 0x7f,
+		0x7d, 0x80, 0x80, 0x81, 0x81, 0x7d, 0x7c, 0x80, 0x80, 0x80, 0x80, 0x7e, 0x7d, 0x80, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83,
+		0x7f, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x7d, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x81,
+		0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83,
+		0x83, 0x7f, 0x7e, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x7d, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80,
+		0x81, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x83, 0x83, 0x7f, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x7d, 0x80, 0x80, 0x80, 0x80, 0x7f,
+		0x7e, 0x80, 0x81, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x83, 0x83, 0x7f, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x7d, 0x80, 0x80, 0x80,
+		0x80, 0x7f, 0x7e, 0x80, 0x81, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f,
+		0x7d, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	},
+	{ // 8
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0xb3, 0x90, 0x37,
+		0x71, 0xbc, 0x6e, 0x3c, 0x81, 0x9c, 0x81, 0x7e, 0x78, 0x85, 0xb0, 0x8f, 0x55, 0x80, 0xab, 0x76,
+		0x54, 0x79, 0x8a, 0x80, 0x79, 0x71, 0x81, 0xa0, 0x8a, 0x6a, 0x85, 0x9d, 0x80, 0x6c, 0x7b, 0x83,
+		0x80, 0x78, 0x71, 0x7e, 0x90, 0x83, 0x74, 0x84, 0x92, 0x84, 0x79, 0x7f, 0x84, 0x82, 0x7b, 0x75,
+		0x7d, 0x87, 0x7f, 0x77, 0x81, 0x8a, 0x83, 0x7e, 0x80, 0x84, 0x84, 0xc2, 0x8e, 0x22, 0x70, 0xc7,
+		0x63, 0x2e, 0x86, 0xa2, 0x80, 0x7e, 0x7a, 0x8a, 0xb4, 0x8e, 0x53, 0x81, 0xab, 0x72, 0x52, 0x7b,
+		0x8a, 0x7e, 0x78, 0x73, 0x84, 0xa1, 0x89, 0x6b, 0x87, 0x9d, 0x7e, 0x6b, 0x7c, 0x83, 0x7f, 0x77,
+		0x71, 0x7f, 0x90, 0x82, 0x74, 0x86, 0x93, 0x83, 0x79, 0x80, 0x83, 0x81, 0x7a, 0x75, 0x7e, 0x86,
+		0x7f, 0x77, 0x82, 0x8a, 0x83, 0x7e, 0x80, 0x84, 0x83, 0x7e, 0xbd, 0x93, 0x27, 0x6a, 0xc2, 0x6a,
+		0x33, 0x83, 0x9f, 0x82, 0x81, 0x7b, 0x86, 0xb1, 0x91, 0x55, 0x7e, 0xa8, 0x75, 0x55, 0x79, 0x88,
+		0x7f, 0x7b, 0x74, 0x82, 0x9f, 0x8b, 0x6d, 0x85, 0x9b, 0x7f, 0x6d, 0x7b, 0x82, 0x7f, 0x79, 0x72,
+		0x7e, 0x8f, 0x84, 0x75, 0x85, 0x92, 0x83, 0x7a, 0x7f, 0x82, 0x81, 0x7b, 0x75, 0x7d, 0x86, 0x80,
+		0x78, 0x81, 0x8a, 0x83, 0x7f, 0x80, 0x83, 0x83, 0xc2, 0x8e, 0x21, 0x70, 0xc7, 0x64, 0x2e, 0x86,
+		0xa2, 0x80, 0x7f, 0x7a, 0x8a, 0xb4, 0x8e, 0x53, 0x81, 0xab, 0x72, 0x52, 0x7b, 0x8a, 0x7e, 0x78,
+		0x73, 0x84, 0xa1, 0x89, 0x6b, 0x87, 0x9d, 0x7e, 0x6b, 0x7c, 0x83, 0x7f, 0x77, 0x71, 0x7f, 0x90,
+		0x82, 0x74, 0x86, 0x93, 0x83, 0x79, 0x80, 0x83, 0x81, 0x7a, 0x75, 0x7e, 0x87, 0x7f, 0x77, 0x82,
+		0x8a, 0x83, 0x7e, 0x80, 0x84, 0x83, 0x7e, 0x7a, 0xc2, 0x98, 0x21, 0x66, 0xc9, 0x6f, 0x30, 0x80,
+		0xa1, 0x85, 0x81, 0x77, 0x83, 0xb3, 0x93, 0x51, 0x7b, 0xab, 0x78, 0x54, 0x78, 0x89, 0x81, 0x7c,
+		0x72, 0x81, 0xa1, 0x8c, 0x6a, 0x83, 0x9c, 0x80, 0x6c, 0x7a, 0x82, 0x80, 0x7a, 0x71, 0x7e, 0x91,
+		0x84, 0x74, 0x83, 0x92, 0x84, 0x79, 0x7e, 0x82, 0x82, 0x7c, 0x75, 0x7d, 0x87, 0x80, 0x78, 0x80,
+		0x8a, 0x84, 0x7e, 0x80, 0x83, 0x84, 0x7f, 0xbc, 0x93, 0x27, 0x6b, 0xc3, 0x69, 0x33, 0x83, 0xa0,
+		0x81, 0x80, 0x7b, 0x86, 0xb0, 0x90, 0x55, 0x7e, 0xa8, 0x74, 0x55, 0x7a, 0x88, 0x7f, 0x7b, 0x74,
+		0x82, 0x9f, 0x8a, 0x6c, 0x85, 0x9b, 0x7f, 0x6d, 0x7b, 0x82, 0x7f, 0x79, 0x72, 0x7f, 0x8f, 0x83,
+		0x75, 0x85, 0x92, 0x83, 0x7a, 0x7f, 0x83, 0x81, 0x7b, 0x75, 0x7d, 0x86, 0x80, 0x78, 0x81, 0x89,
+		0x83, 0x7f, 0x80, 0x83, 0x83, 0x7f, 0x7a, 0xc1, 0x98, 0x22, 0x66, 0xc9, 0x6f, 0x31, 0x80, 0xa1,
+		0x84, 0x81, 0x77, 0x83, 0xb3, 0x93, 0x52, 0x7b, 0xab, 0x77, 0x54, 0x78, 0x89, 0x80, 0x7c, 0x72,
+		0x81, 0xa0, 0x8c, 0x6a, 0x83, 0x9c, 0x80, 0x6c, 0x7a, 0x82, 0x80, 0x7a, 0x71, 0x7e, 0x91, 0x84,
+		0x74, 0x83, 0x92, 0x84, 0x79, 0x7e, 0x82, 0x82, 0x7c, 0x75, 0x7d, 0x87, 0x80, 0x78, 0x81, 0x8a,
+		0x84, 0x7e, 0x80, 0x83, 0x83, 0x7f, 0x7a, 0xc1, 0x98, 0x22, 0x66, 0xc8, 0x6f, 0x31, 0x80, 0xa1,
+		0x84, 0x81, 0x77, 0x83, 0xb3, 0x93, 0x52, 0x7b, 0xab, 0x78, 0x54, 0x78, 0x89, 0x80, 0x7c, 0x72,
+		0x80, 0xa0, 0x8c, 0x6a, 0x83, 0x9c, 0x80, 0x6c, 0x7a, 0x82, 0x80, 0x7a, 0x71, 0x7e, 0x90, 0x84,
+		0x74, 0x83, 0x92, 0x84, 0x79, 0x7e, 0x82, 0x82, 0x7c, 0x75, 0x7d, 0x87, 0x80, 0x78, 0x80, 0x8a,
+		0x84, 0x7e, 0x80, 0x83, 0x83, 0x7f, 0x7a, 0x7e, 0xc7, 0x93, 0x1d, 0x6b, 0xce, 0x6c, 0x2e, 0x81,
+		0xa4, 0x85, 0x7f, 0x74, 0x85, 0xb6, 0x90, 0x4f, 0x7e, 0xae, 0x77, 0x53, 0x79, 0x8b, 0x81, 0x7a,
+		0x70, 0x82, 0xa2, 0x8a, 0x68, 0x84, 0x9e, 0x80, 0x6b, 0x7a, 0x84, 0x81, 0x79, 0x70, 0x7f, 0x92,
+		0x83, 0x73, 0x84, 0x93, 0x83, 0x78, 0x7e, 0x83, 0x82, 0x7b, 0x74, 0x7d, 0x88, 0x80, 0x77, 0x81,
+		0x8a, 0x83, 0x7e, 0x80, 0x84, 0x84, 0x7f, 0x79, 0x7f, 0xc7, 0x93, 0x1d, 0x6c, 0xce, 0x6c, 0x2d,
+		0x81, 0xa4, 0x85, 0x7e, 0x74, 0x85, 0xb6, 0x8f, 0x4e, 0x7e, 0xae, 0x77, 0x53, 0x79, 0x8b, 0x82,
+		0x7a, 0x70, 0x82, 0xa2, 0x8a, 0x68, 0x84, 0x9e, 0x80, 0x6b, 0x7a, 0x84, 0x81, 0x79, 0x70, 0x7f,
+		0x92, 0x83, 0x73, 0x84, 0x93, 0x84, 0x78, 0x7e, 0x83, 0x83, 0x7b, 0x74, 0x7d, 0x88, 0x80, 0x77,
+		0x81, 0x8a, 0x83, 0x7e, 0x80, 0x84, 0x84, 0x7f, 0x79, 0x7f, 0xc7, 0x93, 0x1c, 0x6c, 0xce, 0x6c,
+		0x2d, 0x81, 0xa4, 0x85, 0x7e, 0x74, 0x85, 0xb6, 0x8f, 0x4e, 0x7e, 0xae, 0x77, 0x53, 0x79, 0x8b,
+		0x82, 0x7a, 0x70, 0x82, 0xa2, 0x8a, 0x68, 0x84, 0x9e, 0x80, 0x6b, 0x7a, 0x84, 0x81, 0x79, 0x70,
+		0x7f, 0x92, 0x83, 0x73, 0x84, 0x93, 0x84, 0x78, 0x7e, 0x83, 0x83, 0x7b, 0x74, 0x7d, 0x88, 0x80,
+		0x77, 0x81, 0x8a, 0x83, 0x7e, 0x80, 0x84, 0x84, 0x7f, 0x79, 0x7f, 0x83, 0xc2, 0x8e, 0x23, 0x71,
+		0xcb, 0x69, 0x2f, 0x85, 0xa5, 0x81, 0x7b, 0x76, 0x88, 0xb2, 0x8c, 0x51, 0x80, 0xad, 0x75, 0x53,
+		0x7b, 0x8c, 0x80, 0x78, 0x72, 0x84, 0xa0, 0x87, 0x69, 0x86, 0x9d, 0x7f, 0x6b, 0x7c, 0x85, 0x80,
+		0x78, 0x71, 0x80, 0x90, 0x82, 0x73, 0x84, 0x92, 0x83, 0x78, 0x7f, 0x84, 0x82, 0x7b, 0x75, 0x7e,
+		0x87, 0x7f, 0x77, 0x81, 0x8a, 0x83, 0x7d, 0x80, 0x84, 0x83, 0x7e, 0x7a, 0x7f, 0x83, 0xc1, 0x8e,
+		0x23, 0x71, 0xcb, 0x69, 0x2f, 0x85, 0xa5, 0x81, 0x7b, 0x77, 0x88, 0xb2, 0x8c, 0x52, 0x80, 0xad,
+		0x75, 0x53, 0x7b, 0x8c, 0x80, 0x78, 0x72, 0x84, 0xa0, 0x87, 0x69, 0x86, 0x9d, 0x7f, 0x6b, 0x7c,
+		0x85, 0x80, 0x78, 0x72, 0x80, 0x90, 0x82, 0x73, 0x85, 0x92, 0x82, 0x78, 0x7f, 0x84, 0x82, 0x7b,
+		0x75, 0x7e, 0x87, 0x7f, 0x77, 0x81, 0x8a, 0x83, 0x7e, 0x80, 0x84, 0x83, 0x7e, 0x7a, 0x7f, 0x83,
+		0x7e, 0xbd, 0x94, 0x28, 0x6e, 0xc8, 0x6a, 0x32, 0x85, 0xa1, 0x7f, 0x7e, 0x79, 0x84, 0xaf, 0x8f,
+		0x55, 0x80, 0xab, 0x76, 0x56, 0x7c, 0x8a, 0x7e, 0x7a, 0x73, 0x81, 0x9d, 0x89, 0x6b, 0x85, 0x9c,
+		0x7f, 0x6d, 0x7d, 0x84, 0x80, 0x79, 0x73, 0x7f, 0x8f, 0x82, 0x74, 0x84, 0x91, 0x83, 0x79, 0x80,
+		0x83, 0x81, 0x7c, 0x76, 0x7e, 0x86, 0x7f, 0x78, 0x81, 0x89, 0x82, 0x7e, 0x80, 0x84, 0x83, 0x7f,
+		0x7a, 0x7f, 0x83, 0x7f, 0xbd, 0x94, 0x28, 0x70, 0xc8, 0x68, 0x33, 0x87, 0xa0, 0x7e, 0x7d, 0x79,
+		0x87, 0xb0, 0x8a, 0x54, 0x86, 0xab, 0x6f, 0x58, 0x80, 0x89, 0x7c, 0x77, 0x72, 0x86, 0x9d, 0x80,
+		0x6c, 0x90, 0x9b, 0x78, 0x70, 0x83, 0x85, 0x7d, 0x75, 0x73, 0x84, 0x8c, 0x77, 0x76, 0x8d, 0x8d,
+		0x7c, 0x7d, 0x86, 0x86, 0x80, 0x79, 0x7a, 0x84, 0x81, 0x75, 0x7b, 0x87, 0x83, 0x7c, 0x80, 0x85,
+		0x85, 0x80, 0x7d, 0x80, 0x85, 0x7f, 0x79, 0x7f, 0xc4, 0x88, 0x26, 0x85, 0xc5, 0x55, 0x3f, 0x93,
+		0x95, 0x79, 0x77, 0x73, 0x99, 0xad, 0x6b, 0x64, 0xab, 0x98, 0x5b, 0x70, 0x8e, 0x80, 0x75, 0x6d,
+		0x79, 0x96, 0x84, 0x66, 0x86, 0xa0, 0x80, 0x72, 0x87, 0x8c, 0x81, 0x77, 0x75, 0x84, 0x87, 0x71,
+		0x73, 0x8b, 0x87, 0x76, 0x7e, 0x89, 0x87, 0x80, 0x7c, 0x82, 0x8a, 0x7f, 0x75, 0x80, 0x85, 0x7b,
+		0x78, 0x80, 0x83, 0x80, 0x7d, 0x80, 0x86, 0x84, 0x7c, 0x80, 0x86, 0x81, 0xb9, 0x80, 0x35, 0x9a,
+		0xb1, 0x41, 0x57, 0x9f, 0x80, 0x6f, 0x79, 0x7b, 0xa0, 0x94, 0x60, 0x8a, 0xb1, 0x76, 0x66, 0x91,
+		0x89, 0x74, 0x72, 0x73, 0x86, 0x86, 0x67, 0x78, 0x9a, 0x81, 0x71, 0x8a, 0x90, 0x82, 0x7e, 0x7e,
+		0x87, 0x87, 0x72, 0x76, 0x8a, 0x7f, 0x71, 0x7e, 0x86, 0x80, 0x7d, 0x7f, 0x86, 0x88, 0x7d, 0x7e,
+		0x8a, 0x83, 0x7a, 0x7f, 0x83, 0x7f, 0x7b, 0x7c, 0x80, 0x81, 0x7c, 0x7d, 0x85, 0x82, 0x7e, 0x80,
+		0xbf, 0x7b, 0x3e, 0xa8, 0xa6, 0x41, 0x6a, 0x9a, 0x78, 0x71, 0x6f, 0x7f, 0xa2, 0x79, 0x62, 0xa4,
+		0x9e, 0x66, 0x80, 0x99, 0x82, 0x79, 0x75, 0x80, 0x8d, 0x6f, 0x69, 0x90, 0x85, 0x6a, 0x7f, 0x8d,
+		0x82, 0x7e, 0x7f, 0x8a, 0x8c, 0x78, 0x7b, 0x8f, 0x81, 0x73, 0x80, 0x84, 0x7d, 0x79, 0x7c, 0x83,
+		0x81, 0x78, 0x80, 0x8a, 0x80, 0x7c, 0x85, 0x86, 0x80, 0x7e, 0x80, 0x84, 0x80, 0x7a, 0x80, 0x82,
+		0x7c, 0x7c, 0x81, 0x80, 0x7e, 0xb4, 0x73, 0x4e, 0xb1, 0x90, 0x46, 0x84, 0x93, 0x6e, 0x76, 0x73,
+		0x86, 0x94, 0x64, 0x79, 0xa8, 0x7b, 0x6c, 0x96, 0x8c, 0x7b, 0x7e, 0x7f, 0x8c, 0x7f, 0x6a, 0x87,
+		0x8b, 0x6c, 0x79, 0x8a, 0x7e, 0x7a, 0x7e, 0x84, 0x88, 0x7a, 0x7d, 0x8f, 0x81, 0x77, 0x86, 0x86,
+		0x7d, 0x7d, 0x80, 0x83, 0x7e, 0x78, 0x81, 0x84, 0x79, 0x7d, 0x85, 0x80, 0x7e, 0x80, 0x83, 0x83,
+		0x7e, 0x80, 0x85, 0x80, 0x7d, 0x82, 0x82, 0x7e, 0x7e, 0x80, 0x80, 0xaf, 0x69, 0x5a, 0xb8, 0x7e,
+		0x4b, 0x94, 0x8a, 0x6b, 0x78, 0x77, 0x8f, 0x81, 0x5f, 0x93, 0x99, 0x65, 0x82, 0x99, 0x7e, 0x7d,
+		0x81, 0x89, 0x89, 0x6f, 0x7f, 0x93, 0x73, 0x72, 0x8b, 0x7f, 0x76, 0x7d, 0x82, 0x85, 0x79, 0x7b,
+		0x8d, 0x80, 0x77, 0x88, 0x87, 0x7d, 0x80, 0x83, 0x84, 0x7e, 0x7b, 0x85, 0x81, 0x78, 0x80, 0x83,
+		0x7c, 0x7d, 0x80, 0x81, 0x7f, 0x7d, 0x83, 0x83, 0x7d, 0x80, 0x84, 0x80, 0x7f, 0x81, 0x82, 0x80,
+		0x7f, 0xad, 0x69, 0x62, 0xb5, 0x74, 0x59, 0x97, 0x80, 0x70, 0x78, 0x78, 0x90, 0x72, 0x69, 0x9d,
+		0x80, 0x68, 0x92, 0x8a, 0x7a, 0x81, 0x83, 0x8e, 0x7d, 0x75, 0x92, 0x82, 0x6f, 0x87, 0x85, 0x77,
+		0x7c, 0x80, 0x82, 0x7a, 0x77, 0x88, 0x80, 0x75, 0x85, 0x85, 0x7c, 0x80, 0x84, 0x84, 0x7f, 0x7f,
+		0x87, 0x81, 0x7b, 0x84, 0x83, 0x7c, 0x7f, 0x81, 0x80, 0x7d, 0x7e, 0x82, 0x7f, 0x7c, 0x82, 0x80,
+		0x7d, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x83, 0xa6, 0x64, 0x74, 0xb2, 0x66, 0x6b, 0x9c, 0x77, 0x74,
+		0x7a, 0x80, 0x89, 0x65, 0x7f, 0x97, 0x68, 0x7a, 0x94, 0x7a, 0x7c, 0x83, 0x88, 0x85, 0x75, 0x8a,
+		0x8d, 0x72, 0x84, 0x8c, 0x79, 0x7e, 0x82, 0x82, 0x7d, 0x79, 0x86, 0x80, 0x74, 0x84, 0x82, 0x79,
+		0x80, 0x82, 0x80, 0x7d, 0x80, 0x86, 0x7e, 0x7d, 0x86, 0x81, 0x7d, 0x82, 0x82, 0x80, 0x7f, 0x82,
+		0x82, 0x7d, 0x80, 0x83, 0x7e, 0x7e, 0x81, 0x80, 0x7e, 0x7f, 0x81, 0x80, 0x7e, 0x81, 0xa2, 0x62,
+		0x7d, 0xaa, 0x62, 0x79, 0x97, 0x74, 0x7b, 0x7b, 0x87, 0x81, 0x66, 0x8f, 0x87, 0x66, 0x8a, 0x87,
+		0x75, 0x80, 0x82, 0x87, 0x79, 0x7d, 0x91, 0x7a, 0x7a, 0x8e, 0x7f, 0x7c, 0x83, 0x83, 0x80, 0x7a,
+		0x84, 0x84, 0x76, 0x82, 0x85, 0x7a, 0x7f, 0x81, 0x80, 0x7d, 0x7e, 0x84, 0x7e, 0x7c, 0x85, 0x80,
+		0x7d, 0x82, 0x81, 0x80, 0x7f, 0x82, 0x82, 0x7e, 0x81, 0x83, 0x7e, 0x80, 0x82, 0x80, 0x7f, 0x80,
+		0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7e, 0xa0, 0x65, 0x7d, 0xa5, 0x63, 0x7d, 0x93, 0x73, 0x7f, 0x7a,
+		0x85, 0x81, 0x69, 0x8f, 0x83, 0x69, 0x8b, 0x83, 0x77, 0x81, 0x80, 0x87, 0x7a, 0x7e, 0x8f, 0x79,
+		0x7d, 0x8d, 0x7e, 0x7e, 0x83, 0x82, 0x80, 0x7b, 0x84, 0x83, 0x77, 0x82, 0x83, 0x7a, 0x80, 0x81,
+		0x80, 0x7d, 0x7f, 0x83, 0x7e, 0x7d, 0x84, 0x80, 0x7e, 0x82, 0x81, 0x80, 0x80, 0x82, 0x82, 0x7e,
+		0x81, 0x82, 0x7e, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7f, 0xa0, 0x65,
+		0x7d, 0xa5, 0x62, 0x7d, 0x93, 0x73, 0x7e, 0x7a, 0x86, 0x81, 0x69, 0x8f, 0x83, 0x69, 0x8b, 0x83,
+		0x77, 0x81, 0x81, 0x87, 0x7a, 0x7e, 0x8f, 0x79, 0x7d, 0x8d, 0x7e, 0x7e, 0x83, 0x82, 0x80, 0x7b,
+		0x84, 0x83, 0x77, 0x82, 0x83, 0x7a, 0x80, 0x81, 0x80, 0x7d, 0x7f, 0x83, 0x7e, 0x7d, 0x84, 0x80,
+		0x7e, 0x82, 0x81, 0x80, 0x80, 0x82, 0x82, 0x7e, 0x81, 0x82, 0x7e, 0x80, 0x81, 0x80, 0x7f, 0x80,
+		0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7f, 0xa0, 0x65, 0x7d, 0xa5, 0x62, 0x7d, 0x93, 0x73, 0x7e, 0x7a,
+		0x86, 0x81, 0x69, 0x8f, 0x83, 0x69, 0x8b, 0x83, 0x77, 0x81, 0x81, 0x87, 0x7a, 0x7e, 0x8f, 0x79,
+		0x7d, 0x8d, 0x7e, 0x7e, 0x83, 0x82, 0x80, 0x7b, 0x84, 0x83, 0x77, 0x82, 0x83, 0x7a, 0x80, 0x81,
+		0x80, 0x7d, 0x7f, 0x83, 0x7e, 0x7d, 0x84, 0x80, 0x7e, 0x82, 0x81, 0x80, 0x80, 0x82, 0x82, 0x7e,
+		0x81, 0x82, 0x7e, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7f, 0x80, 0x80,
+		0x9e, 0x64, 0x7f, 0xa6, 0x61, 0x7d, 0x96, 0x72, 0x7d, 0x7c, 0x86, 0x80, 0x69, 0x90, 0x83, 0x68,
+		0x8c, 0x84, 0x76, 0x81, 0x82, 0x86, 0x7a, 0x7f, 0x8f, 0x79, 0x7d, 0x8d, 0x7d, 0x7d, 0x84, 0x82,
+		0x80, 0x7b, 0x85, 0x83, 0x77, 0x83, 0x83, 0x7a, 0x80, 0x81, 0x80, 0x7d, 0x7f, 0x83, 0x7d, 0x7d,
+		0x85, 0x7f, 0x7e, 0x82, 0x81, 0x80, 0x80, 0x82, 0x81, 0x7e, 0x81, 0x82, 0x7e, 0x80, 0x82, 0x80,
+		0x7f, 0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0xa0, 0x63, 0x7e, 0xa7, 0x62, 0x7b, 0x95,
+		0x74, 0x7c, 0x7b, 0x87, 0x80, 0x69, 0x90, 0x84, 0x68, 0x8b, 0x84, 0x76, 0x80, 0x82, 0x87, 0x79,
+		0x7e, 0x90, 0x79, 0x7c, 0x8d, 0x7e, 0x7d, 0x83, 0x83, 0x80, 0x7b, 0x85, 0x83, 0x77, 0x82, 0x83,
+		0x7a, 0x7f, 0x81, 0x80, 0x7d, 0x7f, 0x84, 0x7d, 0x7d, 0x85, 0x80, 0x7d, 0x82, 0x81, 0x80, 0x80,
+		0x82, 0x81, 0x7e, 0x81, 0x83, 0x7e, 0x80, 0x82, 0x80, 0x7f, 0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80,
+		0x7e, 0x80, 0x80, 0x7f, 0x9f, 0x65, 0x7f, 0xa5, 0x62, 0x7e, 0x94, 0x73, 0x7e, 0x7b, 0x85, 0x80,
+		0x6a, 0x8f, 0x83, 0x69, 0x8b, 0x83, 0x77, 0x81, 0x81, 0x86, 0x7a, 0x7e, 0x8f, 0x79, 0x7d, 0x8d,
+		0x7e, 0x7e, 0x83, 0x82, 0x80, 0x7b, 0x84, 0x83, 0x77, 0x83, 0x83, 0x7a, 0x80, 0x81, 0x80, 0x7d,
+		0x7f, 0x83, 0x7d, 0x7d, 0x84, 0x80, 0x7e, 0x82, 0x81, 0x80, 0x80, 0x82, 0x81, 0x7e, 0x81, 0x82,
+		0x7e, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x7f, 0x9f,
+		0x65, 0x7f, 0xa5, 0x62, 0x7e, 0x94, 0x73, 0x7e, 0x7b, 0x85, 0x80, 0x69, 0x8f, 0x83, 0x69, 0x8b,
+		0x83, 0x77, 0x81, 0x81, 0x86, 0x7a, 0x7e, 0x8f, 0x79, 0x7d, 0x8d, 0x7e, 0x7e, 0x83, 0x82, 0x80,
+		0x7b, 0x84, 0x83, 0x77, 0x83, 0x83, 0x7a, 0x80, 0x81, 0x80, 0x7d, 0x7f, 0x83, 0x7d, 0x7d, 0x84,
+		0x80, 0x7e, 0x82, 0x81, 0x80, 0x80, 0x82, 0x81, 0x7e, 0x81, 0x82, 0x7e, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x7f, 0x9f, 0x65, 0x7f, 0xa5, 0x62, 0x7e,
+		0x94, 0x73, 0x7e, 0x7b, 0x85, 0x80, 0x69, 0x8f, 0x83, 0x69, 0x8b, 0x83, 0x77, 0x81, 0x81, 0x86,
+		0x7a, 0x7e, 0x8f, 0x79, 0x7d, 0x8d, 0x7e, 0x7e, 0x83, 0x82, 0x80, 0x7b, 0x84, 0x83, 0x77, 0x83,
+		0x83, 0x7a, 0x80, 0x81, 0x80, 0x7d, 0x7f, 0x83, 0x7d, 0x7d, 0x84, 0x80, 0x7e, 0x82, 0x81, 0x80,
+		0x80, 0x82, 0x81, 0x7e, 0x81, 0x82, 0x7e, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x7f, 0x81,
+		0x80, 0x7f, 0x80, 0x80, 0x7f, 0x80, 0xa0, 0x64, 0x7d, 0xa6, 0x63, 0x7c, 0x94, 0x74, 0x7d, 0x7b,
+		0x86, 0x80, 0x69, 0x8f, 0x84, 0x69, 0x8b, 0x84, 0x77, 0x80, 0x81, 0x86, 0x7a, 0x7e, 0x8f, 0x79,
+		0x7c, 0x8d, 0x7e, 0x7e, 0x83, 0x83, 0x80, 0x7b, 0x84, 0x83, 0x77, 0x82, 0x83, 0x7a, 0x80, 0x81,
+		0x80, 0x7d, 0x7f, 0x84, 0x7e, 0x7d, 0x84, 0x80, 0x7e, 0x82, 0x81, 0x80, 0x80, 0x82, 0x82, 0x7e,
+		0x81, 0x82, 0x7e, 0x80, 0x81, 0x80, 0x7f, 0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0x80,
+		0x7f, 0x80, 0x80, 0x9f, 0x63, 0x7f, 0xa7, 0x61, 0x7c, 0x96, 0x73, 0x7d, 0x7b, 0x86, 0x80, 0x69,
+		0x90, 0x83, 0x68, 0x8c, 0x84, 0x76, 0x81, 0x81, 0x86, 0x79, 0x7f, 0x90, 0x79, 0x7c, 0x8e, 0x7e,
+		0x7d, 0x84, 0x83, 0x80, 0x7b, 0x85, 0x83, 0x77, 0x83, 0x83, 0x7a, 0x80, 0x81, 0x80, 0x7d, 0x7f,
+		0x84, 0x7d, 0x7d, 0x85, 0x80, 0x7d, 0x82, 0x81, 0x80, 0x80, 0x82, 0x81, 0x7e, 0x81, 0x83, 0x7e,
+		0x80, 0x82, 0x80, 0x7f, 0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0x80, 0x7f, 0x80, 0x80,
+		0x80, 0x9e, 0x65, 0x80, 0xa5, 0x62, 0x7e, 0x94, 0x73, 0x7e, 0x7b, 0x85, 0x80, 0x6a, 0x8f, 0x82,
+		0x69, 0x8c, 0x83, 0x76, 0x81, 0x81, 0x86, 0x7a, 0x7f, 0x8f, 0x79, 0x7d, 0x8d, 0x7e, 0x7e, 0x84,
+		0x82, 0x80, 0x7b, 0x85, 0x83, 0x77, 0x83, 0x83, 0x7a, 0x80, 0x81, 0x80, 0x7d, 0x7f, 0x83, 0x7d,
+		0x7d, 0x84, 0x7f, 0x7e, 0x82, 0x81, 0x80, 0x80, 0x82, 0x81, 0x7e, 0x81, 0x82, 0x7e, 0x80, 0x81,
+		0x80, 0x7f, 0x80, 0x81, 0x7f, 0x7f, 0x81, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x9a,
+		0x6d, 0x79, 0xa1, 0x6f, 0x75, 0x90, 0x7b, 0x7d, 0x79, 0x7f, 0x8b, 0x6f, 0x7a, 0x90, 0x76, 0x78,
+		0x87, 0x80, 0x80, 0x7c, 0x80, 0x8a, 0x7b, 0x7c, 0x8b, 0x80, 0x7d, 0x83, 0x82, 0x83, 0x7f, 0x7d,
+		0x86, 0x80, 0x7a, 0x81, 0x81, 0x7e, 0x7f, 0x7f, 0x81, 0x80, 0x7c, 0x80, 0x83, 0x7e, 0x7f, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x83, 0x80, 0x7f, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f,
+		0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x88, 0x81,
+		0x79, 0x82, 0x86, 0x7e, 0x7e, 0x7f, 0x80, 0x82, 0x7f, 0x7a, 0x80, 0x83, 0x7e, 0x7e, 0x80, 0x80,
+		0x82, 0x80, 0x7d, 0x80, 0x83, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x80, 0x81, 0x80, 0x7f,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81,
+		0x80, 0x80, 0x80, 0x81, 0x7d, 0x83, 0x7e, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x82, 0x7a, 0x8a,
+		0x76, 0x87, 0x7d, 0x81, 0x7f, 0x82, 0x7e, 0x83, 0x7c, 0x83, 0x7f, 0x81, 0x7f, 0x80, 0x80, 0x7f,
+		0x80, 0x7e, 0x84, 0x79, 0x89, 0x74, 0x8c, 0x7b, 0x80, 0x85, 0x79, 0x88, 0x7b, 0x81, 0x7f, 0x85,
+		0x7a, 0x80, 0x82, 0x7d, 0x81, 0x86, 0x78, 0x84, 0x80, 0x7e, 0x80, 0x82, 0x7a, 0x88, 0x78, 0x85,
+		0x7f, 0x81, 0x7e, 0x7e, 0x8c, 0x6e, 0x95, 0x6b, 0x91, 0x70, 0x91, 0x6c, 0x97, 0x66, 0x9f, 0x5c,
+		0xa3, 0x68, 0x90, 0x72, 0x87, 0x80, 0x79, 0x8a, 0x7a, 0x7d, 0x8c, 0x6d, 0x94, 0x74, 0x84, 0x83,
+		0x76, 0x8c, 0x75, 0x8b, 0x75, 0x8e, 0x6c, 0x96, 0x6a, 0x96, 0x6d, 0x88, 0x7d, 0x81, 0x83, 0x7b,
+		0x84, 0x80, 0x78, 0x89, 0x7f, 0x77, 0x93, 0x69, 0x94, 0x72, 0x83, 0x83, 0x7e, 0x84, 0x7d, 0x81,
+		0x80, 0x7b, 0x8b, 0x71, 0x8b, 0x78, 0x87, 0x7c, 0x81, 0x82, 0x76, 0x93, 0x67, 0x9a, 0x6c, 0x8a,
+		0x80, 0x80, 0x7e, 0x87, 0x78, 0x81, 0x85, 0x76, 0x89, 0x7d, 0x80, 0x80, 0x80, 0x82, 0x7c, 0x86,
+		0x7a, 0x83, 0x81, 0x7a, 0x85, 0x7f, 0x7e, 0x87, 0x75, 0x8d, 0x77, 0x84, 0x7e, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x81, 0x7e, 0x84, 0x7a, 0x86, 0x78, 0x8a, 0x77, 0x86, 0x7d, 0x80, 0x80, 0x81, 0x7d,
+		0x84, 0x7e, 0x80, 0x7f, 0x82, 0x7d, 0x82, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x81, 0x7e, 0x82,
+		0x7f, 0x81, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80,
+	},
+	{ // 9
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x82, 0x82, 0x80,
+		0x7f, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80,
+		0x80, 0x81, 0x81, 0x7f, 0x7d, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x81,
+		0x81, 0x7f, 0x7d, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80,
+		0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x81,
+		0x7f, 0x7d, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f,
+		0x7d, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x7d,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x7d,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x7d, 0x80,
+		0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x7e,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x7d,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x7f,
+		0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81,
+		0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x84, 0x84, 0x7f, 0x7e, 0x80, 0x80,
+		0x80, 0x81, 0x7e, 0x7c, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80,
+		0x80, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x88, 0x89, 0x7f, 0x7b, 0x7d,
+		0x7e, 0x80, 0x83, 0x7e, 0x78, 0x7c, 0x7f, 0x80, 0x83, 0x81, 0x7d, 0x7f, 0x82, 0x83, 0x84, 0x83,
+		0x80, 0x7e, 0x80, 0x82, 0x81, 0x80, 0x7e, 0x7c, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x81,
+		0x82, 0x81, 0x80, 0x80, 0x80, 0x81, 0x81, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f,
+		0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x8d, 0x90,
+		0x7e, 0x75, 0x77, 0x77, 0x7e, 0x86, 0x80, 0x76, 0x7a, 0x7e, 0x82, 0x8a, 0x8a, 0x80, 0x7f, 0x80,
+		0x81, 0x84, 0x85, 0x7f, 0x79, 0x7a, 0x7d, 0x7f, 0x82, 0x80, 0x7d, 0x7e, 0x80, 0x83, 0x85, 0x85,
+		0x81, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7c, 0x7d, 0x7f, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80,
+		0x81, 0x82, 0x81, 0x80, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x7f, 0x80, 0x81, 0x81, 0x81,
+		0x95, 0x98, 0x7e, 0x6e, 0x6d, 0x6b, 0x77, 0x89, 0x85, 0x7a, 0x7c, 0x7f, 0x83, 0x91, 0x94, 0x86,
+		0x7d, 0x7a, 0x76, 0x7b, 0x82, 0x80, 0x78, 0x79, 0x7b, 0x7f, 0x88, 0x8c, 0x87, 0x82, 0x80, 0x7e,
+		0x7f, 0x82, 0x80, 0x7b, 0x7a, 0x7a, 0x7c, 0x82, 0x86, 0x85, 0x83, 0x81, 0x80, 0x80, 0x82, 0x81,
+		0x7f, 0x7d, 0x7b, 0x7c, 0x80, 0x82, 0x82, 0x82, 0x81, 0x80, 0x80, 0x81, 0x81, 0x80, 0x7f, 0x7d,
+		0x7d, 0x7f, 0x80, 0x9e, 0xa4, 0x7e, 0x63, 0x5f, 0x5b, 0x71, 0x94, 0x98, 0x89, 0x84, 0x7f, 0x7c,
+		0x8c, 0x96, 0x87, 0x78, 0x6e, 0x65, 0x6d, 0x83, 0x8d, 0x8c, 0x8a, 0x82, 0x7c, 0x81, 0x88, 0x85,
+		0x80, 0x7a, 0x71, 0x71, 0x7c, 0x85, 0x89, 0x8b, 0x86, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x7f, 0x7a,
+		0x78, 0x7c, 0x80, 0x85, 0x89, 0x87, 0x81, 0x7e, 0x7d, 0x7d, 0x7e, 0x80, 0x7f, 0x7e, 0x7f, 0x80,
+		0x82, 0x85, 0x85, 0x82, 0x80, 0x7d, 0x7c, 0xa2, 0xae, 0x7c, 0x55, 0x4b, 0x45, 0x67, 0xa5, 0xbb,
+		0xab, 0x9a, 0x7d, 0x5f, 0x69, 0x7e, 0x7f, 0x7d, 0x7c, 0x6f, 0x70, 0x89, 0x9b, 0x9e, 0x9a, 0x85,
+		0x68, 0x5e, 0x68, 0x75, 0x85, 0x94, 0x90, 0x86, 0x82, 0x80, 0x80, 0x82, 0x81, 0x79, 0x72, 0x74,
+		0x78, 0x83, 0x90, 0x93, 0x8c, 0x82, 0x78, 0x71, 0x74, 0x7b, 0x80, 0x84, 0x86, 0x84, 0x82, 0x82,
+		0x82, 0x80, 0x7f, 0x7c, 0x79, 0x79, 0x7e, 0x82, 0x87, 0x89, 0xb5, 0xbb, 0x75, 0x3e, 0x2f, 0x2c,
+		0x60, 0xba, 0xe2, 0xd0, 0xae, 0x74, 0x3b, 0x3d, 0x61, 0x7a, 0x91, 0x9e, 0x8c, 0x7d, 0x86, 0x8f,
+		0x8f, 0x90, 0x80, 0x60, 0x54, 0x61, 0x77, 0x95, 0xae, 0xaa, 0x90, 0x79, 0x66, 0x5f, 0x6c, 0x7f,
+		0x87, 0x8a, 0x89, 0x83, 0x81, 0x87, 0x88, 0x83, 0x7d, 0x73, 0x6c, 0x71, 0x7f, 0x8b, 0x93, 0x94,
+		0x89, 0x7c, 0x74, 0x72, 0x76, 0x7e, 0x84, 0x85, 0x84, 0x83, 0x82, 0x82, 0x83, 0x81, 0xab, 0xb3,
+		0x71, 0x3e, 0x35, 0x36, 0x69, 0xc1, 0xe4, 0xcb, 0xa6, 0x6b, 0x35, 0x3a, 0x63, 0x80, 0x97, 0xa3,
+		0x8f, 0x7d, 0x83, 0x8b, 0x8b, 0x8c, 0x7e, 0x60, 0x57, 0x66, 0x7c, 0x98, 0xaf, 0xa8, 0x8c, 0x75,
+		0x63, 0x5e, 0x6e, 0x81, 0x8a, 0x8d, 0x8b, 0x83, 0x80, 0x85, 0x86, 0x81, 0x7c, 0x74, 0x6e, 0x73,
+		0x80, 0x8c, 0x93, 0x92, 0x87, 0x7a, 0x72, 0x72, 0x76, 0x7f, 0x85, 0x86, 0x85, 0x83, 0x81, 0x81,
+		0x82, 0x80, 0x7d, 0xa8, 0xb2, 0x74, 0x45, 0x3c, 0x39, 0x68, 0xbc, 0xdc, 0xc6, 0xa5, 0x6e, 0x39,
+		0x3f, 0x66, 0x80, 0x96, 0xa3, 0x8e, 0x7c, 0x82, 0x89, 0x89, 0x8c, 0x80, 0x63, 0x5a, 0x68, 0x7b,
+		0x95, 0xab, 0xa5, 0x8c, 0x76, 0x65, 0x60, 0x6f, 0x81, 0x8a, 0x8c, 0x8a, 0x82, 0x80, 0x84, 0x85,
+		0x81, 0x7d, 0x75, 0x6f, 0x74, 0x80, 0x8b, 0x92, 0x91, 0x87, 0x7a, 0x73, 0x72, 0x77, 0x80, 0x85,
+		0x86, 0x84, 0x83, 0x81, 0x81, 0x82, 0x80, 0x7d, 0xa8, 0xb3, 0x74, 0x45, 0x3b, 0x38, 0x67, 0xbb,
+		0xdc, 0xc7, 0xa5, 0x6e, 0x39, 0x3e, 0x65, 0x80, 0x96, 0xa2, 0x8e, 0x7c, 0x82, 0x89, 0x89, 0x8c,
+		0x80, 0x63, 0x5a, 0x67, 0x7b, 0x95, 0xac, 0xa5, 0x8c, 0x76, 0x65, 0x60, 0x6f, 0x81, 0x8a, 0x8c,
+		0x8a, 0x82, 0x80, 0x84, 0x86, 0x81, 0x7d, 0x75, 0x6f, 0x74, 0x80, 0x8b, 0x92, 0x91, 0x87, 0x7a,
+		0x73, 0x72, 0x77, 0x80, 0x85, 0x86, 0x84, 0x83, 0x81, 0x81, 0x82, 0x80, 0x7d, 0xa8, 0xb3, 0x74,
+		0x45, 0x3b, 0x38, 0x67, 0xbb, 0xdd, 0xc7, 0xa5, 0x6e, 0x39, 0x3e, 0x65, 0x7f, 0x96, 0xa2, 0x8e,
+		0x7c, 0x82, 0x89, 0x89, 0x8c, 0x80, 0x63, 0x5a, 0x67, 0x7b, 0x95, 0xac, 0xa5, 0x8c, 0x76, 0x65,
+		0x60, 0x6f, 0x81, 0x8a, 0x8c, 0x8a, 0x82, 0x80, 0x84, 0x86, 0x81, 0x7d, 0x75, 0x6f, 0x74, 0x80,
+		0x8b, 0x92, 0x91, 0x87, 0x7a, 0x73, 0x72, 0x77, 0x80, 0x85, 0x86, 0x84, 0x83, 0x81, 0x81, 0x82,
+		0x80, 0x7d, 0x7a, 0xa7, 0xb5, 0x79, 0x4b, 0x3e, 0x37, 0x63, 0xb6, 0xd8, 0xc6, 0xa8, 0x72, 0x3c,
+		0x40, 0x65, 0x7e, 0x95, 0xa2, 0x8e, 0x7b, 0x81, 0x88, 0x89, 0x8d, 0x81, 0x66, 0x5b, 0x67, 0x79,
+		0x93, 0xaa, 0xa5, 0x8d, 0x78, 0x66, 0x60, 0x6f, 0x81, 0x89, 0x8c, 0x8a, 0x82, 0x80, 0x84, 0x86,
+		0x82, 0x7e, 0x76, 0x70, 0x74, 0x80, 0x8a, 0x91, 0x91, 0x87, 0x7b, 0x74, 0x73, 0x77, 0x7f, 0x85,
+		0x86, 0x84, 0x82, 0x81, 0x81, 0x82, 0x80, 0x7e, 0x7b, 0xa7, 0xb4, 0x79, 0x4a, 0x3e, 0x37, 0x63,
+		0xb6, 0xd9, 0xc7, 0xa8, 0x72, 0x3c, 0x40, 0x65, 0x7e, 0x95, 0xa2, 0x8e, 0x7c, 0x81, 0x88, 0x89,
+		0x8d, 0x81, 0x66, 0x5b, 0x67, 0x79, 0x93, 0xaa, 0xa5, 0x8d, 0x78, 0x66, 0x60, 0x6e, 0x81, 0x89,
+		0x8c, 0x8a, 0x82, 0x80, 0x84, 0x86, 0x82, 0x7e, 0x76, 0x6f, 0x74, 0x80, 0x8a, 0x91, 0x91, 0x87,
+		0x7b, 0x74, 0x73, 0x77, 0x7f, 0x85, 0x86, 0x84, 0x82, 0x81, 0x81, 0x82, 0x80, 0x7e, 0x7b, 0x7a,
+		0xa9, 0xba, 0x7f, 0x4d, 0x3d, 0x33, 0x5e, 0xb2, 0xd8, 0xc9, 0xab, 0x75, 0x3d, 0x3f, 0x64, 0x7e,
+		0x95, 0xa2, 0x8d, 0x7a, 0x80, 0x88, 0x8a, 0x8f, 0x83, 0x67, 0x5b, 0x65, 0x76, 0x91, 0xa9, 0xa6,
+		0x8f, 0x79, 0x67, 0x60, 0x6e, 0x80, 0x89, 0x8c, 0x8a, 0x82, 0x7f, 0x84, 0x86, 0x83, 0x7f, 0x77,
+		0x6f, 0x73, 0x7f, 0x89, 0x91, 0x92, 0x88, 0x7c, 0x74, 0x73, 0x77, 0x7f, 0x85, 0x85, 0x84, 0x82,
+		0x80, 0x81, 0x82, 0x81, 0x7e, 0x7b, 0x79, 0xa8, 0xb9, 0x7e, 0x4d, 0x3d, 0x34, 0x5e, 0xb2, 0xd8,
+		0xc9, 0xab, 0x75, 0x3d, 0x3f, 0x63, 0x7e, 0x95, 0xa2, 0x8e, 0x7a, 0x80, 0x88, 0x8a, 0x8f, 0x83,
+		0x67, 0x5b, 0x65, 0x77, 0x91, 0xa9, 0xa6, 0x8f, 0x79, 0x67, 0x60, 0x6e, 0x80, 0x89, 0x8c, 0x8a,
+		0x82, 0x7f, 0x83, 0x86, 0x83, 0x7f, 0x77, 0x6f, 0x73, 0x7f, 0x89, 0x91, 0x92, 0x88, 0x7c, 0x74,
+		0x73, 0x77, 0x7f, 0x85, 0x86, 0x84, 0x82, 0x80, 0x81, 0x82, 0x81, 0x7f, 0x7b, 0x79, 0xa9, 0xb9,
+		0x7b, 0x49, 0x3d, 0x3a, 0x69, 0xba, 0xd4, 0xb6, 0x94, 0x6a, 0x49, 0x5f, 0x84, 0x88, 0x82, 0x7c,
+		0x6b, 0x72, 0x96, 0xab, 0xa1, 0x8d, 0x6d, 0x52, 0x5c, 0x7a, 0x8c, 0x94, 0x90, 0x80, 0x78, 0x83,
+		0x8e, 0x8c, 0x83, 0x73, 0x64, 0x6b, 0x7f, 0x8d, 0x93, 0x8e, 0x80, 0x79, 0x7e, 0x84, 0x84, 0x80,
+		0x77, 0x71, 0x77, 0x84, 0x8c, 0x8c, 0x86, 0x7e, 0x7a, 0x7f, 0x82, 0x81, 0x7d, 0x79, 0x78, 0x7f,
+		0x87, 0x89, 0x85, 0x80, 0x7d, 0x7d, 0xb5, 0xb6, 0x5f, 0x35, 0x49, 0x60, 0x8f, 0xb9, 0x97, 0x6d,
+		0x7d, 0x94, 0x9a, 0x9f, 0x80, 0x4d, 0x4f, 0x76, 0x89, 0x90, 0x8c, 0x77, 0x76, 0x95, 0xa2, 0x90,
+		0x7c, 0x68, 0x63, 0x79, 0x8b, 0x82, 0x76, 0x78, 0x80, 0x90, 0x9a, 0x8c, 0x77, 0x74, 0x7b, 0x80,
+		0x82, 0x7b, 0x70, 0x77, 0x86, 0x8e, 0x8a, 0x83, 0x7d, 0x7f, 0x86, 0x85, 0x7c, 0x75, 0x77, 0x7e,
+		0x84, 0x85, 0x7f, 0x7e, 0x82, 0x87, 0x86, 0x81, 0x7b, 0x7a, 0x7f, 0x81, 0x7f, 0x7c, 0xb1, 0xaa,
+		0x54, 0x4a, 0x7b, 0x81, 0x84, 0x85, 0x62, 0x6a, 0xa5, 0xab, 0x83, 0x7a, 0x7a, 0x7e, 0x92, 0x88,
+		0x60, 0x62, 0x82, 0x8a, 0x84, 0x80, 0x76, 0x80, 0x9a, 0x93, 0x79, 0x76, 0x7f, 0x82, 0x83, 0x79,
+		0x6c, 0x78, 0x8a, 0x87, 0x7e, 0x7e, 0x81, 0x89, 0x8c, 0x80, 0x77, 0x7e, 0x84, 0x80, 0x7a, 0x78,
+		0x7b, 0x83, 0x86, 0x7f, 0x7c, 0x83, 0x87, 0x84, 0x80, 0x7d, 0x80, 0x83, 0x80, 0x7a, 0x7b, 0x80,
+		0x82, 0x80, 0x7e, 0x7f, 0x83, 0x85, 0x81, 0xb2, 0x9b, 0x47, 0x61, 0x9e, 0x80, 0x60, 0x6c, 0x70,
+		0x89, 0xa0, 0x79, 0x67, 0x99, 0xa6, 0x80, 0x77, 0x7f, 0x81, 0x8b, 0x7c, 0x60, 0x74, 0x90, 0x80,
+		0x6e, 0x7c, 0x88, 0x8d, 0x89, 0x7b, 0x7e, 0x91, 0x8b, 0x75, 0x77, 0x83, 0x82, 0x7b, 0x76, 0x78,
+		0x84, 0x86, 0x7a, 0x7a, 0x88, 0x8a, 0x80, 0x7f, 0x82, 0x85, 0x84, 0x7c, 0x7a, 0x81, 0x82, 0x7b,
+		0x79, 0x80, 0x82, 0x80, 0x7e, 0x80, 0x84, 0x84, 0x80, 0x7f, 0x84, 0x83, 0x7f, 0x7d, 0x80, 0x81,
+		0x7f, 0xaa, 0x89, 0x48, 0x82, 0xae, 0x66, 0x53, 0x8a, 0x8b, 0x77, 0x78, 0x74, 0x8a, 0x9d, 0x76,
+		0x6c, 0x9c, 0x99, 0x6d, 0x78, 0x90, 0x85, 0x79, 0x75, 0x7a, 0x88, 0x80, 0x6b, 0x7d, 0x91, 0x7d,
+		0x71, 0x86, 0x8d, 0x80, 0x7d, 0x82, 0x88, 0x86, 0x7a, 0x7b, 0x89, 0x83, 0x74, 0x7c, 0x87, 0x7f,
+		0x78, 0x7f, 0x83, 0x81, 0x7d, 0x7f, 0x85, 0x84, 0x7d, 0x7f, 0x86, 0x83, 0x7c, 0x80, 0x83, 0x80,
+		0x7d, 0x7e, 0x81, 0x80, 0x7d, 0x7e, 0x81, 0x81, 0x7e, 0x7f, 0xad, 0x84, 0x4b, 0x94, 0xaf, 0x56,
+		0x5c, 0xa0, 0x84, 0x61, 0x79, 0x84, 0x85, 0x82, 0x6f, 0x81, 0xa0, 0x7e, 0x69, 0x94, 0x98, 0x71,
+		0x77, 0x8e, 0x83, 0x79, 0x78, 0x7d, 0x86, 0x7e, 0x6f, 0x80, 0x8e, 0x7a, 0x74, 0x89, 0x89, 0x7c,
+		0x7e, 0x86, 0x86, 0x81, 0x7c, 0x80, 0x87, 0x7f, 0x77, 0x80, 0x85, 0x7b, 0x79, 0x81, 0x82, 0x7e,
+		0x7e, 0x80, 0x84, 0x81, 0x7e, 0x81, 0x85, 0x80, 0x7d, 0x81, 0x83, 0x7f, 0x7e, 0x80, 0x80, 0x7f,
+		0x7e, 0x7f, 0x81, 0x80, 0x7e, 0xa9, 0x85, 0x4d, 0x92, 0xad, 0x57, 0x5d, 0xa0, 0x83, 0x61, 0x7b,
+		0x85, 0x84, 0x82, 0x71, 0x81, 0x9f, 0x7e, 0x6a, 0x93, 0x97, 0x71, 0x78, 0x8e, 0x83, 0x78, 0x79,
+		0x7d, 0x85, 0x7f, 0x70, 0x80, 0x8d, 0x7a, 0x75, 0x89, 0x89, 0x7c, 0x7f, 0x86, 0x85, 0x81, 0x7c,
+		0x80, 0x86, 0x7f, 0x77, 0x80, 0x85, 0x7b, 0x7a, 0x82, 0x82, 0x7e, 0x7e, 0x80, 0x83, 0x81, 0x7e,
+		0x81, 0x85, 0x80, 0x7d, 0x81, 0x83, 0x7f, 0x7e, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x81, 0x80, 0x7e,
+		0xaa, 0x85, 0x4d, 0x92, 0xad, 0x57, 0x5d, 0xa0, 0x83, 0x61, 0x7b, 0x85, 0x84, 0x82, 0x71, 0x81,
+		0x9f, 0x7e, 0x6a, 0x93, 0x97, 0x70, 0x78, 0x8e, 0x83, 0x78, 0x79, 0x7d, 0x85, 0x7f, 0x70, 0x80,
+		0x8d, 0x7a, 0x75, 0x89, 0x89, 0x7c, 0x7f, 0x86, 0x85, 0x81, 0x7c, 0x80, 0x87, 0x7f, 0x77, 0x80,
+		0x85, 0x7b, 0x7a, 0x82, 0x82, 0x7e, 0x7e, 0x80, 0x83, 0x81, 0x7e, 0x81, 0x85, 0x80, 0x7d, 0x81,
+		0x83, 0x7f, 0x7e, 0x80, 0x80, 0x7f, 0x7e, 0x7f, 0x81, 0x80, 0x7e, 0xaa, 0x85, 0x4d, 0x92, 0xad,
+		0x57, 0x5d, 0xa0, 0x83, 0x61, 0x7b, 0x85, 0x84, 0x82, 0x71, 0x81, 0x9f, 0x7e, 0x6a, 0x93, 0x97,
+		0x70, 0x78, 0x8e, 0x83, 0x78, 0x79, 0x7d, 0x85, 0x7f, 0x70, 0x80, 0x8d, 0x7a, 0x75, 0x89, 0x89,
+		0x7c, 0x7f, 0x86, 0x85, 0x81, 0x7c, 0x80, 0x87, 0x7f, 0x77, 0x80, 0x85, 0x7b, 0x7a, 0x82, 0x82,
+		0x7e, 0x7e, 0x80, 0x83, 0x81, 0x7e, 0x81, 0x85, 0x80, 0x7d, 0x81, 0x83, 0x7f, 0x7e, 0x80, 0x80,
+		0x7f, 0x7e, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0xac, 0x83, 0x4b, 0x95, 0xae, 0x55, 0x5c, 0xa1, 0x84,
+		0x60, 0x79, 0x86, 0x85, 0x81, 0x6f, 0x82, 0xa0, 0x7d, 0x69, 0x95, 0x98, 0x70, 0x77, 0x8e, 0x83,
+		0x78, 0x78, 0x7e, 0x86, 0x7e, 0x6f, 0x80, 0x8e, 0x79, 0x74, 0x8a, 0x8a, 0x7b, 0x7e, 0x86, 0x86,
+		0x80, 0x7c, 0x80, 0x87, 0x7f, 0x77, 0x80, 0x85, 0x7b, 0x79, 0x82, 0x82, 0x7e, 0x7e, 0x81, 0x84,
+		0x81, 0x7e, 0x81, 0x85, 0x80, 0x7d, 0x81, 0x83, 0x7f, 0x7d, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x81,
+		0x80, 0x7e, 0x80, 0x82, 0xa9, 0x81, 0x4e, 0x96, 0xac, 0x54, 0x5d, 0xa2, 0x83, 0x5e, 0x7a, 0x87,
+		0x84, 0x80, 0x71, 0x83, 0x9f, 0x7d, 0x6a, 0x95, 0x97, 0x6f, 0x78, 0x8f, 0x83, 0x77, 0x79, 0x7e,
+		0x85, 0x7d, 0x70, 0x80, 0x8d, 0x79, 0x75, 0x8a, 0x89, 0x7b, 0x7f, 0x86, 0x85, 0x80, 0x7c, 0x80,
+		0x87, 0x7f, 0x77, 0x81, 0x85, 0x7b, 0x7a, 0x82, 0x82, 0x7e, 0x7e, 0x81, 0x83, 0x81, 0x7e, 0x81,
+		0x85, 0x80, 0x7d, 0x82, 0x83, 0x7f, 0x7e, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x81, 0x80, 0x7e, 0x80,
+		0x82, 0xa9, 0x81, 0x4e, 0x96, 0xac, 0x54, 0x5d, 0xa2, 0x83, 0x5e, 0x7a, 0x87, 0x84, 0x80, 0x71,
+		0x83, 0x9e, 0x7d, 0x6a, 0x95, 0x97, 0x6f, 0x78, 0x8f, 0x83, 0x77, 0x79, 0x7e, 0x85, 0x7d, 0x70,
+		0x80, 0x8d, 0x79, 0x75, 0x8a, 0x89, 0x7b, 0x7f, 0x86, 0x85, 0x80, 0x7c, 0x80, 0x87, 0x7f, 0x77,
+		0x81, 0x85, 0x7b, 0x7a, 0x82, 0x82, 0x7e, 0x7e, 0x81, 0x83, 0x81, 0x7e, 0x80, 0x85, 0x80, 0x7d,
+		0x81, 0x83, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x7e, 0x7f, 0x81, 0x80, 0x7e, 0x80, 0x82, 0xa4, 0x84,
+		0x56, 0x8c, 0xa7, 0x66, 0x60, 0x90, 0x87, 0x70, 0x76, 0x79, 0x86, 0x8c, 0x71, 0x74, 0x98, 0x8d,
+		0x6f, 0x80, 0x92, 0x84, 0x7d, 0x7e, 0x80, 0x88, 0x80, 0x71, 0x7e, 0x8b, 0x7c, 0x73, 0x80, 0x84,
+		0x7f, 0x7d, 0x7d, 0x82, 0x87, 0x80, 0x7b, 0x85, 0x88, 0x7f, 0x7d, 0x82, 0x82, 0x80, 0x7e, 0x7d,
+		0x80, 0x82, 0x7d, 0x7b, 0x81, 0x81, 0x7f, 0x7e, 0x80, 0x81, 0x82, 0x80, 0x7f, 0x82, 0x83, 0x80,
+		0x7f, 0x81, 0x81, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x93, 0x88, 0x6a,
+		0x7f, 0x94, 0x7d, 0x71, 0x7e, 0x7f, 0x80, 0x81, 0x74, 0x78, 0x8b, 0x84, 0x75, 0x7f, 0x87, 0x83,
+		0x82, 0x80, 0x7d, 0x87, 0x88, 0x7c, 0x7c, 0x84, 0x83, 0x7f, 0x7e, 0x7d, 0x80, 0x83, 0x7e, 0x7a,
+		0x80, 0x82, 0x80, 0x7e, 0x7f, 0x80, 0x83, 0x82, 0x7e, 0x80, 0x83, 0x82, 0x80, 0x80, 0x80, 0x81,
+		0x81, 0x7f, 0x7e, 0x80, 0x81, 0x80, 0x7f, 0x7f, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x8b, 0x88, 0x77, 0x7b, 0x86,
+		0x82, 0x7e, 0x7d, 0x79, 0x7e, 0x85, 0x7e, 0x77, 0x7e, 0x82, 0x80, 0x80, 0x7e, 0x7d, 0x83, 0x86,
+		0x80, 0x7f, 0x81, 0x82, 0x83, 0x82, 0x7f, 0x7f, 0x82, 0x82, 0x7f, 0x7e, 0x7f, 0x80, 0x80, 0x80,
+		0x7d, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x81, 0x80, 0x80, 0x80, 0x81, 0x81, 0x81, 0x80,
+		0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x86, 0x85, 0x7e, 0x7d, 0x81,
+		0x81, 0x81, 0x80, 0x7c, 0x7c, 0x80, 0x81, 0x7f, 0x7e, 0x7e, 0x7e, 0x81, 0x81, 0x7f, 0x7f, 0x80,
+		0x80, 0x81, 0x81, 0x80, 0x80, 0x81, 0x82, 0x81, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80,
+		0x80, 0x81, 0x81, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80, 0x7f,
+		0x80, 0x80, 0x81, 0x81, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x83, 0x80,
+		0x7f, 0x80, 0x80, 0x81, 0x81, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83,
+		0x83, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x7e, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x83, 0x83, 0x80, 0x7f, 0x80, 0x80, 0x80, 0x81, 0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80,
+		0x7f, 0x7e, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x81,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x81, 0x81, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	},
+}
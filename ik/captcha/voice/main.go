@@ -0,0 +1,183 @@
+// Generates sounds.go: spoken digits 0-9 and a beep, 8kHz unsigned 8-bit PCM.
+// The voice is produced by a tiny cascade formant synthesizer, so no external
+// recordings are needed to rebuild the samples:
+//
+//	go run main.go > ../sounds.go && gofmt -w ../sounds.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+const sampleRate = 8000
+
+type phone struct {
+	f1, f2, f3 float64 // formants (Hz)
+	voice      float64 // voicing amplitude
+	noise      float64 // frication amplitude
+	nf         float64 // frication center frequency (Hz)
+	ms         int     // duration
+}
+
+var (
+	// Vowels
+	pI  = phone{f1: 270, f2: 2290, f3: 3010, voice: 1, ms: 180}
+	pIh = phone{f1: 390, f2: 1990, f3: 2550, voice: 1, ms: 110}
+	pEh = phone{f1: 530, f2: 1840, f3: 2480, voice: 1, ms: 120}
+	pUh = phone{f1: 640, f2: 1190, f3: 2390, voice: 1, ms: 150}
+	pAa = phone{f1: 730, f2: 1090, f3: 2440, voice: 1, ms: 130}
+	pAw = phone{f1: 570, f2: 840, f3: 2410, voice: 1, ms: 160}
+	pOh = phone{f1: 450, f2: 800, f3: 2600, voice: 1, ms: 120}
+	pUu = phone{f1: 300, f2: 870, f3: 2240, voice: 1, ms: 200}
+	pUs = phone{f1: 440, f2: 1020, f3: 2240, voice: 1, ms: 100}
+	pAx = phone{f1: 500, f2: 1500, f3: 2500, voice: 0.8, ms: 70}
+
+	// Consonants
+	pW  = phone{f1: 300, f2: 610, f3: 2200, voice: 0.6, ms: 70}
+	pR  = phone{f1: 310, f2: 1060, f3: 1380, voice: 0.7, ms: 70}
+	pN  = phone{f1: 250, f2: 1400, f3: 2500, voice: 0.35, ms: 100}
+	pV  = phone{f1: 220, f2: 1100, f3: 2080, voice: 0.3, noise: 0.15, nf: 2500, ms: 80}
+	pZ  = phone{f1: 240, f2: 1500, f3: 2500, voice: 0.3, noise: 0.35, nf: 3600, ms: 90}
+	pS  = phone{f1: 320, f2: 1400, f3: 2500, noise: 0.6, nf: 3600, ms: 130}
+	pF  = phone{f1: 340, f2: 1100, f3: 2080, noise: 0.25, nf: 2800, ms: 110}
+	pTh = phone{f1: 320, f2: 1290, f3: 2540, noise: 0.2, nf: 3000, ms: 110}
+	pGp = phone{f1: 300, f2: 1600, f3: 2500, ms: 45} // stop closure
+	pT  = phone{f1: 400, f2: 1600, f3: 2600, noise: 0.7, nf: 3500, ms: 30}
+	pK  = phone{f1: 300, f2: 1800, f3: 2500, noise: 0.6, nf: 1900, ms: 40}
+)
+
+var digits = [][]phone{
+	{pZ, pIh, pR, pOh, pUs}, // zero
+	{pW, pUh, pN},           // one
+	{pGp, pT, pUu},          // two
+	{pTh, pR, pI},           // three
+	{pF, pAw, pR},           // four
+	{pF, pAa, pIh, pV},      // five
+	{pS, pIh, pGp, pK, pS},  // six
+	{pS, pEh, pV, pAx, pN},  // seven
+	{pEh, pI, pGp, pT},      // eight
+	{pN, pAa, pIh, pN},      // nine
+}
+
+// resonator is a second order IIR filter (Klatt, 1980).
+type resonator struct{ a, b, c, y1, y2 float64 }
+
+func (r *resonator) set(f, bw float64) {
+	t := 1.0 / sampleRate
+	r.c = -math.Exp(-2 * math.Pi * bw * t)
+	r.b = 2 * math.Exp(-math.Pi*bw*t) * math.Cos(2*math.Pi*f*t)
+	r.a = 1 - r.b - r.c
+}
+
+func (r *resonator) do(x float64) float64 {
+	y := r.a*x + r.b*r.y1 + r.c*r.y2
+	r.y2, r.y1 = r.y1, y
+	return y
+}
+
+func lerp(a, b, t float64) float64 { return a + (b-a)*t }
+
+func synth(phones []phone) []float64 {
+	total := 0
+	for _, p := range phones {
+		total += p.ms * sampleRate / 1000
+	}
+
+	out := make([]float64, 0, total)
+	var r1, r2, r3, rn resonator
+	var phase, last float64
+	prev := phones[0]
+
+	for _, p := range phones {
+		n := p.ms * sampleRate / 1000
+		for i := 0; i < n; i++ {
+			// Glide from the previous phone during the first 40% of this one
+			t := math.Min(float64(i)/(float64(n)*0.4), 1)
+			f1, f2, f3 := lerp(prev.f1, p.f1, t), lerp(prev.f2, p.f2, t), lerp(prev.f3, p.f3, t)
+			voice, noise := lerp(prev.voice, p.voice, t), lerp(prev.noise, p.noise, t)
+			nf := p.nf
+			if nf == 0 {
+				nf = prev.nf
+			}
+
+			r1.set(f1, 80)
+			r2.set(f2, 100)
+			r3.set(f3, 140)
+			rn.set(math.Max(nf, 1000), 900)
+
+			// Falling pitch contour: 130Hz -> 95Hz
+			f0 := lerp(130, 95, float64(len(out))/float64(total))
+			phase += f0 / sampleRate
+			var src float64
+			if phase >= 1 {
+				phase -= 1
+				src = 1
+			}
+
+			v := r3.do(r2.do(r1.do(src * voice * 40)))
+			v += rn.do((rand.Float64()*2 - 1) * noise)
+			out = append(out, v-last) // lip radiation
+			last = v
+		}
+		prev = p
+	}
+	return out
+}
+
+func toPCM(in []float64) []byte {
+	var peak float64
+	for _, v := range in {
+		peak = math.Max(peak, math.Abs(v))
+	}
+	fade := sampleRate / 100
+	b := make([]byte, len(in))
+	for i, v := range in {
+		env := 1.0
+		if i < fade {
+			env = float64(i) / float64(fade)
+		} else if j := len(in) - i; j < fade {
+			env = float64(j) / float64(fade)
+		}
+		b[i] = byte(128 + int(v/peak*100*env))
+	}
+	return b
+}
+
+func beep() []float64 {
+	b := make([]float64, sampleRate/10)
+	for i := range b {
+		b[i] = math.Sin(2 * math.Pi * 1000 * float64(i) / sampleRate)
+	}
+	return b
+}
+
+func write(buf *bytes.Buffer, pcm []byte) {
+	for i, v := range pcm {
+		if i%16 == 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(buf, "0x%02x,", v)
+	}
+	buf.WriteString("\n")
+}
+
+func main() {
+	rand.Seed(1)
+
+	buf := bytes.Buffer{}
+	buf.WriteString("// Code generated by voice/main.go. DO NOT EDIT.\n\npackage captcha\n\n")
+	buf.WriteString("var beepSound = []byte{")
+	write(&buf, toPCM(beep()))
+	buf.WriteString("}\n\nvar digitSounds = [][]byte{\n")
+	for i, d := range digits {
+		fmt.Fprintf(&buf, "{ // %d", i)
+		write(&buf, toPCM(synth(d)))
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	fmt.Println(buf.String())
+}
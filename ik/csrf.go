@@ -9,7 +9,6 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/coyove/common/lru"
@@ -18,43 +17,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-var (
-	Dedup          = lru.NewCache(1024)
-	burstableDedup [1024]struct {
-		sync.Mutex
-		start time.Time
-		count int
-	}
-	maxBurst      = 10
-	burstCooldown = 30000
-)
-
-func init() {
-	go func() {
-		// e.g. max 15 requests in 30 seconds, that's 0.5 r/s, so we tick every 2s
-		for range time.Tick(time.Duration(burstCooldown/maxBurst) * time.Millisecond) {
-			for i := range burstableDedup {
-				b := &burstableDedup[i]
-				b.Lock()
-				if b.count > 1 {
-					b.count--
-				}
-				b.Unlock()
-			}
-		}
-	}()
-}
-
-func BAdd(key string) bool {
-	b := &burstableDedup[common.Hash32(key)%uint32(len(burstableDedup))]
-	b.Lock()
-	defer b.Unlock()
-	if b.count <= maxBurst {
-		b.count++
-		return true
-	}
-	return false
-}
+var Dedup = lru.NewCache(1024)
 
 func MakeToken(g *gin.Context) (string, string) {
 	var x [4]byte
@@ -63,64 +26,152 @@ func MakeToken(g *gin.Context) (string, string) {
 }
 
 func MakeUUID(g *gin.Context, x *[4]byte) string {
-	var p [16]byte
+	var p [17]byte
 	exp := time.Now().Add(time.Minute * time.Duration(common.Cfg.TokenTTL)).Unix()
-	binary.BigEndian.PutUint32(p[:], uint32(exp))
+	binary.BigEndian.PutUint32(p[1:], uint32(exp))
 
-	copy(p[4:10], g.Request.UserAgent())
-	rand.Read(p[10:])
+	copy(p[5:11], g.Request.UserAgent())
+	rand.Read(p[11:])
 
 	if x != nil {
-		copy((*x)[:], p[10:])
+		copy((*x)[:], p[11:])
 	}
 
-	common.Cfg.Blk.Encrypt(p[:], p[:])
+	k := common.ActiveKey()
+	p[0] = k.ID
+	k.Blk.Encrypt(p[1:], p[1:])
 	return hex.EncodeToString(p[:])
 }
 
-func ParseToken(g *gin.Context, tok string) (r []byte, ok bool) {
-	buf, _ := hex.DecodeString(tok)
-	if len(buf) != 16 {
-		return
+// MakeAudioToken returns the WAV audio of the challenge carried by the token,
+// the token itself is not consumed so it can still be used in ParseToken later.
+func MakeAudioToken(g *gin.Context, tok string) ([]byte, bool) {
+	x, ok := decodeToken(g, tok)
+	if !ok {
+		return nil, false
 	}
-	common.Cfg.Blk.Decrypt(buf, buf)
-	exp := binary.BigEndian.Uint32(buf)
-	if now := time.Now(); now.After(time.Unix(int64(exp), 0)) ||
-		now.Before(time.Unix(int64(exp)-common.Cfg.TokenTTL*60, 0)) {
-		return
+	digits := make([]byte, 4) // same 4 digits drawn by MakeToken
+	for i := range digits {
+		digits[i] = x[i] % 10
 	}
+	// Seeded with the random bytes of this token, so the same digits sound differently in different tokens
+	p := bytes.Buffer{}
+	captcha.NewAudio(common.Cfg.Key+hex.EncodeToString(x), digits).WriteTo(&p)
+	return p.Bytes(), true
+}
 
-	tmp := [6]byte{}
-	copy(tmp[:], g.Request.UserAgent())
-
-	ok = bytes.HasPrefix(buf[4:10], tmp[:])
+func ParseToken(g *gin.Context, tok string) (r []byte, ok bool) {
+	r, ok = decodeToken(g, tok)
 	if ok {
 		if _, existed := Dedup.Get(tok); existed {
 			return nil, false
 		}
 		Dedup.Add(tok, true)
 	}
+	return
+}
+
+func decodeToken(g *gin.Context, tok string) (r []byte, ok bool) {
+	buf, _ := hex.DecodeString(tok)
+	keys := common.Keyring() // legacy token without key ID
+	switch len(buf) {
+	case 17:
+		keys, buf = common.KeysByID(buf[0]), buf[1:]
+	case 16:
+	default:
+		return
+	}
+
+	tmp := [6]byte{}
+	copy(tmp[:], g.Request.UserAgent())
 
-	r = buf[10:]
+	for _, k := range keys {
+		p := [16]byte{}
+		k.Blk.Decrypt(p[:], buf)
+		exp := binary.BigEndian.Uint32(p[:])
+		if now := time.Now(); now.After(time.Unix(int64(exp), 0)) ||
+			now.Before(time.Unix(int64(exp)-common.Cfg.TokenTTL*60, 0)) {
+			continue
+		}
+		if bytes.HasPrefix(p[4:10], tmp[:]) {
+			return p[10:], true
+		}
+	}
 	return
 }
 
+// sealWithKey encrypts the data using the active key: ID || Sealed || Nonce
+func sealWithKey(data []byte, nonce [12]byte) []byte {
+	k := common.ActiveKey()
+	gcm, _ := cipher.NewGCM(k.Blk)
+	buf := make([]byte, 1, 1+len(data)+gcm.Overhead()+len(nonce))
+	buf[0] = k.ID
+	buf = gcm.Seal(buf, nonce[:], data, nil)
+	return append(buf, nonce[:]...)
+}
+
+// openWithKeys decrypts the data sealed by sealWithKey, legacy data (Sealed || Nonce)
+// without the key ID will be tried against all keys in the keyring.
+func openWithKeys(buf []byte) ([]byte, error) {
+	if len(buf) < 12 {
+		return nil, fmt.Errorf("token too short")
+	}
+	nonce := buf[len(buf)-12:]
+	try := func(keys []*common.Key, sealed []byte) ([]byte, error) {
+		err := fmt.Errorf("no key")
+		for _, k := range keys {
+			gcm, _ := cipher.NewGCM(k.Blk)
+			var p []byte
+			if p, err = gcm.Open(nil, nonce, sealed, nil); err == nil {
+				return p, nil
+			}
+		}
+		return nil, err
+	}
+	if len(buf) > 12 {
+		if p, err := try(common.KeysByID(buf[0]), buf[1:len(buf)-12]); err == nil {
+			return p, nil
+		}
+	}
+	return try(common.Keyring(), buf[:len(buf)-12])
+}
+
 func MakeOTT(id string) string {
+	return MakeOTTWithTTL(id, time.Second*time.Duration(common.Cfg.IDTokenTTL))
+}
+
+func MakeOTTWithTTL(id string, ttl time.Duration) string {
 	if len(id) == 0 {
 		return ""
 	}
 
 	var nonce [12]byte
-	exp := time.Now().Add(time.Second * time.Duration(common.Cfg.IDTokenTTL)).Unix()
+	exp := time.Now().Add(ttl).Unix()
 	binary.BigEndian.PutUint32(nonce[:], uint32(exp))
 	rand.Read(nonce[4:])
 
-	idbuf := make([]byte, len(id), len(id)+48)
-	copy(idbuf, id)
+	return base64.URLEncoding.EncodeToString(sealWithKey([]byte(id), nonce))
+}
 
-	gcm, _ := cipher.NewGCM(common.Cfg.Blk)
-	data := gcm.Seal(idbuf[:0], nonce[:], idbuf, nil)
-	return base64.URLEncoding.EncodeToString(append(data, nonce[:]...))
+// Seal encrypts the data into an URL-safe token which expires after ttl
+func Seal(data []byte, ttl time.Duration) string {
+	var nonce [12]byte
+	binary.BigEndian.PutUint32(nonce[:], uint32(time.Now().Add(ttl).Unix()))
+	rand.Read(nonce[4:])
+	return userTokenBase64.EncodeToString(sealWithKey(data, nonce))
+}
+
+func Unseal(tok string) ([]byte, bool) {
+	buf, _ := userTokenBase64.DecodeString(strings.TrimRight(tok, "="))
+	if len(buf) < 12 {
+		return nil, false
+	}
+	exp := time.Unix(int64(binary.BigEndian.Uint32(buf[len(buf)-12:])), 0)
+	if time.Now().After(exp) {
+		return nil, false
+	}
+	p, err := openWithKeys(buf)
+	return p, err == nil
 }
 
 func ValidateOTT(id, tok string) bool {
@@ -130,15 +181,12 @@ func ValidateOTT(id, tok string) bool {
 	}
 
 	nonce := idbuf[len(idbuf)-12:]
-	idbuf = idbuf[:len(idbuf)-12]
-
 	exp := time.Unix(int64(binary.BigEndian.Uint32(nonce)), 0)
 	if time.Now().After(exp) {
 		return false
 	}
 
-	gcm, _ := cipher.NewGCM(common.Cfg.Blk)
-	p, _ := gcm.Open(nil, nonce, idbuf, nil)
+	p, _ := openWithKeys(idbuf)
 	return string(p) == id
 }
 
@@ -149,12 +197,10 @@ func MakeUserToken(uid, session string) string {
 	p.WriteString(uid)
 	p.WriteByte(0)
 	p.WriteString(session)
-	buf := p.Bytes()
 
 	var nonce [12]byte
 	rand.Read(nonce[:])
-	gcm, _ := cipher.NewGCM(common.Cfg.Blk)
-	return userTokenBase64.EncodeToString(append(gcm.Seal(buf[:0], nonce[:], buf, nil), nonce[:]...))
+	return userTokenBase64.EncodeToString(sealWithKey(p.Bytes(), nonce))
 }
 
 func ParseUserToken(tok string) (id, session string, err error) {
@@ -162,14 +208,8 @@ func ParseUserToken(tok string) (id, session string, err error) {
 	if err != nil {
 		return "", "", err
 	}
-	if len(u) < 12 {
-		return "", "", fmt.Errorf("token too short")
-	}
-
-	nonce := u[len(u)-12:]
-	gcm, _ := cipher.NewGCM(common.Cfg.Blk)
 
-	opened, err := gcm.Open(nil, nonce[:], u[:len(u)-12], nil)
+	opened, err := openWithKeys(u)
 	if err != nil {
 		return "", "", err
 	}
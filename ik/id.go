@@ -20,6 +20,7 @@ const (
 	IDFollowing          = 0x0B
 	IDBlacklist          = 0x0C
 	IDLike               = 0x0D
+	IDBookmark           = 0x0E
 )
 
 type IDHeader byte
@@ -73,3 +73,41 @@ func TestShortId(t *testing.T) {
 		t.Logf("%d %s", i, c)
 	}
 }
+
+func TestKeyRotation(t *testing.T) {
+	common.SetKeyring("0123456789abcdef")
+	tok := MakeUserToken("a", "session")
+	ott := MakeOTT("a")
+
+	common.SetKeyring("fedcba9876543210", "0123456789abcdef")
+	if id, session, err := ParseUserToken(tok); id != "a" || session != "session" {
+		t.Fatal(id, session, err)
+	}
+	if !ValidateOTT("a", ott) {
+		t.Fatal("OTT signed by old key")
+	}
+	if id, _, err := ParseUserToken(MakeUserToken("b", "s")); id != "b" {
+		t.Fatal(id, err)
+	}
+
+	common.SetKeyring("fedcba9876543210")
+	if _, _, err := ParseUserToken(tok); err == nil {
+		t.Fatal("key retired")
+	}
+	if ValidateOTT("a", ott) {
+		t.Fatal("key retired")
+	}
+}
+
+func TestSeal(t *testing.T) {
+	tok := Seal([]byte("hello"), time.Second)
+	if p, ok := Unseal(tok); !ok || string(p) != "hello" {
+		t.Fatal(p, ok)
+	}
+	if _, ok := Unseal(tok[:len(tok)-2]); ok {
+		t.Fatal("tampered token")
+	}
+	if _, ok := Unseal(Seal([]byte("hello"), -time.Second)); ok {
+		t.Fatal("expired token")
+	}
+}
@@ -0,0 +1,94 @@
+package ik
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	totpPeriod = 30
+	totpDigits = 6
+	totpSkew   = 1 // accept codes from adjacent periods
+)
+
+var totpBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func MakeTOTPSecret() string {
+	p := [20]byte{}
+	rand.Read(p[:])
+	return totpBase32.EncodeToString(p[:])
+}
+
+// TOTPURI returns the otpauth:// URI recognized by authenticator apps
+func TOTPURI(issuer, uid, secret string) string {
+	return "otpauth://totp/" + url.PathEscape(issuer+":"+uid) + "?" + url.Values{
+		"secret": {secret},
+		"issuer": {issuer},
+	}.Encode()
+}
+
+// TOTPCode computes the RFC 6238 code of the secret at time t
+func TOTPCode(secret string, t time.Time) string {
+	key, err := totpBase32.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return ""
+	}
+	return hotp(key, uint64(t.Unix()/totpPeriod))
+}
+
+func hotp(key []byte, counter uint64) string {
+	var c [8]byte
+	binary.BigEndian.PutUint64(c[:], counter)
+	h := hmac.New(sha1.New, key)
+	h.Write(c[:])
+	sum := h.Sum(nil)
+	off := sum[len(sum)-1] & 0xf
+	v := binary.BigEndian.Uint32(sum[off:]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, v%1000000)
+}
+
+// TOTPWindow is how long a code stays valid, used codes should be remembered at least this long
+const TOTPWindow = (2*totpSkew + 1) * totpPeriod * time.Second
+
+// ValidateTOTP validates the code, 'use' marks the code used and returns false if it has been used before,
+// so each code can only be used once
+func ValidateTOTP(secret, code string, use func(key string) bool) bool {
+	code = strings.Replace(code, " ", "", -1)
+	if len(code) != totpDigits || secret == "" {
+		return false
+	}
+	now := time.Now()
+	for i := -totpSkew; i <= totpSkew; i++ {
+		if !hmac.Equal([]byte(TOTPCode(secret, now.Add(time.Duration(i*totpPeriod)*time.Second))), []byte(code)) {
+			continue
+		}
+		return use(secret + "/" + code)
+	}
+	return false
+}
+
+// MakeRecoveryCodes generates n one-time recovery codes, returns the codes and their hashes
+func MakeRecoveryCodes(n int) (codes, hashes []string) {
+	for i := 0; i < n; i++ {
+		p := [5]byte{}
+		rand.Read(p[:])
+		c := strings.ToLower(totpBase32.EncodeToString(p[:]))
+		codes = append(codes, c)
+		hashes = append(hashes, HashRecoveryCode(c))
+	}
+	return
+}
+
+func HashRecoveryCode(code string) string {
+	h := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(h[:8])
+}
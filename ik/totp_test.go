@@ -0,0 +1,43 @@
+package ik
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+func TestTOTP(t *testing.T) {
+	// RFC 6238 appendix B, SHA1, truncated to 6 digits
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	for ts, code := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		if c := TOTPCode(secret, time.Unix(ts, 0)); c != code {
+			t.Fatal(ts, c, code)
+		}
+	}
+
+	used := map[string]bool{}
+	use := func(key string) bool {
+		first := !used[key]
+		used[key] = true
+		return first
+	}
+	secret = MakeTOTPSecret()
+	code := TOTPCode(secret, time.Now())
+	if !ValidateTOTP(secret, code, use) {
+		t.Fatal(code)
+	}
+	if ValidateTOTP(secret, code, use) {
+		t.Fatal("replayed", code)
+	}
+
+	codes, hashes := MakeRecoveryCodes(4)
+	if HashRecoveryCode(" "+codes[2]+" ") != hashes[2] {
+		t.Fatal(codes, hashes)
+	}
+	t.Log(TOTPURI("iis", "admin", secret))
+}
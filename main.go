@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"net/http"
 	"net/http/pprof"
+	"net/url"
 	"os"
 	"runtime"
 	"strconv"
@@ -20,6 +21,9 @@ import (
 	"github.com/alicebob/miniredis/v2"
 	"github.com/coyove/iis/common"
 	"github.com/coyove/iis/dal"
+	"github.com/coyove/iis/dal/feed"
+	"github.com/coyove/iis/dal/index"
+	"github.com/coyove/iis/dal/ratelimit"
 	"github.com/coyove/iis/dal/storage"
 	"github.com/coyove/iis/dal/tagrank"
 	"github.com/coyove/iis/handler"
@@ -56,6 +60,10 @@ func main() {
 	}
 
 	tagrank.Init(redisConfig)
+	ratelimit.Init(redisConfig)
+	feed.Init(redisConfig)
+	index.Init(redisConfig)
+	dal.EnsureSearchIndex(10000)
 
 	prodMode := common.Cfg.Key != "0123456789abcdef"
 
@@ -128,6 +136,32 @@ func main() {
 		"sub": func(a, b int) int {
 			return a - b
 		},
+		"pathEscape": url.PathEscape,
+		"getSessions": func(u *model.User) []model.Session {
+			return dal.GetSessions(u)
+		},
+		"getAPITokens": func(u *model.User) []model.APIToken {
+			return dal.GetAPITokens(u)
+		},
+		"getSavedSearches": func(u *model.User) []model.SavedSearch {
+			return dal.GetSavedSearches(u.ID)
+		},
+		"getMutes": func(u *model.User) []model.MuteEntry {
+			return dal.GetMuteEntries(u.ID)
+		},
+		"getOAuthClients": func(u *model.User) []*model.OAuthClient {
+			return dal.GetOAuthClients(u.ID)
+		},
+		"getOAuthGrants": func(u *model.User) []model.OAuthGrant {
+			return dal.GetOAuthGrants(u.ID)
+		},
+		"getOAuthClient": func(id string) *model.OAuthClient {
+			c, _ := dal.GetOAuthClient(id)
+			return c
+		},
+		"apiScopes": func() []string {
+			return model.APIScopeNames
+		},
 		"ipChainLookup": func(chain string) [][3]interface{} {
 			res := [][3]interface{}{}
 			for _, part := range strings.Split(chain, ",") {
@@ -184,19 +218,35 @@ func main() {
 	r.Handle("GET", "/user", handler.User)
 	r.Handle("GET", "/user_security", handler.UserSecurity)
 	r.Handle("GET", "/user_api", handler.UserSecurity)
+	r.Handle("GET", "/user_searches", handler.UserSecurity)
+	r.Handle("GET", "/user_mutes", handler.UserSecurity)
+	r.Handle("GET", "/reset_password", handler.ResetPassword)
+	r.Handle("GET", "/verify_email", handler.VerifyEmail)
+	r.Handle("GET", "/oauth/authorize", handler.OAuthAuthorize)
+	r.Handle("POST", "/oauth/authorize", handler.APIOAuthAuthorize)
+	r.Handle("POST", "/oauth/token", handler.APIOAuthToken)
 	r.Handle("GET", "/user/:type/:uid", handler.UserList)
 	r.Handle("POST", "/user/:type/:uid", handler.UserList)
 	r.Handle("GET", "/likes/:uid", handler.UserLikes)
+	r.Handle("GET", "/bookmarks", handler.Bookmarks)
+	r.Handle("GET", "/lists", handler.Lists)
+	r.Handle("GET", "/scheduled", handler.ScheduledPosts)
+	r.Handle("GET", "/drafts", handler.Drafts)
+	r.Handle("GET", "/lists/:uid", handler.Lists)
+	r.Handle("GET", "/list/:uid/:lid", handler.ListTimeline)
 	r.Handle("GET", "/t", handler.Timeline)
 	r.Handle("GET", "/t/:user", handler.Timeline)
 	r.Handle("GET", "/search/:query", handler.Search)
 	r.Handle("GET", "/search", handler.Search)
 	r.Handle("GET", "/S/:id", handler.S)
+	r.Handle("GET", "/revisions/:id", handler.Revisions)
+	r.Handle("GET", "/thread/:id", handler.Thread)
 	r.Handle("GET", "/inbox", handler.Inbox)
 	r.Handle("GET", "/mod/user", handler.ModUser)
 	r.Handle("GET", "/mod/kv", handler.ModKV)
 	r.Handle("GET", "/post_box", handler.PostBox)
 	r.Handle("GET", "/api/timeline", handler.APITimeline) // crawler special case
+	r.Handle("GET", "/api/new_captcha_audio", handler.APINewCaptchaAudio)
 
 	r.Handle("POST", "/api/u/:id", handler.APIGetUserInfoBox)
 	r.Handle("POST", "/api/upload_image", handler.APIUpload)
@@ -207,20 +257,38 @@ func main() {
 	r.Handle("POST", "/api/ban", handler.APIBan)
 	r.Handle("POST", "/api/promote_mod", handler.APIPromoteMod)
 	r.Handle("POST", "/api/mod_kv", handler.APIModKV)
+	r.Handle("POST", "/api/ratelimit_override", handler.APIRateLimitOverride)
+	r.Handle("POST", "/api/rebuild_index", handler.APIRebuildSearchIndex)
+	r.Handle("POST", "/api/promote_key", handler.APIPromoteKey)
 	r.Handle("POST", "/api/user_settings", handler.APIUpdateUserSettings)
 	r.Handle("POST", "/api/clear_inbox", handler.APIClearInbox)
-	r.Handle("POST", "/api2/follow_block", handler.APIFollowBlock)
-	r.Handle("POST", "/api2/like_article", handler.APILike)
+	r.Handle("POST", "/api/oauth_client", handler.APIOAuthClient)
+	r.Handle("POST", "/api/totp_begin", handler.APIBeginTOTP)
+	r.Handle("POST", "/api/totp_enable", handler.APIEnableTOTP)
+	r.Handle("POST", "/api/totp_disable", handler.APIDisableTOTP)
+	r.Handle("POST", "/api/totp_recovery", handler.APIRegenRecoveryCodes)
+	r.Handle("POST", "/api2/timeline", middleware.RequireScope(model.ScopeRead), handler.APITimeline)
+	r.Handle("POST", "/api2/follow_block", middleware.RequireScope(model.ScopeFollow), handler.APIFollowBlock)
+	r.Handle("POST", "/api2/like_article", middleware.RequireScope(model.ScopePost), handler.APILike)
+	r.Handle("POST", "/api2/repost", middleware.RequireScope(model.ScopePost), handler.APIRepost)
+	r.Handle("POST", "/api2/bookmark", middleware.RequireScope(model.ScopePost), handler.APIBookmark)
+	r.Handle("POST", "/api2/list", middleware.RequireScope(model.ScopeFollow), handler.APIList)
+	r.Handle("POST", "/api2/scheduled", middleware.RequireScope(model.ScopePost), handler.APIScheduledPost)
+	r.Handle("POST", "/api2/draft", middleware.RequireScope(model.ScopePost), handler.APIDraft)
 	r.Handle("POST", "/api2/signup", handler.APISignup)
 	r.Handle("POST", "/api2/login", handler.APILogin)
+	r.Handle("POST", "/api2/login_totp", handler.APILoginTOTP)
 	r.Handle("POST", "/api2/logout", handler.APILogout)
-	r.Handle("POST", "/api2/new", handler.APINew)
+	r.Handle("POST", "/api2/new", middleware.RequireScope(model.ScopePost), handler.APINew)
 	r.Handle("POST", "/api2/user_password", handler.APIUpdateUserPassword)
-	r.Handle("POST", "/api2/delete", handler.APIDeleteArticle)
-	r.Handle("POST", "/api2/toggle_nsfw", handler.APIToggleNSFWArticle)
-	r.Handle("POST", "/api2/toggle_lock", handler.APIToggleLockArticle)
-	r.Handle("POST", "/api2/drop_top", handler.APIDropTop)
-	r.Handle("POST", "/api2/poll", handler.APIPoll)
+	r.Handle("POST", "/api/reset_password_request", handler.APIRequestPasswordReset)
+	r.Handle("POST", "/api/reset_password", handler.APIResetUserPassword)
+	r.Handle("POST", "/api2/delete", middleware.RequireScope(model.ScopePost), handler.APIDeleteArticle)
+	r.Handle("POST", "/api2/edit", middleware.RequireScope(model.ScopePost), handler.APIEditArticle)
+	r.Handle("POST", "/api2/toggle_nsfw", middleware.RequireScope(model.ScopePost), handler.APIToggleNSFWArticle)
+	r.Handle("POST", "/api2/toggle_lock", middleware.RequireScope(model.ScopePost), handler.APIToggleLockArticle)
+	r.Handle("POST", "/api2/drop_top", middleware.RequireScope(model.ScopePost), handler.APIDropTop)
+	r.Handle("POST", "/api2/poll", middleware.RequireScope(model.ScopePost), handler.APIPoll)
 
 	r.Handle("GET", "/debug/pprof/*name", func(g *gin.Context) {
 		u, _ := g.Get("user")
@@ -12,7 +12,6 @@ import (
 	"github.com/coyove/iis/common"
 	"github.com/coyove/iis/common/logs"
 	"github.com/coyove/iis/dal"
-	"github.com/coyove/iis/ik"
 	"github.com/coyove/iis/model"
 	"github.com/gin-gonic/gin"
 )
@@ -49,6 +48,9 @@ func mwRenderPerf(g *gin.Context) {
 
 	g.Set("ip", ip)
 	g.Set("req-start", start)
+	if u != nil {
+		dal.TouchSession(u, g)
+	}
 
 	if strings.HasPrefix(g.Request.URL.Path, "/s/") {
 		g.Writer.Header().Add("Cache-Control", "max-age=31536000")
@@ -64,43 +66,16 @@ func mwRenderPerf(g *gin.Context) {
 	}
 }
 
-func mwIPThrot(g *gin.Context) {
-	if g.Request.Method == "POST" && common.Cfg.ReadOnly {
-		g.String(200, "retryable/read-only")
-		g.Abort()
-		return
-	}
-
-	if g.Request.Method != "POST" || strings.HasPrefix(g.Request.URL.Path, "/api/") {
-		g.Set("ip-ok", true)
-		g.Next()
-		return
-	}
-
-	ip := g.MustGet("ip").(net.IP).String()
-	lastaccess, ok := ik.Dedup.Get(ip)
-
-	if !ok {
-		ik.Dedup.Add(ip, time.Now())
-		g.Set("ip-ok", true)
-		goto NEXT
-	} else {
-		t, _ := lastaccess.(time.Time)
-		diff := time.Since(t).Seconds()
-
-		if diff > float64(common.Cfg.Cooldown)-1 {
-			ik.Dedup.Add(ip, time.Now())
-			g.Set("ip-ok", true)
-			goto NEXT
+// RequireScope allows API tokens with the scope to access the handler,
+// the API user will also be set in the context if the request has no cookie.
+func RequireScope(scope model.APIScope) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		g.Set("api-scope", scope)
+		if _, ok := g.Get("user"); !ok && dal.ContextToken(g) != "" {
+			if u := dal.GetUserByContext(g); u != nil {
+				g.Set("user", u)
+			}
 		}
-
-		g.Set("ip-ok", false)
-		g.Set("ip-ok-remain", diff)
-	}
-NEXT:
-	g.Next()
-	if g.GetBool("clear-ip-throt") {
-		ik.Dedup.Remove(ip)
 	}
 }
 
@@ -121,8 +96,8 @@ func New(prod bool) *gin.Engine {
 	r.Use(
 		gin.Recovery(),
 		mwRenderPerf,
-		mwIPThrot,
 		RequestSizeLimiter(int64(common.Cfg.MaxRequestSize)*1024*1024),
+		mwRateLimit, // may parse the form, so it must be after the size limiter
 		errorHandling,
 	)
 
@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/dal"
+	"github.com/coyove/iis/dal/ratelimit"
+	"github.com/coyove/iis/ik"
+	"github.com/coyove/iis/model"
+	"github.com/gin-gonic/gin"
+)
+
+// rateLimitToken returns the user token without parsing the form, multipart bodies
+// (e.g. uploads) should be left untouched for handlers
+func rateLimitToken(g *gin.Context) string {
+	if h := g.GetHeader("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return strings.TrimSpace(h[7:])
+	}
+	if tok := g.Query("api2_uid"); tok != "" {
+		return tok
+	}
+	if strings.HasPrefix(g.ContentType(), "application/x-www-form-urlencoded") {
+		return g.PostForm("api2_uid")
+	}
+	return ""
+}
+
+// rateLimitUser returns the user of the request, users authenticated by tokens are loaded lazily
+func rateLimitUser(g *gin.Context, tok string, loaded *bool) *model.User {
+	if u, ok := g.Get("user"); ok {
+		return u.(*model.User)
+	}
+	if *loaded || tok == "" {
+		return nil
+	}
+	*loaded = true
+	if id, _, err := ik.ParseUserToken(tok); err == nil {
+		if u, _ := dal.WeakGetUser(id); u != nil {
+			g.Set("ratelimit-user", u)
+		}
+	}
+	u, _ := g.Get("ratelimit-user")
+	x, _ := u.(*model.User)
+	return x
+}
+
+func rateLimitKey(g *gin.Context, p *common.RateLimitPolicy, u *model.User, tok string) string {
+	ip := g.MustGet("ip").(net.IP).String()
+	switch p.Key {
+	case "token":
+		if tok != "" {
+			return p.Name + ":t:" + dal.SessionHash(tok)
+		}
+		fallthrough
+	case "user":
+		if u != nil {
+			return p.Name + ":u:" + u.ID
+		}
+	}
+	return p.Name + ":ip:" + ip
+}
+
+func setRateLimitHeaders(g *gin.Context, r ratelimit.Result) {
+	h := g.Writer.Header()
+	h.Set("RateLimit-Limit", strconv.Itoa(r.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(r.Remaining))
+	h.Set("RateLimit-Reset", strconv.Itoa(int(math.Ceil(r.Reset.Seconds()))))
+}
+
+func mwRateLimit(g *gin.Context) {
+	if g.Request.Method == "POST" && common.Cfg.ReadOnly {
+		g.String(200, "retryable/read-only")
+		g.Abort()
+		return
+	}
+
+	g.Set("ip-ok", true)
+
+	var (
+		tok       = rateLimitToken(g)
+		loaded    bool
+		tightest  *ratelimit.Result
+		softKeys  []string
+		softAlgos []string
+	)
+
+	for i := range common.Cfg.RateLimits {
+		p := &common.Cfg.RateLimits[i]
+		if !p.Match(g.Request.Method, g.Request.URL.Path) {
+			continue
+		}
+
+		u := rateLimitUser(g, tok, &loaded)
+		limit := p.Limit
+		if u != nil {
+			if l, ok := u.RateLimits[p.Name]; ok {
+				limit = l
+			}
+		}
+		if limit < 0 {
+			continue
+		}
+
+		key := rateLimitKey(g, p, u, tok)
+		r, err := ratelimit.Take(p.Algo, key, limit, time.Duration(p.Window)*time.Second)
+		if err != nil {
+			// Fail open, a broken Redis should not take the whole site down
+			log.Println("[ratelimit]", key, err)
+			continue
+		}
+
+		if p.Soft {
+			softKeys, softAlgos = append(softKeys, key), append(softAlgos, p.Algo)
+			if !r.Allowed {
+				g.Set("ip-ok", false)
+				g.Set("ip-ok-retry", r.RetryAfter.Seconds())
+			}
+			continue
+		}
+
+		if tightest == nil || r.Remaining < tightest.Remaining {
+			tightest = &r
+		}
+
+		if !r.Allowed {
+			setRateLimitHeaders(g, r)
+			retry := r.RetryAfter.Seconds()
+			g.Writer.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retry))))
+			g.Writer.Header().Set("X-Reason", fmt.Sprintf("cooldown`%.1fs", retry))
+			g.String(429, "cooldown`%.1fs", retry)
+			g.Abort()
+			return
+		}
+	}
+
+	if tightest != nil {
+		setRateLimitHeaders(g, *tightest)
+	}
+
+	g.Next()
+
+	if g.GetBool("clear-ip-throt") {
+		for i, key := range softKeys {
+			ratelimit.Clear(softAlgos[i], key)
+		}
+	}
+}
@@ -2,19 +2,22 @@ package model
 
 import (
 	"math"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/coyove/common/lru"
 	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/common/jiebago"
+	"github.com/coyove/iis/common/stemmer"
 	"github.com/coyove/iis/ik"
 )
 
 var (
 	mtSize     = 100
-	candSize   = 100
 	pool       = lru.NewCache(1e4)
 	dedupSec   = 60.0
 	dedupCache = lru.NewCache(100)
@@ -24,21 +27,87 @@ var (
 func IndexUser(u *User) { Index("su", ik.NewID(ik.IDAuthor, u.ID), u.ID, u.CustomName) }
 func IndexTag(t string) { Index("st", ik.NewID(ik.IDTag, t), t) }
 
-func indexArticle(a *Article) {
+// Searchable returns true if the article should be put into the article search index
+func (a *Article) Searchable() bool {
 	if a.PostOptions&PostOptionNoMasterTimeline != 0 ||
 		a.PostOptions&PostOptionNoSearch != 0 {
-		return
+		return false
+	}
+	if a.IsDeleted() || a.Cmd != CmdNone || a.ReferID != "" {
+		return false
+	}
+	return len(a.ID) == 12 && a.ID[0] == 'S'
+}
+
+var (
+	reHan        = regexp.MustCompile(`\p{Han}+`)
+	reSearchWord = regexp.MustCompile(`[\p{L}\p{N}]+`)
+	maxTerms     = 512
+	maxTermLen   = 64
+)
+
+func addBigrams(m map[string]int, runes []rune) {
+	if len(runes) == 1 {
+		m[string(runes)]++
+	}
+	for i := 0; i+1 < len(runes) && len(m) < maxTerms; i++ {
+		m[string(runes[i:i+2])]++
+	}
+}
+
+func needsBigrams(word string) bool {
+	for _, r := range word {
+		if unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Hangul, unicode.Thai) {
+			return true
+		}
+	}
+	return false
+}
+
+// Tokenize splits the text into at most 512 distinct terms and counts their occurrences.
+// Han characters are cut by jieba using search mode and unknown ones fall back to bigrams,
+// Latin words are case folded and stemmed, other scripts without spaces are split into bigrams.
+func Tokenize(v string) (m map[string]int) {
+	if len(v) == 0 {
+		return m
 	}
-	if !(len(a.ID) == 12 && a.ID[0] == 'S') {
-		return
+
+	m = map[string]int{}
+	var unknown []rune
+	for _, loc := range reHan.FindAllStringIndex(v, -1) {
+		for _, word := range jiebago.CutForSearch(v[loc[0]:loc[1]]) {
+			if utf8.RuneCountInString(word) == 1 {
+				unknown = append(unknown, []rune(word)...)
+				continue
+			}
+			if len(unknown) > 0 {
+				addBigrams(m, unknown)
+				unknown = unknown[:0]
+			}
+			if len(m) < maxTerms {
+				m[word]++
+			}
+		}
+		if len(unknown) > 0 {
+			addBigrams(m, unknown)
+			unknown = unknown[:0]
+		}
 	}
-	if a.Anonymous {
-		Index("art", ik.ParseID(a.ID), a.Content)
-	} else {
-		Index("art", ik.ParseID(a.ID), a.Content, a.Author)
+
+	for _, word := range reSearchWord.FindAllString(reHan.ReplaceAllString(v, " "), -1) {
+		if len(m) >= maxTerms {
+			break
+		}
+		if word = strings.ToLower(word); needsBigrams(word) {
+			addBigrams(m, []rune(word))
+		} else if len(word) <= maxTermLen {
+			m[stemmer.Stem(word)]++
+		}
 	}
+	return m
 }
 
+// tf splits the text into overlapping bigrams, which is used to search users and tags by substrings
 func tf(v string) (m map[string]float64) {
 	if len(v) == 0 {
 		return m
@@ -127,28 +196,23 @@ func Search(ns, query string, start, n int) ([]ik.ID, int) {
 		}
 	}
 
+	// Score all candidates before sorting, so relevant but old entries are not cut off
 	sorting := []pair{}
 	for key := range allKeys {
-		sorting = append(sorting, pair{key, 0})
-	}
-
-	if ns == "art" {
-		sort.Slice(sorting, func(i, j int) bool { return sorting[i].id.Time().After(sorting[j].id.Time()) })
-	} else {
-		sort.Slice(sorting, func(i, j int) bool { return sorting[i].id.Less(sorting[j].id) })
-	}
-
-	if len(sorting) > candSize {
-		sorting = sorting[:candSize]
-	}
-
-	for ii := range sorting {
+		p := pair{key, 0}
 		for _, i := range iters {
-			if s, ok := i.Get(sorting[ii].id); ok {
-				sorting[ii].score += s.(float64) * math.Max(math.Log2(float64(pool.Len())/float64(i.Len())), 0)
+			if s, ok := i.Get(key); ok {
+				p.score += s.(float64) * math.Max(math.Log2(float64(pool.Len())/float64(i.Len())), 0)
 			}
 		}
+		sorting = append(sorting, p)
 	}
-	sort.Slice(sorting, func(i, j int) bool { return sorting[i].score > sorting[j].score })
+
+	sort.Slice(sorting, func(i, j int) bool {
+		if sorting[i].score == sorting[j].score {
+			return sorting[i].id.Less(sorting[j].id)
+		}
+		return sorting[i].score > sorting[j].score
+	})
 	return slice(sorting), len(sorting)
 }
@@ -24,3 +24,18 @@ func TestSearch(t *testing.T) {
 	}
 	t.Log(Search("test", text[10:rand.Intn(len(text)/2)+10], 0, 10))
 }
+
+func TestTokenize(t *testing.T) {
+	m := Tokenize("Running DOGS 跑步的狗 ありがとう c++")
+	for _, term := range []string{"run", "dog", "跑步", "步的", "的狗", "あり", "とう", "c"} {
+		if m[term] != 1 {
+			t.Fatal(term, m)
+		}
+	}
+	if len(m) != 10 {
+		t.Fatal(m)
+	}
+	if m := Tokenize("猫"); m["猫"] != 1 || len(m) != 1 {
+		t.Fatal(m)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"html/template"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ var (
 	Dummy                        = User{_IsYou: true, ID: "dummy"}
 	DalIsFollowing               func(string, string) bool
 	DalIsBlocking                func(string, string) bool
+	DalIsMuting                  func(string, string) bool
 	DalIsFollowingWithAcceptance func(string, *User) (bool, bool)
 )
 
@@ -36,6 +38,12 @@ const (
 	CmdLike                = "like"          // raw cmd article
 	CmdInboxLike           = "inbox-like"    // notification shown in inbox
 	CmdTimelineLike        = "timeline-like" // notification shown in timeline
+	CmdInboxLoginAlert     = "inbox-login-alert"
+	CmdInboxSearch         = "inbox-saved-search"
+	CmdInboxRepost         = "inbox-repost"
+	CmdInboxQuote          = "inbox-quote"
+	CmdRepost              = "repost"
+	CmdBookmark            = "bookmark"
 
 	DeletionMarker = "[[b19b8759-391b-460a-beb0-16f5f334c34f]]"
 )
@@ -55,6 +63,8 @@ type Article struct {
 	ID            string            `json:"id"`
 	Replies       int               `json:"rs,omitempty"`      // how many replies
 	Likes         int32             `json:"like,omitempty"`    // how many likes
+	Reposts       int32             `json:"rp,omitempty"`      // how many reposts
+	Revisions     int32             `json:"rev,omitempty"`     // how many edits
 	ReplyLockMode byte              `json:"lm,omitempty"`      // reply lock
 	PostOptions   byte              `json:"po,omitempty"`      // post options
 	Asc           byte              `json:"asc,omitempty"`     // replies order by asc
@@ -77,7 +87,8 @@ type Article struct {
 	ReferID       string            `json:"ref,omitempty"`     // refer ID (to another article)
 	History       string            `json:"his,omitempty"`     // operation history
 
-	T_StickOnTop bool `json:"-"`
+	T_StickOnTop bool   `json:"-"`
+	T_RepostBy   string `json:"-"` // set when the article is resolved from a user's repost
 }
 
 func (a *Article) IsDeleted() bool {
@@ -110,7 +121,6 @@ func UnmarshalArticle(b []byte) (*Article, error) {
 	if a.ID == "" {
 		return nil, fmt.Errorf("failed to unmarshal: %q", b)
 	}
-	indexArticle(a)
 	return a, err
 }
 
@@ -120,6 +130,8 @@ type User struct {
 	Role                  string
 	PasswordHash          []byte
 	Email                 string `json:"e"`
+	EmailVerified         bool   `json:"ev,omitempty"`
+	PendingEmail          string `json:"pe,omitempty"` // waiting for confirmation
 	Avatar                uint32 `json:"av"`
 	CustomName            string `json:"cn"`
 	Followers             int32  `json:"F"`
@@ -138,16 +150,23 @@ type User struct {
 	HideLocation          int    `json:"hl,omitempty"`
 	Description           string `json:"desc,omitempty"`
 	APIToken              string `json:"api,omitempty"`
+	TOTPSecret            string `json:"totp,omitempty"`
+	TOTPRecovery          string `json:"totpr,omitempty"` // hashes of unused recovery codes
+
+	RateLimits map[string]int `json:"rl,omitempty"` // overridden limits of policies, negative means unlimited
 
 	_IsFollowing            bool
 	_IsFollowingNotAccepted bool
 	_IsFollowed             bool
 	_IsBlocking             bool
+	_IsMuting               bool
 	_IsYou                  bool
 	_IsInvalid              bool
 	_IsAnon                 bool
 	_IsAPI                  bool
 	_ShowList               byte
+	_Session                string
+	_APIScope               APIScope
 }
 
 func (u User) Marshal() []byte {
@@ -203,6 +222,8 @@ func (u User) IsFollowed() bool { return u._IsFollowed }
 
 func (u User) IsBlocking() bool { return u._IsBlocking }
 
+func (u User) IsMuting() bool { return u._IsMuting }
+
 func (u User) IsYou() bool { return u._IsYou }
 
 func (u User) IsInvalid() bool { return u._IsInvalid }
@@ -217,8 +238,18 @@ func (u *User) SetIsAnon(v bool) *User { u._IsAnon = v; return u }
 
 func (u *User) SetIsAPI(v bool) *User { u._IsAPI = v; return u }
 
+func (u *User) SetAPIScope(s APIScope) *User { u._APIScope = s; return u }
+
+// HasScope returns true if the user is not using an API token, or the token has the scope
+func (u User) HasScope(s APIScope) bool { return !u._IsAPI || u._APIScope&s == s }
+
 func (u User) ShowList() byte { return u._ShowList }
 
+// CurrentSession returns the session this user logged in with
+func (u User) CurrentSession() string { return u._Session }
+
+func (u *User) SetCurrentSession(s string) *User { u._Session = s; return u }
+
 func (u *User) Buildup(you *User) {
 	following, accepted := DalIsFollowingWithAcceptance(you.ID, u)
 	u._IsYou = you.ID == u.ID
@@ -229,6 +260,7 @@ func (u *User) Buildup(you *User) {
 	u._IsFollowingNotAccepted = following && !accepted
 	u._IsFollowed = DalIsFollowing(u.ID, you.ID)
 	u._IsBlocking = DalIsBlocking(you.ID, u.ID)
+	u._IsMuting = DalIsMuting(you.ID, u.ID)
 }
 
 func (u *User) SetShowList(t byte) { u._ShowList = t }
@@ -240,13 +272,40 @@ func (u User) JSON() string {
 	return string(b)
 }
 
+// VerifiedEmail returns the email only if it has been confirmed by the user
+func (u User) VerifiedEmail() string {
+	if u.EmailVerified {
+		return u.Email
+	}
+	return ""
+}
+
 func (u User) Signup() time.Time { return time.Unix(int64(u.TSignup), 0) }
 
 func (u User) Login() time.Time { return time.Unix(int64(u.TLogin), 0) }
 
-func (u User) IsMod() bool { return u.Role == "mod" || u.ID == common.Cfg.AdminName }
+func (u User) IsMod() bool {
+	return (u.Role == "mod" || u.ID == common.Cfg.AdminName) && !u.NeedsTOTP() && u.HasScope(ScopeModerate)
+}
+
+func (u User) IsAdmin() bool {
+	return (u.Role == "admin" || u.ID == common.Cfg.AdminName) && !u.NeedsTOTP() && u.HasScope(ScopeModerate)
+}
+
+// NeedsTOTP returns true if the user is a mod or admin but hasn't enabled 2FA while it is enforced,
+// its privileges are suspended until 2FA is enabled
+func (u User) NeedsTOTP() bool {
+	return common.Cfg.TOTPForMods && u.TOTPSecret == "" &&
+		(u.Role == "mod" || u.Role == "admin" || u.ID == common.Cfg.AdminName)
+}
 
-func (u User) IsAdmin() bool { return u.Role == "admin" || u.ID == common.Cfg.AdminName }
+// RateLimitOverride returns the overridden limit of the policy, or an empty string if not set
+func (u User) RateLimitOverride(policy string) string {
+	if l, ok := u.RateLimits[policy]; ok {
+		return strconv.Itoa(l)
+	}
+	return ""
+}
 
 func (u User) IDHash() (hash uint64) {
 	for _, r := range u.ID {
@@ -272,3 +331,129 @@ func UnmarshalUser(b []byte) (*User, error) {
 	IndexUser(a)
 	return a, err
 }
+
+type Session struct {
+	ID       string // hash of the session secret
+	Create   time.Time
+	LastUse  time.Time
+	IP       string
+	Device   string
+	Location string
+	Current  bool
+}
+
+type APIScope byte
+
+const (
+	ScopeRead APIScope = 1 << iota
+	ScopePost
+	ScopeUpload
+	ScopeFollow
+	ScopeModerate
+
+	ScopeAll = ScopeRead | ScopePost | ScopeUpload | ScopeFollow | ScopeModerate
+)
+
+var APIScopeNames = []string{"read", "post", "upload", "follow", "moderate"}
+
+func ParseAPIScope(names string) (s APIScope) {
+	for _, n := range strings.FieldsFunc(names, func(r rune) bool { return r == ',' || r == ' ' }) {
+		for i, name := range APIScopeNames {
+			if strings.TrimSpace(n) == name {
+				s |= 1 << uint(i)
+			}
+		}
+	}
+	return
+}
+
+func (s APIScope) String() string {
+	res := []string{}
+	for i, name := range APIScopeNames {
+		if s&(1<<uint(i)) != 0 {
+			res = append(res, name)
+		}
+	}
+	return strings.Join(res, ",")
+}
+
+type APIToken struct {
+	ID      string    `json:"-"` // hash of the token secret
+	Label   string    `json:"l"`
+	Scope   APIScope  `json:"s"`
+	Create  time.Time `json:"c"`
+	Expire  time.Time `json:"e,omitempty"`
+	LastUse time.Time `json:"lu,omitempty"`
+	LastIP  string    `json:"ip,omitempty"`
+	Legacy  bool      `json:"-"`
+}
+
+func (t APIToken) Expired() bool { return !t.Expire.IsZero() && time.Now().After(t.Expire) }
+
+type SavedSearch struct {
+	ID     string    `json:"-"`
+	Query  string    `json:"q"`
+	Muted  bool      `json:"m,omitempty"`
+	Create time.Time `json:"c"`
+}
+
+type UserList struct {
+	ID          string    `json:"-"`
+	Owner       string    `json:"-"`
+	Name        string    `json:"n"`
+	Description string    `json:"d,omitempty"`
+	Public      bool      `json:"p,omitempty"`
+	Members     int       `json:"m,omitempty"`
+	Subscribers int       `json:"s,omitempty"`
+	Create      time.Time `json:"c"`
+}
+
+func (l UserList) Link() string { return "/list/" + l.Owner + "/" + l.ID }
+
+type Draft struct {
+	ID        string    `json:"-"`
+	Content   string    `json:"c"`
+	Media     string    `json:"m,omitempty"`
+	Poll      bool      `json:"p,omitempty"`
+	ReplyTo   string    `json:"r,omitempty"`
+	NSFW      bool      `json:"n,omitempty"`
+	ReplyLock byte      `json:"l,omitempty"`
+	Update    time.Time `json:"u"`
+}
+
+// Link returns the page where the draft can be continued in the post box
+func (d Draft) Link() string {
+	if d.ReplyTo == "" {
+		return "/t?draft=" + d.ID
+	}
+	return "/S/" + d.ReplyTo[1:] + "?draft=" + d.ID
+}
+
+type OAuthClient struct {
+	ID           string
+	Name         string
+	Owner        string
+	RedirectURIs []string
+	SecretHash   string
+	Public       bool // public clients (e.g. native apps) can't keep secrets, so they rely on PKCE only
+	Create       time.Time
+}
+
+func (c OAuthClient) ValidRedirectURI(uri string) bool {
+	for _, u := range c.RedirectURIs {
+		if u == uri {
+			return true
+		}
+	}
+	return false
+}
+
+type OAuthGrant struct {
+	ID           string    `json:"-"`
+	ClientID     string    `json:"c"`
+	Scope        APIScope  `json:"s"`
+	Create       time.Time `json:"ct"`
+	AccessHash   string    `json:"ah"`
+	AccessExpire time.Time `json:"ae"`
+	RefreshHash  string    `json:"rh"`
+}
@@ -0,0 +1,23 @@
+package model
+
+import "testing"
+
+func TestAPIScope(t *testing.T) {
+	s := ParseAPIScope("read, post,unknown")
+	if s != ScopeRead|ScopePost || s.String() != "read,post" {
+		t.Fatal(s)
+	}
+
+	u := &User{ID: "a", Role: "mod"}
+	if !u.IsMod() || !u.HasScope(ScopeUpload) {
+		t.Fatal(u)
+	}
+	u.SetIsAPI(true).SetAPIScope(s)
+	if u.IsMod() || u.HasScope(ScopeUpload) || !u.HasScope(ScopePost) {
+		t.Fatal(u)
+	}
+	u.SetAPIScope(ScopeAll)
+	if !u.IsMod() {
+		t.Fatal(u)
+	}
+}
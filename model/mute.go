@@ -0,0 +1,145 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coyove/iis/common"
+)
+
+// Keys of the mute list: "@<user_id>", "#<tag>", "k:<keyword>" and "r:<regexp>",
+// values are unix timestamps when the mutes expire, 0 means never
+const (
+	MuteUser    = "@"
+	MuteTag     = "#"
+	MuteKeyword = "k:"
+	MuteRegexp  = "r:"
+)
+
+type MuteEntry struct {
+	Key    string
+	Kind   string
+	Value  string
+	Expire time.Time
+}
+
+func (e MuteEntry) Expired() bool { return !e.Expire.IsZero() && time.Now().After(e.Expire) }
+
+// MakeMuteKey validates the value and returns the key stored in the mute list
+func MakeMuteKey(kind, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch kind {
+	case MuteUser, MuteTag:
+		value = strings.TrimPrefix(value, kind)
+		if value == "" || len(value) > 32 {
+			return "", fmt.Errorf("e:invalid_mute")
+		}
+	case MuteKeyword:
+		value = strings.ToLower(common.SoftTrunc(value, 64))
+		if value == "" {
+			return "", fmt.Errorf("e:invalid_mute")
+		}
+	case MuteRegexp:
+		if value == "" || len(value) > 128 {
+			return "", fmt.Errorf("e:invalid_mute")
+		}
+		if _, err := regexp.Compile(value); err != nil {
+			return "", fmt.Errorf("e:invalid_regexp")
+		}
+	default:
+		return "", fmt.Errorf("e:invalid_mute")
+	}
+	return kind + value, nil
+}
+
+func ParseMuteEntries(extras map[string]string) []MuteEntry {
+	res := []MuteEntry{}
+	for k, v := range extras {
+		e := MuteEntry{Key: k}
+		for _, kind := range []string{MuteUser, MuteTag, MuteKeyword, MuteRegexp} {
+			if strings.HasPrefix(k, kind) {
+				e.Kind, e.Value = kind, k[len(kind):]
+				break
+			}
+		}
+		if e.Kind == "" {
+			continue
+		}
+		if ts, _ := strconv.ParseInt(v, 10, 64); ts > 0 {
+			e.Expire = time.Unix(ts, 0)
+		}
+		res = append(res, e)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+	return res
+}
+
+type Mutes struct {
+	users    map[string]bool
+	tags     map[string]bool
+	keywords []string
+	regexps  []*regexp.Regexp
+}
+
+// NewMutes compiles unexpired entries of the mute list
+func NewMutes(extras map[string]string) *Mutes {
+	m := &Mutes{users: map[string]bool{}, tags: map[string]bool{}}
+	for _, e := range ParseMuteEntries(extras) {
+		if e.Expired() {
+			continue
+		}
+		switch e.Kind {
+		case MuteUser:
+			m.users[e.Value] = true
+		case MuteTag:
+			m.tags[strings.ToLower(e.Value)] = true
+		case MuteKeyword:
+			m.keywords = append(m.keywords, e.Value)
+		case MuteRegexp:
+			if re, err := regexp.Compile(e.Value); err == nil {
+				m.regexps = append(m.regexps, re)
+			}
+		}
+	}
+	return m
+}
+
+func (m *Mutes) IsEmpty() bool {
+	return m == nil || len(m.users)+len(m.tags)+len(m.keywords)+len(m.regexps) == 0
+}
+
+func (m *Mutes) IsMutingUser(id string) bool { return m != nil && m.users[id] }
+
+// Match returns true if the author or the content is muted
+func (m *Mutes) Match(author, content string) bool {
+	if m.IsEmpty() {
+		return false
+	}
+	if m.users[author] {
+		return true
+	}
+	if len(m.tags) > 0 {
+		_, tags := common.ExtractMentionsAndTags(content)
+		for _, t := range tags {
+			if m.tags[strings.ToLower(t)] {
+				return true
+			}
+		}
+	}
+	lower := strings.ToLower(content)
+	for _, k := range m.keywords {
+		if strings.Contains(lower, k) {
+			return true
+		}
+	}
+	for _, re := range m.regexps {
+		if re.MatchString(content) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestMutes(t *testing.T) {
+	if _, err := MakeMuteKey(MuteRegexp, "(abc"); err == nil || err.Error() != "e:invalid_regexp" {
+		t.Fatal(err)
+	}
+	if k, _ := MakeMuteKey(MuteKeyword, " Spoiler "); k != "k:spoiler" {
+		t.Fatal(k)
+	}
+	if k, _ := MakeMuteKey(MuteTag, "#golang"); k != "#golang" {
+		t.Fatal(k)
+	}
+
+	expired := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	m := NewMutes(map[string]string{
+		"@foo":        "0",
+		"@bar":        expired,
+		"#golang":     "0",
+		"k:spoiler":   "0",
+		`r:\d{4}-\d+`: "0",
+		"unknown":     "0",
+	})
+	if !m.IsMutingUser("foo") || m.IsMutingUser("bar") {
+		t.Fatal(m.users)
+	}
+	for content, muted := range map[string]bool{
+		"hello":             false,
+		"learning #Golang":  true,
+		"no SPOILER please": true,
+		"call 0101-1234":    true,
+	} {
+		if m.Match("zzz", content) != muted {
+			t.Fatal(content)
+		}
+	}
+	if !m.Match("foo", "hello") || m.Match("bar", "hello") {
+		t.Fatal("user mutes")
+	}
+	if (*Mutes)(nil).Match("foo", "spoiler") {
+		t.Fatal("nil mutes")
+	}
+	if e := ParseMuteEntries(map[string]string{"@bar": expired}); len(e) != 1 || !e[0].Expired() || e[0].Value != "bar" {
+		t.Fatal(e)
+	}
+}
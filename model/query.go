@@ -0,0 +1,312 @@
+package model
+
+import (
+	"html"
+	"html/template"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/coyove/iis/common"
+	"github.com/coyove/iis/ik"
+)
+
+// SearchQuery is the parsed search query, e.g.:
+//
+//	golang from:foo to:bar #tag has:image is:nsfw is:poll before:2020-06-01 after:2020-01-01 "exact phrase" -excluded
+type SearchQuery struct {
+	Text     []string // free text, ranked by relevance
+	Phrases  []string
+	Excludes []string
+	From     string
+	To       string // replies to the user
+	Tags     []string
+	HasImage bool
+	IsNSFW   bool
+	IsPoll   bool
+	Before   time.Time
+	After    time.Time
+}
+
+// Special terms put into the search index along with the content, they can't be produced by Tokenize
+const (
+	searchTermFrom  = "from:"
+	searchTermTo    = "to:"
+	searchTermTag   = "#"
+	searchTermImage = "has:image"
+	searchTermNSFW  = "is:nsfw"
+	searchTermPoll  = "is:poll"
+)
+
+// splitSearchQuery splits the query by spaces, quoted phrases are kept as a whole including the quotes
+func splitSearchQuery(q string) (res []string) {
+	var buf []rune
+	quoted := false
+	for _, r := range q {
+		switch {
+		case r == '"':
+			buf = append(buf, r)
+			quoted = !quoted
+		case unicode.IsSpace(r) && !quoted:
+			if len(buf) > 0 {
+				res = append(res, string(buf))
+			}
+			buf = buf[:0]
+		default:
+			buf = append(buf, r)
+		}
+	}
+	if len(buf) > 0 {
+		res = append(res, string(buf))
+	}
+	return res
+}
+
+func normalizeSearchTag(tag string) string {
+	if _, tags := common.ExtractMentionsAndTags("#" + tag); len(tags) > 0 {
+		return strings.ToLower(tags[0])
+	}
+	return ""
+}
+
+func ParseSearchQuery(q string) (sq SearchQuery) {
+	for _, part := range splitSearchQuery(common.SoftTrunc(q, 256)) {
+		exclude := strings.HasPrefix(part, "-") && len(part) > 1
+		if exclude {
+			part = part[1:]
+		}
+
+		if strings.HasPrefix(part, `"`) {
+			phrase := strings.ToLower(strings.TrimSpace(strings.Trim(part, `"`)))
+			switch {
+			case phrase == "":
+			case exclude:
+				sq.Excludes = append(sq.Excludes, phrase)
+			default:
+				sq.Phrases = append(sq.Phrases, phrase)
+			}
+			continue
+		}
+
+		if exclude {
+			sq.Excludes = append(sq.Excludes, strings.ToLower(part))
+			continue
+		}
+
+		lower := strings.ToLower(part)
+		idx := strings.Index(lower, ":")
+		key, value := "", ""
+		if idx > 0 {
+			key, value = lower[:idx], part[idx+1:]
+		}
+
+		switch {
+		case key == "from" && value != "":
+			sq.From = strings.TrimPrefix(value, "@")
+		case key == "to" && value != "":
+			sq.To = strings.TrimPrefix(value, "@")
+		case lower == searchTermImage:
+			sq.HasImage = true
+		case lower == searchTermNSFW:
+			sq.IsNSFW = true
+		case lower == searchTermPoll:
+			sq.IsPoll = true
+		case key == "before" || key == "after":
+			t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+			if err != nil {
+				sq.Text = append(sq.Text, part)
+			} else if key == "before" {
+				sq.Before = t
+			} else {
+				sq.After = t.AddDate(0, 0, 1)
+			}
+		case strings.HasPrefix(part, "#") && normalizeSearchTag(part[1:]) != "":
+			sq.Tags = append(sq.Tags, normalizeSearchTag(part[1:]))
+		default:
+			sq.Text = append(sq.Text, part)
+		}
+	}
+	return
+}
+
+func (q *SearchQuery) IsEmpty() bool {
+	return len(q.Text) == 0 && len(q.Phrases) == 0 && len(q.Required()) == 0
+}
+
+// Required returns the special terms which must present in the index
+func (q *SearchQuery) Required() (res []string) {
+	if q.From != "" {
+		res = append(res, searchTermFrom+strings.ToLower(q.From))
+	}
+	if q.To != "" {
+		res = append(res, searchTermTo+strings.ToLower(q.To))
+	}
+	for _, t := range q.Tags {
+		res = append(res, searchTermTag+t)
+	}
+	if q.HasImage {
+		res = append(res, searchTermImage)
+	}
+	if q.IsNSFW {
+		res = append(res, searchTermNSFW)
+	}
+	if q.IsPoll {
+		res = append(res, searchTermPoll)
+	}
+	return
+}
+
+// Terms returns the tokenized free text and phrases, which are used for ranking
+func (q *SearchQuery) Terms() (res []string) {
+	for t := range Tokenize(strings.Join(append(append([]string{}, q.Text...), q.Phrases...), " ")) {
+		res = append(res, t)
+	}
+	return
+}
+
+// SearchTerms returns terms of the article to be indexed, including special terms for filters
+func (a *Article) SearchTerms(parentAuthor string) (content map[string]int, special []string) {
+	content = Tokenize(a.Content)
+	if content == nil {
+		content = map[string]int{}
+	}
+	if !a.Anonymous {
+		special = append(special, searchTermFrom+strings.ToLower(a.Author))
+	}
+	if parentAuthor != "" {
+		special = append(special, searchTermTo+strings.ToLower(parentAuthor))
+	}
+	_, tags := common.ExtractMentionsAndTags(a.Content)
+	for _, t := range tags {
+		special = append(special, searchTermTag+strings.ToLower(t))
+	}
+	if a.Media != "" {
+		special = append(special, searchTermImage)
+	}
+	if a.NSFW {
+		special = append(special, searchTermNSFW)
+	}
+	if a.Extras["poll_title"] != "" {
+		special = append(special, searchTermPoll)
+	}
+	return
+}
+
+// MatchTerms checks terms of an article returned by SearchTerms, it works like SearchArticles without the index
+func (q *SearchQuery) MatchTerms(content map[string]int, special []string) bool {
+	specials := map[string]bool{}
+	for _, t := range special {
+		specials[t] = true
+	}
+	for _, t := range q.Required() {
+		if !specials[t] {
+			return false
+		}
+	}
+	terms := q.Terms()
+	for _, t := range terms {
+		if content[t] > 0 {
+			return true
+		}
+	}
+	return len(terms) == 0
+}
+
+// Match checks the article against filters which can't be fully answered by the index
+func (q *SearchQuery) Match(a *Article, parentAuthor string) bool {
+	t := ik.ParseID(a.ID).Time()
+	if !q.Before.IsZero() && !t.Before(q.Before) {
+		return false
+	}
+	if !q.After.IsZero() && t.Before(q.After) {
+		return false
+	}
+	if q.From != "" && (a.Anonymous || !strings.EqualFold(a.Author, q.From)) {
+		return false
+	}
+	if q.To != "" && !strings.EqualFold(parentAuthor, q.To) {
+		return false
+	}
+	if (q.HasImage && a.Media == "") || (q.IsNSFW && !a.NSFW) || (q.IsPoll && a.Extras["poll_title"] == "") {
+		return false
+	}
+
+	content := strings.ToLower(a.Content)
+	for _, p := range q.Phrases {
+		if !strings.Contains(content, p) {
+			return false
+		}
+	}
+	for _, e := range q.Excludes {
+		if strings.Contains(content, e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Snippet returns about 'width' runes of the content around the first match of the query, matches are wrapped in <mark>
+func (q *SearchQuery) Snippet(content string, width int) template.HTML {
+	words := append(q.Terms(), q.Phrases...)
+	runes := []rune(content)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, w := range words {
+		w := []rune(w)
+		if len(w) < 2 {
+			continue
+		}
+	NEXT:
+		for i := 0; i+len(w) <= len(lower); i++ {
+			for j := range w {
+				if lower[i+j] != w[j] {
+					continue NEXT
+				}
+			}
+			for j := range w {
+				marked[i+j] = true
+			}
+			if first == -1 || i < first {
+				first = i
+			}
+		}
+	}
+	if first == -1 {
+		return ""
+	}
+
+	start := first - width/4
+	if start < 0 {
+		start = 0
+	}
+	end := start + width
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	buf := strings.Builder{}
+	if start > 0 {
+		buf.WriteString("...")
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && marked[j] == marked[i] {
+			j++
+		}
+		if marked[i] {
+			buf.WriteString("<mark>" + html.EscapeString(string(runes[i:j])) + "</mark>")
+		} else {
+			buf.WriteString(html.EscapeString(string(runes[i:j])))
+		}
+		i = j
+	}
+	if end < len(runes) {
+		buf.WriteString("...")
+	}
+	return template.HTML(buf.String())
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/coyove/iis/ik"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	q := ParseSearchQuery(`golang from:@Foo to:bar #Tag has:image is:nsfw is:poll before:2020-06-01 after:2020-01-01 "Exact  Phrase" -excluded -"bad words" unknown:x`)
+	if len(q.Text) != 2 || q.Text[0] != "golang" || q.Text[1] != "unknown:x" {
+		t.Fatal(q.Text)
+	}
+	if q.From != "Foo" || q.To != "bar" || len(q.Tags) != 1 || q.Tags[0] != "tag" {
+		t.Fatal(q.From, q.To, q.Tags)
+	}
+	if !q.HasImage || !q.IsNSFW || !q.IsPoll || q.Before.Month() != 6 || q.After.Day() != 2 {
+		t.Fatal(q)
+	}
+	if len(q.Phrases) != 1 || q.Phrases[0] != "exact  phrase" {
+		t.Fatal(q.Phrases)
+	}
+	if len(q.Excludes) != 2 || q.Excludes[0] != "excluded" || q.Excludes[1] != "bad words" {
+		t.Fatal(q.Excludes)
+	}
+	if r := q.Required(); len(r) != 6 || r[0] != "from:foo" || r[2] != "#tag" {
+		t.Fatal(r)
+	}
+
+	a := &Article{ID: ik.NewGeneralID().String(), Author: "foo", Content: "Hello Exact  Phrase #tag", Media: "x", NSFW: true}
+	q = ParseSearchQuery(`from:foo "exact  phrase" has:image is:nsfw`)
+	if !q.Match(a, "") {
+		t.Fatal("should match")
+	}
+	if q = ParseSearchQuery(`hello -phrase`); q.Match(a, "") {
+		t.Fatal("excluded")
+	}
+	if q = ParseSearchQuery(`to:bar`); q.Match(a, "") || !q.Match(a, "BAR") {
+		t.Fatal("to")
+	}
+	if q = ParseSearchQuery(`before:2000-01-01`); q.Match(a, "") {
+		t.Fatal("before")
+	}
+
+	content, special := a.SearchTerms("bar")
+	if len(special) != 5 {
+		t.Fatal(special)
+	}
+	if q = ParseSearchQuery(`hello #tag to:bar`); !q.MatchTerms(content, special) {
+		t.Fatal("match terms")
+	}
+	if q = ParseSearchQuery(`world #tag`); q.MatchTerms(content, special) {
+		t.Fatal("world")
+	}
+
+	q = ParseSearchQuery(`running "a<b"`)
+	if s := q.Snippet("Dogs are RUNNING, a<b", 100); s != "Dogs are <mark>RUN</mark>NING, <mark>a&lt;b</mark>" {
+		t.Fatal(s)
+	}
+	if s := q.Snippet("xxxxxxxxxx run", 4); s != "... <mark>run</mark>" {
+		t.Fatal(s)
+	}
+}
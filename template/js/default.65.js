@@ -181,6 +181,44 @@ function $postReload(el, url, data) {
     }, stop)
 }
 
+function loginChallenge() {
+    $post('/api/new_captcha', {}, function(r) {
+        $q('#login-challenge').style.display = 'block';
+        $q('#login-challenge [name=captcha]').src = "data:image/png;base64," + r.Challenge;
+        $q('#login-challenge [name=uuid]').value = r.UUID;
+        $q('#login-challenge [name=answer]').value = '';
+    })
+}
+
+function login(el) {
+    var stop = $wait(el), data = {
+        'username': $q('[name=username]').value,
+        'password': $q('[name=password]').value,
+        'remember': $q('#remember').checked ? '1' :'',
+    };
+    if ($q('#login-challenge').style.display != 'none') {
+        data.uuid = $q('#login-challenge [name=uuid]').value;
+        data.answer = $q('#login-challenge [name=answer]').value;
+    }
+    $post('/api2/login', data, function(res) {
+        stop();
+        if (res == "login_challenge" || (res != "ok" && data.uuid)) loginChallenge();
+        if (res.match(/^ok:totp:/)) {
+            var code = prompt('请输入两步验证码或恢复码');
+            if (!code) return;
+            data.pending = res.substring(8);
+            data.code = code;
+            delete data.password;
+            delete data.uuid; // captcha has been solved in the first step
+            delete data.answer;
+            return $postReload(el, '/api2/login_totp', data);
+        }
+        if (res != "ok") return res;
+        var r = new URLSearchParams(location.search).get('redirect')
+        r ? location.href = r : location.reload();
+    }, stop)
+}
+
 function isInViewport(el, scale) {
     var top = el.offsetTop, height = el.offsetHeight, h = window.innerHeight, s = scale || 0;
     while (el.offsetParent) {
@@ -205,6 +243,130 @@ function likeArticle(el, id) {
     }, stop);
 }
 
+function repostArticle(el, id) {
+    var div = $html("<div class=tmpl-light-bg style='border-radius:0.5em;position:absolute;z-index:1001;box-shadow:0 1px 5px rgba(0,0,0,.3)'></div>"),
+        box = el.getBoundingClientRect(),
+        bodyBox = document.body.getBoundingClientRect(),
+        reposted = el.getAttribute("reposted") === "true",
+        num = el.querySelector('span'),
+        oldValue = parseInt(num.innerText) || 0;
+
+    div.style.left = box.left - bodyBox.left + "px";
+    div.style.top = box.bottom - bodyBox.top + "px";
+    div.appendChild($html("<div style='margin:0.5em'><button class='gbutton repost'><i class=icon-cw-circled></i> " + (reposted ? "取消转发" : "转发") + "</button></div>"))
+    div.appendChild($html("<div style='margin:0.5em'><textarea class=t rows=3 placeholder='引用并评论...' style='width:16em;display:block'></textarea></div>"))
+    div.appendChild($html("<div style='margin:0.5em;text-align:center'><button class='gbutton quote'><i class=icon-quote-left></i> 引用</button></div>"))
+    document.body.appendChild(div)
+
+    window.REGIONS.push({
+        valid: true,
+        boxes: [el, div],
+        callback: function(x, y) { div.parentNode.removeChild(div) },
+    });
+
+    div.querySelector('button.repost').onclick = function(e) {
+        var stop = $wait(e.target), v = reposted ? "" : "1";
+        $post("/api2/repost", { repost: v, to: id }, function(res) {
+            stop();
+            if (res !== "ok") return res;
+            el.setAttribute("reposted", !!v);
+            el.querySelector('i').className = v ? 'icon-cw-circled tmpl-green-text' : 'icon-cw-circled';
+            num.innerText = Math.max(v ? oldValue + 1 : oldValue - 1, 0) || "";
+            return "ok:" + (v ? "已转发" : "已取消转发")
+        }, stop);
+    }
+
+    div.querySelector('button.quote').onclick = function(e) {
+        var stop = $wait(e.target);
+        $post("/api2/new", { content: div.querySelector('textarea').value, quote: id }, function(res) {
+            stop();
+            if (res.substring(0, 3) !== "ok:") return res;
+            return "ok:已引用"
+        }, stop);
+    }
+}
+
+function bookmarkArticle(el, id) {
+    var div = $html("<div class=tmpl-light-bg style='border-radius:0.5em;position:absolute;z-index:1001;box-shadow:0 1px 5px rgba(0,0,0,.3)'></div>"),
+        box = el.getBoundingClientRect(),
+        bodyBox = document.body.getBoundingClientRect(),
+        bookmarked = el.getAttribute("bookmarked") === "true";
+
+    div.style.left = box.left - bodyBox.left + "px";
+    div.style.top = box.bottom - bodyBox.top + "px";
+    div.appendChild($html("<div style='margin:0.5em'><input class=t placeholder='文件夹 (可选)' style='width:12em'></div>"))
+    div.appendChild($html("<div style='margin:0.5em;text-align:center'><button class='gbutton save'>" + (bookmarked ? "移动书签" : "添加书签") + "</button></div>"))
+    if (bookmarked)
+        div.appendChild($html("<div style='margin:0.5em;text-align:center'><button class='gbutton tmpl-red-text remove'>删除书签</button></div>"))
+    document.body.appendChild(div)
+
+    window.REGIONS.push({
+        valid: true,
+        boxes: [el, div],
+        callback: function(x, y) { div.parentNode.removeChild(div) },
+    });
+
+    var update = function(e, v) {
+        var stop = $wait(e.target);
+        $post("/api2/bookmark", { bookmark: v, to: id, folder: div.querySelector('input').value }, function(res) {
+            stop();
+            if (res !== "ok") return res;
+            el.setAttribute("bookmarked", !!v);
+            el.querySelector('i').className = v ? 'icon-link tmpl-green-text' : 'icon-link';
+            return "ok:" + (v ? "已添加书签" : "已删除书签")
+        }, stop);
+    }
+    div.querySelector('button.save').onclick = function(e) { update(e, "1") }
+    if (bookmarked)
+        div.querySelector('button.remove').onclick = function(e) { update(e, "") }
+}
+
+function editArticle(el, id) {
+    var div = $html("<div class=tmpl-light-bg style='border-radius:0.5em;position:absolute;z-index:1001;box-shadow:0 1px 5px rgba(0,0,0,.3)'></div>"),
+        box = el.getBoundingClientRect(),
+        bodyBox = document.body.getBoundingClientRect();
+
+    div.style.left = box.left - bodyBox.left + "px";
+    div.style.top = box.bottom - bodyBox.top + "px";
+    div.appendChild($html("<div style='margin:0.5em'><textarea class=t style='width:20em;height:6em'></textarea></div>"))
+    div.appendChild($html("<div style='margin:0.5em;text-align:center'><button class='gbutton save'>保存编辑</button></div>"))
+    div.querySelector('textarea').value = el.getAttribute("content");
+    document.body.appendChild(div)
+
+    window.REGIONS.push({
+        valid: true,
+        boxes: [el, div],
+        callback: function(x, y) { div.parentNode.removeChild(div) },
+    });
+
+    div.querySelector('button.save').onclick = function(e) {
+        var stop = $wait(e.target), content = div.querySelector('textarea').value;
+        $post("/api2/edit", { id: id, content: content }, function(res) {
+            stop();
+            if (res.substring(0, 3) !== "ok:") return res;
+            var html = decodeURIComponent(res.substring(3));
+            $q("[data-pre-id='" + id + "']", true).forEach(function(e) { e.innerHTML = html });
+            el.setAttribute("content", content);
+            if (div.parentNode) div.parentNode.removeChild(div);
+            return "ok:已编辑";
+        }, stop);
+    }
+}
+
+function listMenu(el, uid) {
+    var menu = el.parentNode.querySelector('.list-menu');
+    if (menu) {
+        menu.parentNode.removeChild(menu);
+        return;
+    }
+    var stop = $wait(el);
+    $post("/api2/list", { method: "menu", to: uid }, function(res) {
+        stop();
+        if (res.substring(0, 3) !== "ok:") return res;
+        el.parentNode.appendChild($html(res.substring(3)));
+    }, stop);
+}
+
 function deleteArticle(el, id) {
     if (!confirm("是否确认删除该发言？该操作不可逆")) return;
     var stop = $wait(el);
@@ -301,6 +463,10 @@ function followBlock(el, m, id) {
             case "accept":
                 el.innerHTML = '<i class="icon-ok tmpl-green-text"></i>';
                 return "ok" 
+            case "mute":
+                el = el.querySelector('span');
+                if (el) el.innerText = on ? "取消静音" : "静音";
+                return "ok:" + (on ? "已静音" : "已取消静音") + id;
             default:
                 el = el.querySelector('i');
                 el.className = el.className.replace(/block-\S+/, '') + " block-" + on;
@@ -316,6 +482,7 @@ function __i18n(t) {
         return "请等待" + t.split("`").pop();
     return ({
         "captcha_failed": "无效验证码",
+        "login_challenge": "登录失败次数过多，请输入验证码",
         "expired_session": "Token过期，请重试",
         "content_too_short": "正文过短",
         "cannot_reply": "无法回复",
@@ -330,7 +497,44 @@ function __i18n(t) {
         "user_not_permitted": "无权限",
         "cannot_follow": "无法关注",
         "cannot_block_tag": "无法拉黑标签",
-        "poll_nochange": "不可更改投票"
+        "poll_nochange": "不可更改投票",
+        "invalid_totp": "无效验证码",
+        "totp_enabled": "两步验证已启用",
+        "site_domain_not_set": "站点未配置域名，无法发送邮件",
+        "verify_too_frequent": "验证邮件发送过于频繁，请稍后再试",
+        "invalid_email": "无效邮箱",
+        "invalid_scope": "请至少选择一项权限",
+        "too_many_api_tokens": "Token数量已达上限",
+        "invalid_query": "无效的搜索",
+        "too_many_saved_searches": "保存的搜索已达上限",
+        "duplicated_saved_search": "已保存过该搜索",
+        "saved_search_not_found": "搜索不存在",
+        "invalid_mute": "无效的静音内容",
+        "invalid_regexp": "无效的正则表达式",
+        "too_many_mutes": "静音已达上限",
+        "cannot_repost": "无法转发该状态",
+        "invalid_folder": "无效的文件夹名",
+        "too_many_folders": "文件夹已达上限",
+        "invalid_list_name": "无效的列表名",
+        "too_many_lists": "列表已达上限",
+        "too_many_list_members": "列表成员已达上限",
+        "list_not_found": "列表不存在",
+        "cannot_add_to_list": "无法将该用户加入列表",
+        "cannot_subscribe_list": "无法订阅该列表",
+        "invalid_schedule_time": "无效的发布时间",
+        "too_many_scheduled_posts": "定时发布已达上限",
+        "scheduled_post_not_found": "定时发布不存在或已发布",
+        "scheduled_post_publishing": "正在发布中，请稍后再试",
+        "cannot_edit": "无法编辑该发言",
+        "edit_window_passed": "已超过可编辑时间",
+        "content_not_changed": "内容未改变",
+        "too_many_thread_parts": "分段数量已达上限",
+        "thread_not_supported": "分段发布不支持匿名或定时发布",
+        "keyring_key_not_set": "未配置KeyringKey，无法轮换",
+        "draft_not_found": "草稿不存在",
+        "too_many_drafts": "草稿已达上限",
+        "too_many_oauth_clients": "应用数量已达上限",
+        "invalid_redirect_uri": "无效回调地址"
     })[t] || t;
 }
 
@@ -579,6 +783,7 @@ function postBox(uuid, p, win) {
     history.pushState({}, "发布", "/post_box?p=" + (p||""))
     window.onpopstate = function(event) {
         box.innerHTML = old; // clear inside content
+        box.removeAttribute("data-draft");
         box.className = '';
         document.body.style.overflow = null;
         window.onpopstate = null;